@@ -17,10 +17,13 @@ import (
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/cron"
+	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/utils"
 	"github.com/iov-one/weave/x/validators"
+	tmiavl "github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
 )
 
 // Authenticator returns authentication with multisigs
@@ -62,12 +65,15 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	multisig.RegisterRoutes(r, authFn)
 	migration.RegisterRoutes(r, authFn)
 	validators.RegisterRoutes(r, authFn)
-	blog.RegisterRoutes(r, authFn, scheduler)
+	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(), scheduler)
+	blog.RegisterRoutes(r, authFn, CashControl(), scheduler)
 	return r
 }
 
 // QueryRouter returns a default query router,
-// allowing access to "/blog", "/auth", "/contracts", "/wallets", "/validators" and "/"
+// allowing access to "/blog", "/auth", "/contracts", "/wallets", "/validators",
+// "/proposals", "/votes", "/electorates", "/electionrules" and "/".
+// "/simulate" needs the chain ID of the store, see RegisterSimulateQuery.
 func QueryRouter() weave.QueryRouter {
 	r := weave.NewQueryRouter()
 	r.RegisterAll(
@@ -77,6 +83,7 @@ func QueryRouter() weave.QueryRouter {
 		migration.RegisterQuery,
 		orm.RegisterQuery,
 		validators.RegisterQuery,
+		gov.RegisterQuery,
 		blog.RegisterQuery,
 	)
 	return r
@@ -98,9 +105,11 @@ func CronStack() weave.Handler {
 	rt := app.NewRouter()
 
 	authFn := cron.Authenticator{}
+	scheduler := cron.NewScheduler(CronTaskMarshaler)
 
 	// Cron is using custom router as not the same handlers are registered.
-	blog.RegisterCronRoutes(rt, authFn)
+	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor())
+	blog.RegisterCronRoutes(rt, authFn, CashControl(), scheduler)
 
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
@@ -115,9 +124,19 @@ func CronStack() weave.Handler {
 // CommitKVStore returns an initialized KVStore that persists
 // the data to the named path.
 func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
+	tree, err := commitTree(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	return loadCommitStore(tree)
+}
+
+// commitTree returns an initialized merkle tree that persists the data to
+// the named path.
+func commitTree(dbPath string) (*tmiavl.MutableTree, error) {
 	// memory backed case, just for testing
 	if dbPath == "" {
-		return iavl.MockCommitStore(), nil
+		return tmiavl.NewMutableTree(dbm.NewMemDB(), iavl.DefaultCacheSize), nil
 	}
 
 	// Expand the path fully
@@ -132,21 +151,41 @@ func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
 	// Split the database name into it's components (dir, name)
 	dir := filepath.Dir(path)
 	name := filepath.Base(path)
-	return iavl.NewCommitStore(dir, name), nil
+	db, err := dbm.NewGoLevelDB(name, dir)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return tmiavl.NewMutableTree(db, iavl.DefaultCacheSize), nil
+}
+
+// loadCommitStore wraps given tree and loads its latest version.
+func loadCommitStore(tree *tmiavl.MutableTree) (weave.CommitKVStore, error) {
+	kv := iavl.NewCommitStoreFromTree(tree)
+	if err := kv.LoadLatestVersion(); err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return kv, nil
 }
 
 // Application constructs a basic ABCI application with
-// the given arguments.
+// the given arguments. The application attaches merkle proofs to the query
+// results if requested.
 func Application(name string, h weave.Handler,
-	tx weave.TxDecoder, dbPath string, debug bool) (app.BaseApp, error) {
+	tx weave.TxDecoder, dbPath string, debug bool) (ProvingApp, error) {
 
 	ctx := context.Background()
-	kv, err := CommitKVStore(dbPath)
+	tree, err := commitTree(dbPath)
+	if err != nil {
+		return ProvingApp{}, errors.Wrap(err, "cannot create database instance")
+	}
+	kv, err := loadCommitStore(tree)
 	if err != nil {
-		return app.BaseApp{}, errors.Wrap(err, "cannot create database instance")
+		return ProvingApp{}, errors.Wrap(err, "cannot load database")
 	}
-	store := app.NewStoreApp(name, kv, QueryRouter(), ctx)
+	qr := QueryRouter()
+	store := app.NewStoreApp(name, kv, qr, ctx)
+	RegisterSimulateQuery(qr, store.GetChainID)
 	ticker := cron.NewTicker(CronStack(), CronTaskMarshaler)
 	base := app.NewBaseApp(store, tx, h, ticker, debug)
-	return base, nil
+	return NewProvingApp(base, tree, debug), nil
 }
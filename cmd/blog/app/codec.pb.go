@@ -9,8 +9,10 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	blog "github.com/iov-one/blog-tutorial/x/blog"
 	github_com_iov_one_weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	migration "github.com/iov-one/weave/migration"
 	cash "github.com/iov-one/weave/x/cash"
+	gov "github.com/iov-one/weave/x/gov"
 	multisig "github.com/iov-one/weave/x/multisig"
 	sigs "github.com/iov-one/weave/x/sigs"
 	validators "github.com/iov-one/weave/x/validators"
@@ -56,12 +58,27 @@ type Tx struct {
 	//	*Tx_ValidatorsApplyDiffMsg
 	//	*Tx_ExecuteBatchMsg
 	//	*Tx_MigrationUpgradeSchemaMsg
+	//	*Tx_GovCreateProposalMsg
+	//	*Tx_GovDeleteProposalMsg
+	//	*Tx_GovVoteMsg
+	//	*Tx_GovUpdateElectorateMsg
+	//	*Tx_GovUpdateElectionRuleMsg
 	//	*Tx_BlogCreateUserMsg
 	//	*Tx_BlogCreateBlogMsg
 	//	*Tx_BlogChangeBlogOwnerMsg
 	//	*Tx_BlogCreateArticleMsg
 	//	*Tx_BlogDeleteArticleMsg
 	//	*Tx_BlogCancelDeleteArticleTaskMsg
+	//	*Tx_BlogHideArticleMsg
+	//	*Tx_BlogUpdateConfigurationMsg
+	//	*Tx_BlogCommissionArticleMsg
+	//	*Tx_BlogFulfillCommissionMsg
+	//	*Tx_BlogPinArticleMsg
+	//	*Tx_BlogUnpinArticleMsg
+	//	*Tx_BlogCreateSeriesMsg
+	//	*Tx_BlogAppendSeriesArticleMsg
+	//	*Tx_BlogReorderSeriesMsg
+	//	*Tx_BlogRemoveSeriesArticleMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -122,6 +139,21 @@ type Tx_ExecuteBatchMsg struct {
 type Tx_MigrationUpgradeSchemaMsg struct {
 	MigrationUpgradeSchemaMsg *migration.UpgradeSchemaMsg `protobuf:"bytes,69,opt,name=migration_upgrade_schema_msg,json=migrationUpgradeSchemaMsg,proto3,oneof"`
 }
+type Tx_GovCreateProposalMsg struct {
+	GovCreateProposalMsg *gov.CreateProposalMsg `protobuf:"bytes,73,opt,name=gov_create_proposal_msg,json=govCreateProposalMsg,proto3,oneof"`
+}
+type Tx_GovDeleteProposalMsg struct {
+	GovDeleteProposalMsg *gov.DeleteProposalMsg `protobuf:"bytes,74,opt,name=gov_delete_proposal_msg,json=govDeleteProposalMsg,proto3,oneof"`
+}
+type Tx_GovVoteMsg struct {
+	GovVoteMsg *gov.VoteMsg `protobuf:"bytes,75,opt,name=gov_vote_msg,json=govVoteMsg,proto3,oneof"`
+}
+type Tx_GovUpdateElectorateMsg struct {
+	GovUpdateElectorateMsg *gov.UpdateElectorateMsg `protobuf:"bytes,77,opt,name=gov_update_electorate_msg,json=govUpdateElectorateMsg,proto3,oneof"`
+}
+type Tx_GovUpdateElectionRuleMsg struct {
+	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
+}
 type Tx_BlogCreateUserMsg struct {
 	BlogCreateUserMsg *blog.CreateUserMsg `protobuf:"bytes,100,opt,name=blog_create_user_msg,json=blogCreateUserMsg,proto3,oneof"`
 }
@@ -140,6 +172,36 @@ type Tx_BlogDeleteArticleMsg struct {
 type Tx_BlogCancelDeleteArticleTaskMsg struct {
 	BlogCancelDeleteArticleTaskMsg *blog.CancelDeleteArticleTaskMsg `protobuf:"bytes,105,opt,name=blog_cancel_delete_article_task_msg,json=blogCancelDeleteArticleTaskMsg,proto3,oneof"`
 }
+type Tx_BlogHideArticleMsg struct {
+	BlogHideArticleMsg *blog.HideArticleMsg `protobuf:"bytes,106,opt,name=blog_hide_article_msg,json=blogHideArticleMsg,proto3,oneof"`
+}
+type Tx_BlogUpdateConfigurationMsg struct {
+	BlogUpdateConfigurationMsg *blog.UpdateConfigurationMsg `protobuf:"bytes,107,opt,name=blog_update_configuration_msg,json=blogUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_BlogCommissionArticleMsg struct {
+	BlogCommissionArticleMsg *blog.CommissionArticleMsg `protobuf:"bytes,108,opt,name=blog_commission_article_msg,json=blogCommissionArticleMsg,proto3,oneof"`
+}
+type Tx_BlogFulfillCommissionMsg struct {
+	BlogFulfillCommissionMsg *blog.FulfillCommissionMsg `protobuf:"bytes,109,opt,name=blog_fulfill_commission_msg,json=blogFulfillCommissionMsg,proto3,oneof"`
+}
+type Tx_BlogPinArticleMsg struct {
+	BlogPinArticleMsg *blog.PinArticleMsg `protobuf:"bytes,110,opt,name=blog_pin_article_msg,json=blogPinArticleMsg,proto3,oneof"`
+}
+type Tx_BlogUnpinArticleMsg struct {
+	BlogUnpinArticleMsg *blog.UnpinArticleMsg `protobuf:"bytes,111,opt,name=blog_unpin_article_msg,json=blogUnpinArticleMsg,proto3,oneof"`
+}
+type Tx_BlogCreateSeriesMsg struct {
+	BlogCreateSeriesMsg *blog.CreateSeriesMsg `protobuf:"bytes,112,opt,name=blog_create_series_msg,json=blogCreateSeriesMsg,proto3,oneof"`
+}
+type Tx_BlogAppendSeriesArticleMsg struct {
+	BlogAppendSeriesArticleMsg *blog.AppendSeriesArticleMsg `protobuf:"bytes,113,opt,name=blog_append_series_article_msg,json=blogAppendSeriesArticleMsg,proto3,oneof"`
+}
+type Tx_BlogReorderSeriesMsg struct {
+	BlogReorderSeriesMsg *blog.ReorderSeriesMsg `protobuf:"bytes,114,opt,name=blog_reorder_series_msg,json=blogReorderSeriesMsg,proto3,oneof"`
+}
+type Tx_BlogRemoveSeriesArticleMsg struct {
+	BlogRemoveSeriesArticleMsg *blog.RemoveSeriesArticleMsg `protobuf:"bytes,115,opt,name=blog_remove_series_article_msg,json=blogRemoveSeriesArticleMsg,proto3,oneof"`
+}
 
 func (*Tx_CashSendMsg) isTx_Sum()                    {}
 func (*Tx_MultisigCreateMsg) isTx_Sum()              {}
@@ -147,12 +209,27 @@ func (*Tx_MultisigUpdateMsg) isTx_Sum()              {}
 func (*Tx_ValidatorsApplyDiffMsg) isTx_Sum()         {}
 func (*Tx_ExecuteBatchMsg) isTx_Sum()                {}
 func (*Tx_MigrationUpgradeSchemaMsg) isTx_Sum()      {}
+func (*Tx_GovCreateProposalMsg) isTx_Sum()           {}
+func (*Tx_GovDeleteProposalMsg) isTx_Sum()           {}
+func (*Tx_GovVoteMsg) isTx_Sum()                     {}
+func (*Tx_GovUpdateElectorateMsg) isTx_Sum()         {}
+func (*Tx_GovUpdateElectionRuleMsg) isTx_Sum()       {}
 func (*Tx_BlogCreateUserMsg) isTx_Sum()              {}
 func (*Tx_BlogCreateBlogMsg) isTx_Sum()              {}
 func (*Tx_BlogChangeBlogOwnerMsg) isTx_Sum()         {}
 func (*Tx_BlogCreateArticleMsg) isTx_Sum()           {}
 func (*Tx_BlogDeleteArticleMsg) isTx_Sum()           {}
 func (*Tx_BlogCancelDeleteArticleTaskMsg) isTx_Sum() {}
+func (*Tx_BlogHideArticleMsg) isTx_Sum()             {}
+func (*Tx_BlogUpdateConfigurationMsg) isTx_Sum()     {}
+func (*Tx_BlogCommissionArticleMsg) isTx_Sum()       {}
+func (*Tx_BlogFulfillCommissionMsg) isTx_Sum()       {}
+func (*Tx_BlogPinArticleMsg) isTx_Sum()              {}
+func (*Tx_BlogUnpinArticleMsg) isTx_Sum()            {}
+func (*Tx_BlogCreateSeriesMsg) isTx_Sum()            {}
+func (*Tx_BlogAppendSeriesArticleMsg) isTx_Sum()     {}
+func (*Tx_BlogReorderSeriesMsg) isTx_Sum()           {}
+func (*Tx_BlogRemoveSeriesArticleMsg) isTx_Sum()     {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -224,6 +301,41 @@ func (m *Tx) GetMigrationUpgradeSchemaMsg() *migration.UpgradeSchemaMsg {
 	return nil
 }
 
+func (m *Tx) GetGovCreateProposalMsg() *gov.CreateProposalMsg {
+	if x, ok := m.GetSum().(*Tx_GovCreateProposalMsg); ok {
+		return x.GovCreateProposalMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovDeleteProposalMsg() *gov.DeleteProposalMsg {
+	if x, ok := m.GetSum().(*Tx_GovDeleteProposalMsg); ok {
+		return x.GovDeleteProposalMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovVoteMsg() *gov.VoteMsg {
+	if x, ok := m.GetSum().(*Tx_GovVoteMsg); ok {
+		return x.GovVoteMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovUpdateElectorateMsg() *gov.UpdateElectorateMsg {
+	if x, ok := m.GetSum().(*Tx_GovUpdateElectorateMsg); ok {
+		return x.GovUpdateElectorateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
+	if x, ok := m.GetSum().(*Tx_GovUpdateElectionRuleMsg); ok {
+		return x.GovUpdateElectionRuleMsg
+	}
+	return nil
+}
+
 func (m *Tx) GetBlogCreateUserMsg() *blog.CreateUserMsg {
 	if x, ok := m.GetSum().(*Tx_BlogCreateUserMsg); ok {
 		return x.BlogCreateUserMsg
@@ -266,6 +378,76 @@ func (m *Tx) GetBlogCancelDeleteArticleTaskMsg() *blog.CancelDeleteArticleTaskMs
 	return nil
 }
 
+func (m *Tx) GetBlogHideArticleMsg() *blog.HideArticleMsg {
+	if x, ok := m.GetSum().(*Tx_BlogHideArticleMsg); ok {
+		return x.BlogHideArticleMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogUpdateConfigurationMsg() *blog.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_BlogUpdateConfigurationMsg); ok {
+		return x.BlogUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogCommissionArticleMsg() *blog.CommissionArticleMsg {
+	if x, ok := m.GetSum().(*Tx_BlogCommissionArticleMsg); ok {
+		return x.BlogCommissionArticleMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogFulfillCommissionMsg() *blog.FulfillCommissionMsg {
+	if x, ok := m.GetSum().(*Tx_BlogFulfillCommissionMsg); ok {
+		return x.BlogFulfillCommissionMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogPinArticleMsg() *blog.PinArticleMsg {
+	if x, ok := m.GetSum().(*Tx_BlogPinArticleMsg); ok {
+		return x.BlogPinArticleMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogUnpinArticleMsg() *blog.UnpinArticleMsg {
+	if x, ok := m.GetSum().(*Tx_BlogUnpinArticleMsg); ok {
+		return x.BlogUnpinArticleMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogCreateSeriesMsg() *blog.CreateSeriesMsg {
+	if x, ok := m.GetSum().(*Tx_BlogCreateSeriesMsg); ok {
+		return x.BlogCreateSeriesMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogAppendSeriesArticleMsg() *blog.AppendSeriesArticleMsg {
+	if x, ok := m.GetSum().(*Tx_BlogAppendSeriesArticleMsg); ok {
+		return x.BlogAppendSeriesArticleMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogReorderSeriesMsg() *blog.ReorderSeriesMsg {
+	if x, ok := m.GetSum().(*Tx_BlogReorderSeriesMsg); ok {
+		return x.BlogReorderSeriesMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetBlogRemoveSeriesArticleMsg() *blog.RemoveSeriesArticleMsg {
+	if x, ok := m.GetSum().(*Tx_BlogRemoveSeriesArticleMsg); ok {
+		return x.BlogRemoveSeriesArticleMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -275,12 +457,27 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_ValidatorsApplyDiffMsg)(nil),
 		(*Tx_ExecuteBatchMsg)(nil),
 		(*Tx_MigrationUpgradeSchemaMsg)(nil),
+		(*Tx_GovCreateProposalMsg)(nil),
+		(*Tx_GovDeleteProposalMsg)(nil),
+		(*Tx_GovVoteMsg)(nil),
+		(*Tx_GovUpdateElectorateMsg)(nil),
+		(*Tx_GovUpdateElectionRuleMsg)(nil),
 		(*Tx_BlogCreateUserMsg)(nil),
 		(*Tx_BlogCreateBlogMsg)(nil),
 		(*Tx_BlogChangeBlogOwnerMsg)(nil),
 		(*Tx_BlogCreateArticleMsg)(nil),
 		(*Tx_BlogDeleteArticleMsg)(nil),
 		(*Tx_BlogCancelDeleteArticleTaskMsg)(nil),
+		(*Tx_BlogHideArticleMsg)(nil),
+		(*Tx_BlogUpdateConfigurationMsg)(nil),
+		(*Tx_BlogCommissionArticleMsg)(nil),
+		(*Tx_BlogFulfillCommissionMsg)(nil),
+		(*Tx_BlogPinArticleMsg)(nil),
+		(*Tx_BlogUnpinArticleMsg)(nil),
+		(*Tx_BlogCreateSeriesMsg)(nil),
+		(*Tx_BlogAppendSeriesArticleMsg)(nil),
+		(*Tx_BlogReorderSeriesMsg)(nil),
+		(*Tx_BlogRemoveSeriesArticleMsg)(nil),
 	}
 }
 
@@ -318,6 +515,31 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MigrationUpgradeSchemaMsg); err != nil {
 			return err
 		}
+	case *Tx_GovCreateProposalMsg:
+		_ = b.EncodeVarint(73<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCreateProposalMsg); err != nil {
+			return err
+		}
+	case *Tx_GovDeleteProposalMsg:
+		_ = b.EncodeVarint(74<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovDeleteProposalMsg); err != nil {
+			return err
+		}
+	case *Tx_GovVoteMsg:
+		_ = b.EncodeVarint(75<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovVoteMsg); err != nil {
+			return err
+		}
+	case *Tx_GovUpdateElectorateMsg:
+		_ = b.EncodeVarint(77<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectorateMsg); err != nil {
+			return err
+		}
+	case *Tx_GovUpdateElectionRuleMsg:
+		_ = b.EncodeVarint(78<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
+			return err
+		}
 	case *Tx_BlogCreateUserMsg:
 		_ = b.EncodeVarint(100<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.BlogCreateUserMsg); err != nil {
@@ -348,6 +570,56 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.BlogCancelDeleteArticleTaskMsg); err != nil {
 			return err
 		}
+	case *Tx_BlogHideArticleMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogHideArticleMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogUpdateConfigurationMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogCommissionArticleMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogCommissionArticleMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogFulfillCommissionMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogFulfillCommissionMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogPinArticleMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogPinArticleMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogUnpinArticleMsg:
+		_ = b.EncodeVarint(111<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogUnpinArticleMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogCreateSeriesMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogCreateSeriesMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogAppendSeriesArticleMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogAppendSeriesArticleMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogReorderSeriesMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogReorderSeriesMsg); err != nil {
+			return err
+		}
+	case *Tx_BlogRemoveSeriesArticleMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogRemoveSeriesArticleMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -406,6 +678,46 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_MigrationUpgradeSchemaMsg{msg}
 		return true, err
+	case 73: // sum.gov_create_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CreateProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovCreateProposalMsg{msg}
+		return true, err
+	case 74: // sum.gov_delete_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.DeleteProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovDeleteProposalMsg{msg}
+		return true, err
+	case 75: // sum.gov_vote_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.VoteMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovVoteMsg{msg}
+		return true, err
+	case 77: // sum.gov_update_electorate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectorateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovUpdateElectorateMsg{msg}
+		return true, err
+	case 78: // sum.gov_update_election_rule_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectionRuleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovUpdateElectionRuleMsg{msg}
+		return true, err
 	case 100: // sum.blog_create_user_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
@@ -454,6 +766,86 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_BlogCancelDeleteArticleTaskMsg{msg}
 		return true, err
+	case 106: // sum.blog_hide_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.HideArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogHideArticleMsg{msg}
+		return true, err
+	case 107: // sum.blog_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogUpdateConfigurationMsg{msg}
+		return true, err
+	case 108: // sum.blog_commission_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.CommissionArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogCommissionArticleMsg{msg}
+		return true, err
+	case 109: // sum.blog_fulfill_commission_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.FulfillCommissionMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogFulfillCommissionMsg{msg}
+		return true, err
+	case 110: // sum.blog_pin_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.PinArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogPinArticleMsg{msg}
+		return true, err
+	case 111: // sum.blog_unpin_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.UnpinArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogUnpinArticleMsg{msg}
+		return true, err
+	case 112: // sum.blog_create_series_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.CreateSeriesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogCreateSeriesMsg{msg}
+		return true, err
+	case 113: // sum.blog_append_series_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.AppendSeriesArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogAppendSeriesArticleMsg{msg}
+		return true, err
+	case 114: // sum.blog_reorder_series_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.ReorderSeriesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogReorderSeriesMsg{msg}
+		return true, err
+	case 115: // sum.blog_remove_series_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.RemoveSeriesArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_BlogRemoveSeriesArticleMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -493,6 +885,31 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_GovCreateProposalMsg:
+		s := proto.Size(x.GovCreateProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovDeleteProposalMsg:
+		s := proto.Size(x.GovDeleteProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovVoteMsg:
+		s := proto.Size(x.GovVoteMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovUpdateElectorateMsg:
+		s := proto.Size(x.GovUpdateElectorateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovUpdateElectionRuleMsg:
+		s := proto.Size(x.GovUpdateElectionRuleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case *Tx_BlogCreateUserMsg:
 		s := proto.Size(x.BlogCreateUserMsg)
 		n += 2 // tag and wire
@@ -523,6 +940,56 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Tx_BlogHideArticleMsg:
+		s := proto.Size(x.BlogHideArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogUpdateConfigurationMsg:
+		s := proto.Size(x.BlogUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogCommissionArticleMsg:
+		s := proto.Size(x.BlogCommissionArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogFulfillCommissionMsg:
+		s := proto.Size(x.BlogFulfillCommissionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogPinArticleMsg:
+		s := proto.Size(x.BlogPinArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogUnpinArticleMsg:
+		s := proto.Size(x.BlogUnpinArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogCreateSeriesMsg:
+		s := proto.Size(x.BlogCreateSeriesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogAppendSeriesArticleMsg:
+		s := proto.Size(x.BlogAppendSeriesArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogReorderSeriesMsg:
+		s := proto.Size(x.BlogReorderSeriesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_BlogRemoveSeriesArticleMsg:
+		s := proto.Size(x.BlogRemoveSeriesArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -582,6 +1049,7 @@ type ExecuteBatchMsg_Union struct {
 	//	*ExecuteBatchMsg_Union_CashSendMsg
 	//	*ExecuteBatchMsg_Union_MultisigCreateMsg
 	//	*ExecuteBatchMsg_Union_MultisigUpdateMsg
+	//	*ExecuteBatchMsg_Union_BlogCreateArticleMsg
 	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -633,10 +1101,14 @@ type ExecuteBatchMsg_Union_MultisigCreateMsg struct {
 type ExecuteBatchMsg_Union_MultisigUpdateMsg struct {
 	MultisigUpdateMsg *multisig.UpdateMsg `protobuf:"bytes,57,opt,name=multisig_update_msg,json=multisigUpdateMsg,proto3,oneof"`
 }
+type ExecuteBatchMsg_Union_BlogCreateArticleMsg struct {
+	BlogCreateArticleMsg *blog.CreateArticleMsg `protobuf:"bytes,103,opt,name=blog_create_article_msg,json=blogCreateArticleMsg,proto3,oneof"`
+}
 
-func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()       {}
-func (*ExecuteBatchMsg_Union_MultisigCreateMsg) isExecuteBatchMsg_Union_Sum() {}
-func (*ExecuteBatchMsg_Union_MultisigUpdateMsg) isExecuteBatchMsg_Union_Sum() {}
+func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_MultisigCreateMsg) isExecuteBatchMsg_Union_Sum()    {}
+func (*ExecuteBatchMsg_Union_MultisigUpdateMsg) isExecuteBatchMsg_Union_Sum()    {}
+func (*ExecuteBatchMsg_Union_BlogCreateArticleMsg) isExecuteBatchMsg_Union_Sum() {}
 
 func (m *ExecuteBatchMsg_Union) GetSum() isExecuteBatchMsg_Union_Sum {
 	if m != nil {
@@ -666,12 +1138,20 @@ func (m *ExecuteBatchMsg_Union) GetMultisigUpdateMsg() *multisig.UpdateMsg {
 	return nil
 }
 
+func (m *ExecuteBatchMsg_Union) GetBlogCreateArticleMsg() *blog.CreateArticleMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_BlogCreateArticleMsg); ok {
+		return x.BlogCreateArticleMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
 		(*ExecuteBatchMsg_Union_CashSendMsg)(nil),
 		(*ExecuteBatchMsg_Union_MultisigCreateMsg)(nil),
 		(*ExecuteBatchMsg_Union_MultisigUpdateMsg)(nil),
+		(*ExecuteBatchMsg_Union_BlogCreateArticleMsg)(nil),
 	}
 }
 
@@ -694,6 +1174,11 @@ func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) e
 		if err := b.EncodeMessage(x.MultisigUpdateMsg); err != nil {
 			return err
 		}
+	case *ExecuteBatchMsg_Union_BlogCreateArticleMsg:
+		_ = b.EncodeVarint(103<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogCreateArticleMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteBatchMsg_Union.Sum has unexpected type %T", x)
@@ -728,6 +1213,14 @@ func _ExecuteBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{msg}
 		return true, err
+	case 103: // sum.blog_create_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.CreateArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_BlogCreateArticleMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -752,6 +1245,11 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteBatchMsg_Union_BlogCreateArticleMsg:
+		s := proto.Size(x.BlogCreateArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -759,39 +1257,32 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-// CronTask is a format used by the CronMarshaler to marshal and unmarshal cron
-// task.
-//
-// When there is a gap in message sequence numbers - that most likely means some
-// old fields got deprecated. This is done to maintain binary compatibility.
-type CronTask struct {
-	// Authenticators contains a list of conditions that authenticate execution
-	// of this task.
-	// This is one of the main differences between the CronTask and Tx entities.
-	// CronTask is created interanlly and does not have to be signed. Because we
-	// use the same handlers as for the Tx to process a cron task, we must
-	// provide authentication method. This attribute contains all authentication
-	// conditions required for execution, that will be inserted into the context.
-	Authenticators []github_com_iov_one_weave.Condition `protobuf:"bytes,1,rep,name=authenticators,proto3,casttype=github.com/iov-one/weave.Condition" json:"authenticators,omitempty"`
-	// Use the same indexes for the messages as the Tx message.
-	//
-	// Types that are valid to be assigned to Sum:
-	//	*CronTask_BlogDeleteArticleMsg
-	Sum isCronTask_Sum `protobuf_oneof:"sum"`
+// ProposalOptions are possible items that can be enacted by a governance vote
+// Blog moderation decisions are voted on by the moderators electorate.
+type ProposalOptions struct {
+	// Types that are valid to be assigned to Option:
+	//	*ProposalOptions_ExecuteProposalBatchMsg
+	//	*ProposalOptions_GovUpdateElectorateMsg
+	//	*ProposalOptions_GovUpdateElectionRuleMsg
+	//	*ProposalOptions_GovCreateTextResolutionMsg
+	//	*ProposalOptions_BlogChangeBlogOwnerMsg
+	//	*ProposalOptions_BlogHideArticleMsg
+	//	*ProposalOptions_BlogUpdateConfigurationMsg
+	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
-func (m *CronTask) Reset()         { *m = CronTask{} }
-func (m *CronTask) String() string { return proto.CompactTextString(m) }
-func (*CronTask) ProtoMessage()    {}
-func (*CronTask) Descriptor() ([]byte, []int) {
+func (m *ProposalOptions) Reset()         { *m = ProposalOptions{} }
+func (m *ProposalOptions) String() string { return proto.CompactTextString(m) }
+func (*ProposalOptions) ProtoMessage()    {}
+func (*ProposalOptions) Descriptor() ([]byte, []int) {
 	return fileDescriptor_96adc38df3c83d28, []int{2}
 }
-func (m *CronTask) XXX_Unmarshal(b []byte) error {
+func (m *ProposalOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CronTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProposalOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CronTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProposalOptions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -801,96 +1292,269 @@ func (m *CronTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CronTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CronTask.Merge(m, src)
+func (m *ProposalOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalOptions.Merge(m, src)
 }
-func (m *CronTask) XXX_Size() int {
+func (m *ProposalOptions) XXX_Size() int {
 	return m.Size()
 }
-func (m *CronTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_CronTask.DiscardUnknown(m)
+func (m *ProposalOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CronTask proto.InternalMessageInfo
+var xxx_messageInfo_ProposalOptions proto.InternalMessageInfo
 
-type isCronTask_Sum interface {
-	isCronTask_Sum()
+type isProposalOptions_Option interface {
+	isProposalOptions_Option()
 	MarshalTo([]byte) (int, error)
 	Size() int
 }
 
-type CronTask_BlogDeleteArticleMsg struct {
-	BlogDeleteArticleMsg *blog.DeleteArticleMsg `protobuf:"bytes,120,opt,name=blog_delete_article_msg,json=blogDeleteArticleMsg,proto3,oneof"`
+type ProposalOptions_ExecuteProposalBatchMsg struct {
+	ExecuteProposalBatchMsg *ExecuteProposalBatchMsg `protobuf:"bytes,60,opt,name=execute_proposal_batch_msg,json=executeProposalBatchMsg,proto3,oneof"`
+}
+type ProposalOptions_GovUpdateElectorateMsg struct {
+	GovUpdateElectorateMsg *gov.UpdateElectorateMsg `protobuf:"bytes,77,opt,name=gov_update_electorate_msg,json=govUpdateElectorateMsg,proto3,oneof"`
+}
+type ProposalOptions_GovUpdateElectionRuleMsg struct {
+	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
+}
+type ProposalOptions_GovCreateTextResolutionMsg struct {
+	GovCreateTextResolutionMsg *gov.CreateTextResolutionMsg `protobuf:"bytes,79,opt,name=gov_create_text_resolution_msg,json=govCreateTextResolutionMsg,proto3,oneof"`
+}
+type ProposalOptions_BlogChangeBlogOwnerMsg struct {
+	BlogChangeBlogOwnerMsg *blog.ChangeBlogOwnerMsg `protobuf:"bytes,102,opt,name=blog_change_blog_owner_msg,json=blogChangeBlogOwnerMsg,proto3,oneof"`
+}
+type ProposalOptions_BlogHideArticleMsg struct {
+	BlogHideArticleMsg *blog.HideArticleMsg `protobuf:"bytes,106,opt,name=blog_hide_article_msg,json=blogHideArticleMsg,proto3,oneof"`
+}
+type ProposalOptions_BlogUpdateConfigurationMsg struct {
+	BlogUpdateConfigurationMsg *blog.UpdateConfigurationMsg `protobuf:"bytes,107,opt,name=blog_update_configuration_msg,json=blogUpdateConfigurationMsg,proto3,oneof"`
 }
 
-func (*CronTask_BlogDeleteArticleMsg) isCronTask_Sum() {}
+func (*ProposalOptions_ExecuteProposalBatchMsg) isProposalOptions_Option()    {}
+func (*ProposalOptions_GovUpdateElectorateMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_GovUpdateElectionRuleMsg) isProposalOptions_Option()   {}
+func (*ProposalOptions_GovCreateTextResolutionMsg) isProposalOptions_Option() {}
+func (*ProposalOptions_BlogChangeBlogOwnerMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_BlogHideArticleMsg) isProposalOptions_Option()         {}
+func (*ProposalOptions_BlogUpdateConfigurationMsg) isProposalOptions_Option() {}
 
-func (m *CronTask) GetSum() isCronTask_Sum {
+func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
-		return m.Sum
+		return m.Option
 	}
 	return nil
 }
 
-func (m *CronTask) GetAuthenticators() []github_com_iov_one_weave.Condition {
-	if m != nil {
-		return m.Authenticators
+func (m *ProposalOptions) GetExecuteProposalBatchMsg() *ExecuteProposalBatchMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_ExecuteProposalBatchMsg); ok {
+		return x.ExecuteProposalBatchMsg
 	}
 	return nil
 }
 
-func (m *CronTask) GetBlogDeleteArticleMsg() *blog.DeleteArticleMsg {
-	if x, ok := m.GetSum().(*CronTask_BlogDeleteArticleMsg); ok {
-		return x.BlogDeleteArticleMsg
+func (m *ProposalOptions) GetGovUpdateElectorateMsg() *gov.UpdateElectorateMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovUpdateElectorateMsg); ok {
+		return x.GovUpdateElectorateMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovUpdateElectionRuleMsg); ok {
+		return x.GovUpdateElectionRuleMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovCreateTextResolutionMsg() *gov.CreateTextResolutionMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovCreateTextResolutionMsg); ok {
+		return x.GovCreateTextResolutionMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetBlogChangeBlogOwnerMsg() *blog.ChangeBlogOwnerMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_BlogChangeBlogOwnerMsg); ok {
+		return x.BlogChangeBlogOwnerMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetBlogHideArticleMsg() *blog.HideArticleMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_BlogHideArticleMsg); ok {
+		return x.BlogHideArticleMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetBlogUpdateConfigurationMsg() *blog.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_BlogUpdateConfigurationMsg); ok {
+		return x.BlogUpdateConfigurationMsg
 	}
 	return nil
 }
 
 // XXX_OneofFuncs is for the internal use of the proto package.
-func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
-		(*CronTask_BlogDeleteArticleMsg)(nil),
+func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
+		(*ProposalOptions_ExecuteProposalBatchMsg)(nil),
+		(*ProposalOptions_GovUpdateElectorateMsg)(nil),
+		(*ProposalOptions_GovUpdateElectionRuleMsg)(nil),
+		(*ProposalOptions_GovCreateTextResolutionMsg)(nil),
+		(*ProposalOptions_BlogChangeBlogOwnerMsg)(nil),
+		(*ProposalOptions_BlogHideArticleMsg)(nil),
+		(*ProposalOptions_BlogUpdateConfigurationMsg)(nil),
 	}
 }
 
-func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*CronTask)
-	// sum
-	switch x := m.Sum.(type) {
-	case *CronTask_BlogDeleteArticleMsg:
-		_ = b.EncodeVarint(120<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.BlogDeleteArticleMsg); err != nil {
+func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ProposalOptions)
+	// option
+	switch x := m.Option.(type) {
+	case *ProposalOptions_ExecuteProposalBatchMsg:
+		_ = b.EncodeVarint(60<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ExecuteProposalBatchMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovUpdateElectorateMsg:
+		_ = b.EncodeVarint(77<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectorateMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovUpdateElectionRuleMsg:
+		_ = b.EncodeVarint(78<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovCreateTextResolutionMsg:
+		_ = b.EncodeVarint(79<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCreateTextResolutionMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_BlogChangeBlogOwnerMsg:
+		_ = b.EncodeVarint(102<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogChangeBlogOwnerMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_BlogHideArticleMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogHideArticleMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_BlogUpdateConfigurationMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogUpdateConfigurationMsg); err != nil {
 			return err
 		}
 	case nil:
 	default:
-		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
+		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
 	}
 	return nil
 }
 
-func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*CronTask)
+func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ProposalOptions)
 	switch tag {
-	case 120: // sum.blog_delete_article_msg
+	case 60: // option.execute_proposal_batch_msg
 		if wire != proto.WireBytes {
 			return true, proto.ErrInternalBadWireType
 		}
-		msg := new(blog.DeleteArticleMsg)
+		msg := new(ExecuteProposalBatchMsg)
 		err := b.DecodeMessage(msg)
-		m.Sum = &CronTask_BlogDeleteArticleMsg{msg}
+		m.Option = &ProposalOptions_ExecuteProposalBatchMsg{msg}
+		return true, err
+	case 77: // option.gov_update_electorate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectorateMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovUpdateElectorateMsg{msg}
+		return true, err
+	case 78: // option.gov_update_election_rule_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectionRuleMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{msg}
+		return true, err
+	case 79: // option.gov_create_text_resolution_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CreateTextResolutionMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovCreateTextResolutionMsg{msg}
+		return true, err
+	case 102: // option.blog_change_blog_owner_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.ChangeBlogOwnerMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_BlogChangeBlogOwnerMsg{msg}
+		return true, err
+	case 106: // option.blog_hide_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.HideArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_BlogHideArticleMsg{msg}
+		return true, err
+	case 107: // option.blog_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_BlogUpdateConfigurationMsg{msg}
 		return true, err
 	default:
 		return false, nil
 	}
 }
 
-func _CronTask_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*CronTask)
-	// sum
-	switch x := m.Sum.(type) {
-	case *CronTask_BlogDeleteArticleMsg:
-		s := proto.Size(x.BlogDeleteArticleMsg)
+func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ProposalOptions)
+	// option
+	switch x := m.Option.(type) {
+	case *ProposalOptions_ExecuteProposalBatchMsg:
+		s := proto.Size(x.ExecuteProposalBatchMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovUpdateElectorateMsg:
+		s := proto.Size(x.GovUpdateElectorateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovUpdateElectionRuleMsg:
+		s := proto.Size(x.GovUpdateElectionRuleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovCreateTextResolutionMsg:
+		s := proto.Size(x.GovCreateTextResolutionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_BlogChangeBlogOwnerMsg:
+		s := proto.Size(x.BlogChangeBlogOwnerMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_BlogHideArticleMsg:
+		s := proto.Size(x.BlogHideArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_BlogUpdateConfigurationMsg:
+		s := proto.Size(x.BlogUpdateConfigurationMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
@@ -901,784 +1565,4222 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-func init() {
-	proto.RegisterType((*Tx)(nil), "blog.Tx")
-	proto.RegisterType((*ExecuteBatchMsg)(nil), "blog.ExecuteBatchMsg")
-	proto.RegisterType((*ExecuteBatchMsg_Union)(nil), "blog.ExecuteBatchMsg.Union")
-	proto.RegisterType((*CronTask)(nil), "blog.CronTask")
+type ExecuteProposalBatchMsg struct {
+	Messages []ExecuteProposalBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
 }
 
-func init() { proto.RegisterFile("cmd/blog/app/codec.proto", fileDescriptor_96adc38df3c83d28) }
-
-var fileDescriptor_96adc38df3c83d28 = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x95, 0x41, 0x4f, 0xdb, 0x48,
-	0x14, 0xc7, 0x13, 0x12, 0x56, 0xd1, 0x00, 0x8b, 0x18, 0x58, 0x36, 0x64, 0x57, 0x21, 0xcb, 0x4a,
-	0x2b, 0xa4, 0x55, 0xc7, 0x2a, 0x5c, 0xda, 0xaa, 0x3d, 0x90, 0x00, 0x6a, 0x0f, 0x2d, 0x52, 0x42,
-	0x7a, 0xac, 0x35, 0xb1, 0x27, 0x93, 0x11, 0xb6, 0x27, 0xf2, 0xd8, 0x90, 0x7e, 0x8b, 0x7e, 0x88,
-	0x7e, 0x82, 0x9e, 0xfa, 0x11, 0x38, 0xd2, 0x5b, 0x4f, 0xa8, 0x82, 0x6f, 0xd1, 0x53, 0x35, 0x6f,
-	0x6c, 0xc7, 0x71, 0x0a, 0xaa, 0x7a, 0xec, 0x6d, 0xe6, 0xfd, 0xff, 0xef, 0x37, 0x2f, 0x6f, 0x9e,
-	0x27, 0xa8, 0xee, 0xf8, 0xae, 0x35, 0xf0, 0x24, 0xb7, 0xe8, 0x78, 0x6c, 0x39, 0xd2, 0x65, 0x0e,
-	0x19, 0x87, 0x32, 0x92, 0xb8, 0xaa, 0xa3, 0x0d, 0xc2, 0x45, 0x34, 0x8a, 0x07, 0xc4, 0x91, 0xbe,
-	0x25, 0xe4, 0xf9, 0x03, 0x19, 0x30, 0xeb, 0x82, 0xd1, 0x73, 0x66, 0xf9, 0x82, 0x87, 0x34, 0x12,
-	0x32, 0xc8, 0x67, 0x35, 0xfe, 0xbf, 0xd3, 0x3f, 0xb1, 0x1c, 0xaa, 0x46, 0x33, 0x66, 0xeb, 0x1e,
-	0xb3, 0x1f, 0x7b, 0x91, 0x50, 0x82, 0xff, 0x30, 0x5d, 0x09, 0xae, 0x66, 0xcc, 0x0f, 0xef, 0x31,
-	0x9f, 0x53, 0x4f, 0xb8, 0x34, 0x92, 0xe1, 0x6c, 0xca, 0x06, 0x97, 0x5c, 0xc2, 0xd2, 0xd2, 0xab,
-	0x24, 0x8a, 0x27, 0xa6, 0x43, 0x39, 0xe7, 0xce, 0xc7, 0x1a, 0x5a, 0x38, 0x9d, 0xe0, 0x7f, 0x50,
-	0x75, 0xc8, 0x98, 0xaa, 0x97, 0x5b, 0xe5, 0xdd, 0xa5, 0xbd, 0x15, 0xa2, 0x7f, 0x22, 0x39, 0x66,
-	0xec, 0x45, 0x30, 0x94, 0x5d, 0x90, 0xf0, 0x1e, 0x42, 0x4a, 0xf0, 0x80, 0x46, 0x71, 0xc8, 0x54,
-	0x7d, 0xa1, 0x55, 0xd9, 0x5d, 0xda, 0xc3, 0x44, 0x57, 0x4b, 0x7a, 0x91, 0xdb, 0x4b, 0xa5, 0x6e,
-	0xce, 0x85, 0x1b, 0xa8, 0x96, 0xfe, 0xfe, 0x7a, 0xb5, 0x55, 0xd9, 0x5d, 0xee, 0x66, 0x7b, 0xbc,
-	0x8f, 0x56, 0xf4, 0x29, 0xb6, 0x62, 0x81, 0x6b, 0xfb, 0x8a, 0xd7, 0xf7, 0xf3, 0x67, 0xf7, 0x58,
-	0xe0, 0xbe, 0x54, 0xfc, 0x79, 0xa9, 0xbb, 0xa4, 0xf7, 0xc9, 0x16, 0x1f, 0xa1, 0xf5, 0x14, 0x60,
-	0x3b, 0x21, 0xa3, 0x11, 0x83, 0xd4, 0x47, 0x90, 0xba, 0x4e, 0x52, 0x8d, 0x74, 0x40, 0x33, 0x80,
-	0xb5, 0x34, 0x9a, 0x05, 0x67, 0x30, 0xf1, 0xd8, 0x4d, 0x31, 0x8f, 0x8b, 0x98, 0x3e, 0x68, 0x05,
-	0x4c, 0x16, 0xc4, 0x7d, 0xb4, 0x35, 0xbd, 0x00, 0x9b, 0x8e, 0xc7, 0xde, 0x5b, 0xdb, 0x15, 0xc3,
-	0x21, 0xc0, 0x9e, 0x00, 0xac, 0x4e, 0xa6, 0x0e, 0x72, 0xa0, 0x1d, 0x87, 0x62, 0x38, 0x34, 0xc4,
-	0xcd, 0xa9, 0x94, 0x57, 0x70, 0x07, 0xad, 0xb1, 0x09, 0x73, 0xe2, 0x88, 0xd9, 0x03, 0x1a, 0x39,
-	0x23, 0xc0, 0x3d, 0x05, 0xdc, 0x1f, 0x44, 0xdf, 0x20, 0x39, 0x32, 0x72, 0x5b, 0xab, 0x86, 0xb5,
-	0xca, 0x66, 0x43, 0xf8, 0x0d, 0xfa, 0x3b, 0x9b, 0x6c, 0x3b, 0x1e, 0xf3, 0x90, 0xba, 0xcc, 0x56,
-	0xce, 0x88, 0xf9, 0x14, 0x78, 0x47, 0xc0, 0xfb, 0x8b, 0x64, 0x26, 0xd2, 0x37, 0xa6, 0x1e, 0x78,
-	0x0c, 0x75, 0x2b, 0x53, 0x8b, 0x22, 0x3e, 0x46, 0x1b, 0xba, 0x94, 0xf4, 0x16, 0x62, 0xc5, 0x42,
-	0xe0, 0xba, 0x49, 0x0f, 0xa1, 0x4e, 0xd3, 0xf1, 0xbe, 0x62, 0x61, 0xd2, 0x43, 0x1d, 0x9d, 0x09,
-	0x16, 0x39, 0xb0, 0xd6, 0x1c, 0x36, 0xcf, 0x69, 0x7b, 0x92, 0xcf, 0x71, 0x92, 0x20, 0x7e, 0x8d,
-	0x1a, 0x86, 0x33, 0xa2, 0x01, 0x4f, 0x38, 0xf2, 0x22, 0x48, 0xaa, 0x1a, 0x26, 0x97, 0x61, 0x68,
-	0x60, 0xd1, 0x89, 0x27, 0xda, 0x90, 0x5c, 0x06, 0x20, 0xe7, 0x14, 0x7c, 0x82, 0xfe, 0xcc, 0xd7,
-	0x47, 0xc3, 0x48, 0x38, 0x9e, 0x19, 0x17, 0x0e, 0xd0, 0xcd, 0x7c, 0x89, 0x07, 0x46, 0x36, 0xc8,
-	0x8d, 0x69, 0x95, 0xd3, 0x78, 0x06, 0x74, 0x99, 0xc7, 0x0a, 0xc0, 0x51, 0x1e, 0x78, 0x08, 0xfa,
-	0x3c, 0xb0, 0x18, 0xc7, 0x12, 0xfd, 0x6b, 0x2a, 0xa4, 0x81, 0xc3, 0xbc, 0x22, 0x37, 0xa2, 0xea,
-	0x0c, 0xe0, 0x02, 0xe0, 0xad, 0xa4, 0x5a, 0xf0, 0xce, 0xa0, 0x4e, 0xa9, 0x3a, 0x33, 0xc7, 0x34,
-	0xa1, 0xee, 0x3b, 0x1d, 0xed, 0x45, 0x54, 0x51, 0xb1, 0xbf, 0xf3, 0x7e, 0x01, 0xad, 0x16, 0x06,
-	0x11, 0x3f, 0x43, 0x35, 0x9f, 0x29, 0x45, 0x39, 0xbc, 0x25, 0x15, 0x98, 0xb0, 0xef, 0x4d, 0x2c,
-	0xe9, 0x07, 0x42, 0x06, 0xed, 0xea, 0xe5, 0xf5, 0x76, 0xa9, 0x9b, 0xa5, 0x34, 0x3e, 0x95, 0xd1,
-	0x22, 0x28, 0xbf, 0xc0, 0xeb, 0x90, 0xb6, 0xe9, 0x43, 0x19, 0xd5, 0x3a, 0xa1, 0x0c, 0x74, 0xf7,
-	0xf0, 0x2b, 0xf4, 0x3b, 0x8d, 0xa3, 0x11, 0x0b, 0x22, 0xe1, 0xc0, 0x87, 0x0f, 0x5d, 0x5a, 0x6e,
-	0xff, 0xf7, 0xf5, 0x7a, 0x7b, 0xe7, 0xae, 0x77, 0x9e, 0x74, 0x64, 0xe0, 0x0a, 0xfd, 0x15, 0x76,
-	0x0b, 0xd9, 0xf7, 0x0d, 0xd3, 0xe4, 0x67, 0x86, 0x29, 0x29, 0xba, 0x5d, 0xbf, 0xbc, 0x69, 0x96,
-	0xaf, 0x6e, 0x9a, 0xe5, 0x2f, 0x37, 0xcd, 0xf2, 0xbb, 0xdb, 0x66, 0xe9, 0xea, 0xb6, 0x59, 0xfa,
-	0x7c, 0xdb, 0x2c, 0x0d, 0x7e, 0x83, 0xff, 0x8d, 0xfd, 0x6f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94,
-	0x8a, 0x55, 0x9e, 0x71, 0x07, 0x00, 0x00,
+func (m *ExecuteProposalBatchMsg) Reset()         { *m = ExecuteProposalBatchMsg{} }
+func (m *ExecuteProposalBatchMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteProposalBatchMsg) ProtoMessage()    {}
+func (*ExecuteProposalBatchMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96adc38df3c83d28, []int{3}
 }
-
-func (m *Tx) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ExecuteProposalBatchMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Tx) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Fees != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
-		n1, err := m.Fees.MarshalTo(dAtA[i:])
+func (m *ExecuteProposalBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteProposalBatchMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if len(m.Signatures) > 0 {
-		for _, msg := range m.Signatures {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.Multisig) > 0 {
-		for _, b := range m.Multisig {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Sum != nil {
-		nn2, err := m.Sum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn2
+}
+func (m *ExecuteProposalBatchMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteProposalBatchMsg.Merge(m, src)
+}
+func (m *ExecuteProposalBatchMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteProposalBatchMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteProposalBatchMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteProposalBatchMsg proto.InternalMessageInfo
+
+func (m *ExecuteProposalBatchMsg) GetMessages() []ExecuteProposalBatchMsg_Union {
+	if m != nil {
+		return m.Messages
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Tx_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n3, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+type ExecuteProposalBatchMsg_Union struct {
+	// Types that are valid to be assigned to Sum:
+	//	*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg
+	//	*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg
+	//	*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg
+	//	*ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg
+	//	*ExecuteProposalBatchMsg_Union_BlogHideArticleMsg
+	//	*ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg
+	Sum isExecuteProposalBatchMsg_Union_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Reset()         { *m = ExecuteProposalBatchMsg_Union{} }
+func (m *ExecuteProposalBatchMsg_Union) String() string { return proto.CompactTextString(m) }
+func (*ExecuteProposalBatchMsg_Union) ProtoMessage()    {}
+func (*ExecuteProposalBatchMsg_Union) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96adc38df3c83d28, []int{3, 0}
+}
+func (m *ExecuteProposalBatchMsg_Union) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteProposalBatchMsg_Union) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteProposalBatchMsg_Union.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
+		return b[:n], nil
 	}
-	return i, nil
 }
-func (m *Tx_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigCreateMsg != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n4, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
-	}
-	return i, nil
+func (m *ExecuteProposalBatchMsg_Union) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteProposalBatchMsg_Union.Merge(m, src)
 }
-func (m *Tx_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n5, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
-	return i, nil
+func (m *ExecuteProposalBatchMsg_Union) XXX_Size() int {
+	return m.Size()
 }
-func (m *Tx_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ValidatorsApplyDiffMsg != nil {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n6, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	return i, nil
+func (m *ExecuteProposalBatchMsg_Union) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteProposalBatchMsg_Union.DiscardUnknown(m)
 }
-func (m *Tx_ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ExecuteBatchMsg != nil {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteBatchMsg.Size()))
-		n7, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
-	}
-	return i, nil
+
+var xxx_messageInfo_ExecuteProposalBatchMsg_Union proto.InternalMessageInfo
+
+type isExecuteProposalBatchMsg_Union_Sum interface {
+	isExecuteProposalBatchMsg_Union_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
-func (m *Tx_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MigrationUpgradeSchemaMsg != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x4
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n8, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	return i, nil
+
+type ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg struct {
+	GovUpdateElectorateMsg *gov.UpdateElectorateMsg `protobuf:"bytes,77,opt,name=gov_update_electorate_msg,json=govUpdateElectorateMsg,proto3,oneof"`
 }
-func (m *Tx_BlogCreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlogCreateUserMsg != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateUserMsg.Size()))
-		n9, err := m.BlogCreateUserMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	return i, nil
+type ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg struct {
+	GovUpdateElectionRuleMsg *gov.UpdateElectionRuleMsg `protobuf:"bytes,78,opt,name=gov_update_election_rule_msg,json=govUpdateElectionRuleMsg,proto3,oneof"`
 }
-func (m *Tx_BlogCreateBlogMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlogCreateBlogMsg != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateBlogMsg.Size()))
-		n10, err := m.BlogCreateBlogMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
-	}
-	return i, nil
+type ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg struct {
+	GovCreateTextResolutionMsg *gov.CreateTextResolutionMsg `protobuf:"bytes,79,opt,name=gov_create_text_resolution_msg,json=govCreateTextResolutionMsg,proto3,oneof"`
 }
-func (m *Tx_BlogChangeBlogOwnerMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlogChangeBlogOwnerMsg != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.BlogChangeBlogOwnerMsg.Size()))
-		n11, err := m.BlogChangeBlogOwnerMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
-	}
-	return i, nil
+type ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg struct {
+	BlogChangeBlogOwnerMsg *blog.ChangeBlogOwnerMsg `protobuf:"bytes,102,opt,name=blog_change_blog_owner_msg,json=blogChangeBlogOwnerMsg,proto3,oneof"`
 }
-func (m *Tx_BlogCreateArticleMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlogCreateArticleMsg != nil {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateArticleMsg.Size()))
-		n12, err := m.BlogCreateArticleMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
-	}
-	return i, nil
+type ExecuteProposalBatchMsg_Union_BlogHideArticleMsg struct {
+	BlogHideArticleMsg *blog.HideArticleMsg `protobuf:"bytes,106,opt,name=blog_hide_article_msg,json=blogHideArticleMsg,proto3,oneof"`
 }
-func (m *Tx_BlogDeleteArticleMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlogDeleteArticleMsg != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.BlogDeleteArticleMsg.Size()))
-		n13, err := m.BlogDeleteArticleMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
-	}
-	return i, nil
+type ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg struct {
+	BlogUpdateConfigurationMsg *blog.UpdateConfigurationMsg `protobuf:"bytes,107,opt,name=blog_update_configuration_msg,json=blogUpdateConfigurationMsg,proto3,oneof"`
 }
-func (m *Tx_BlogCancelDeleteArticleTaskMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlogCancelDeleteArticleTaskMsg != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x6
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCancelDeleteArticleTaskMsg.Size()))
-		n14, err := m.BlogCancelDeleteArticleTaskMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
+
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+func (*ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_BlogHideArticleMsg) isExecuteProposalBatchMsg_Union_Sum()     {}
+func (*ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg) isExecuteProposalBatchMsg_Union_Sum() {
+}
+
+func (m *ExecuteProposalBatchMsg_Union) GetSum() isExecuteProposalBatchMsg_Union_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	return i, nil
+	return nil
 }
-func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func (m *ExecuteProposalBatchMsg_Union) GetGovUpdateElectorateMsg() *gov.UpdateElectorateMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg); ok {
+		return x.GovUpdateElectorateMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *ExecuteProposalBatchMsg_Union) GetGovUpdateElectionRuleMsg() *gov.UpdateElectionRuleMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg); ok {
+		return x.GovUpdateElectionRuleMsg
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ExecuteProposalBatchMsg_Union) GetGovCreateTextResolutionMsg() *gov.CreateTextResolutionMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg); ok {
+		return x.GovCreateTextResolutionMsg
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn15, err := m.Sum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn15
+func (m *ExecuteProposalBatchMsg_Union) GetBlogChangeBlogOwnerMsg() *blog.ChangeBlogOwnerMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg); ok {
+		return x.BlogChangeBlogOwnerMsg
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n16, err := m.CashSendMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
+func (m *ExecuteProposalBatchMsg_Union) GetBlogHideArticleMsg() *blog.HideArticleMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_BlogHideArticleMsg); ok {
+		return x.BlogHideArticleMsg
 	}
-	return i, nil
+	return nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigCreateMsg != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n17, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
+
+func (m *ExecuteProposalBatchMsg_Union) GetBlogUpdateConfigurationMsg() *blog.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg); ok {
+		return x.BlogUpdateConfigurationMsg
 	}
-	return i, nil
+	return nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n18, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
+		(*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_BlogHideArticleMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg)(nil),
 	}
-	return i, nil
 }
-func (m *CronTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func _ExecuteProposalBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ExecuteProposalBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg:
+		_ = b.EncodeVarint(77<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectorateMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg:
+		_ = b.EncodeVarint(78<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovUpdateElectionRuleMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg:
+		_ = b.EncodeVarint(79<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovCreateTextResolutionMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg:
+		_ = b.EncodeVarint(102<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogChangeBlogOwnerMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_BlogHideArticleMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogHideArticleMsg); err != nil {
+			return err
+		}
+	case *ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ExecuteProposalBatchMsg_Union.Sum has unexpected type %T", x)
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
+func _ExecuteProposalBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ExecuteProposalBatchMsg_Union)
+	switch tag {
+	case 77: // sum.gov_update_electorate_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
 		}
-	}
-	if m.Sum != nil {
-		nn19, err := m.Sum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		msg := new(gov.UpdateElectorateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{msg}
+		return true, err
+	case 78: // sum.gov_update_election_rule_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.UpdateElectionRuleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{msg}
+		return true, err
+	case 79: // sum.gov_create_text_resolution_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.CreateTextResolutionMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{msg}
+		return true, err
+	case 102: // sum.blog_change_blog_owner_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.ChangeBlogOwnerMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg{msg}
+		return true, err
+	case 106: // sum.blog_hide_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.HideArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_BlogHideArticleMsg{msg}
+		return true, err
+	case 107: // sum.blog_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
 		}
-		i += nn19
+		msg := new(blog.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return i, nil
 }
 
-func (m *CronTask_BlogDeleteArticleMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlogDeleteArticleMsg != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x7
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.BlogDeleteArticleMsg.Size()))
-		n20, err := m.BlogDeleteArticleMsg.MarshalTo(dAtA[i:])
+func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExecuteProposalBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg:
+		s := proto.Size(x.GovUpdateElectorateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg:
+		s := proto.Size(x.GovUpdateElectionRuleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg:
+		s := proto.Size(x.GovCreateTextResolutionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg:
+		s := proto.Size(x.BlogChangeBlogOwnerMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_BlogHideArticleMsg:
+		s := proto.Size(x.BlogHideArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg:
+		s := proto.Size(x.BlogUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// CronTask is a format used by the CronMarshaler to marshal and unmarshal cron
+// task.
+//
+// When there is a gap in message sequence numbers - that most likely means some
+// old fields got deprecated. This is done to maintain binary compatibility.
+type CronTask struct {
+	// Authenticators contains a list of conditions that authenticate execution
+	// of this task.
+	// This is one of the main differences between the CronTask and Tx entities.
+	// CronTask is created interanlly and does not have to be signed. Because we
+	// use the same handlers as for the Tx to process a cron task, we must
+	// provide authentication method. This attribute contains all authentication
+	// conditions required for execution, that will be inserted into the context.
+	Authenticators []github_com_iov_one_weave.Condition `protobuf:"bytes,1,rep,name=authenticators,proto3,casttype=github.com/iov-one/weave.Condition" json:"authenticators,omitempty"`
+	// Use the same indexes for the messages as the Tx message.
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*CronTask_GovTallyMsg
+	//	*CronTask_BlogDeleteArticleMsg
+	//	*CronTask_BlogRefundCommissionMsg
+	//	*CronTask_BlogExpireRateLimitMsg
+	Sum isCronTask_Sum `protobuf_oneof:"sum"`
+}
+
+func (m *CronTask) Reset()         { *m = CronTask{} }
+func (m *CronTask) String() string { return proto.CompactTextString(m) }
+func (*CronTask) ProtoMessage()    {}
+func (*CronTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96adc38df3c83d28, []int{4}
+}
+func (m *CronTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n20
+		return b[:n], nil
 	}
-	return i, nil
 }
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+func (m *CronTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronTask.Merge(m, src)
 }
-func (m *Tx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fees != nil {
-		l = m.Fees.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
-		}
+func (m *CronTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronTask proto.InternalMessageInfo
+
+type isCronTask_Sum interface {
+	isCronTask_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type CronTask_GovTallyMsg struct {
+	GovTallyMsg *gov.TallyMsg `protobuf:"bytes,76,opt,name=gov_tally_msg,json=govTallyMsg,proto3,oneof"`
+}
+type CronTask_BlogDeleteArticleMsg struct {
+	BlogDeleteArticleMsg *blog.DeleteArticleMsg `protobuf:"bytes,120,opt,name=blog_delete_article_msg,json=blogDeleteArticleMsg,proto3,oneof"`
+}
+type CronTask_BlogRefundCommissionMsg struct {
+	BlogRefundCommissionMsg *blog.RefundCommissionMsg `protobuf:"bytes,121,opt,name=blog_refund_commission_msg,json=blogRefundCommissionMsg,proto3,oneof"`
+}
+type CronTask_BlogExpireRateLimitMsg struct {
+	BlogExpireRateLimitMsg *blog.ExpireRateLimitMsg `protobuf:"bytes,122,opt,name=blog_expire_rate_limit_msg,json=blogExpireRateLimitMsg,proto3,oneof"`
+}
+
+func (*CronTask_GovTallyMsg) isCronTask_Sum()             {}
+func (*CronTask_BlogDeleteArticleMsg) isCronTask_Sum()    {}
+func (*CronTask_BlogRefundCommissionMsg) isCronTask_Sum() {}
+func (*CronTask_BlogExpireRateLimitMsg) isCronTask_Sum()  {}
+
+func (m *CronTask) GetSum() isCronTask_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	if len(m.Multisig) > 0 {
-		for _, b := range m.Multisig {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
-		}
+	return nil
+}
+
+func (m *CronTask) GetAuthenticators() []github_com_iov_one_weave.Condition {
+	if m != nil {
+		return m.Authenticators
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	return nil
+}
+
+func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
+	if x, ok := m.GetSum().(*CronTask_GovTallyMsg); ok {
+		return x.GovTallyMsg
 	}
-	return n
+	return nil
 }
 
-func (m *Tx_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CronTask) GetBlogDeleteArticleMsg() *blog.DeleteArticleMsg {
+	if x, ok := m.GetSum().(*CronTask_BlogDeleteArticleMsg); ok {
+		return x.BlogDeleteArticleMsg
 	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *CronTask) GetBlogRefundCommissionMsg() *blog.RefundCommissionMsg {
+	if x, ok := m.GetSum().(*CronTask_BlogRefundCommissionMsg); ok {
+		return x.BlogRefundCommissionMsg
 	}
-	return n
+	return nil
 }
-func (m *Tx_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CronTask) GetBlogExpireRateLimitMsg() *blog.ExpireRateLimitMsg {
+	if x, ok := m.GetSum().(*CronTask_BlogExpireRateLimitMsg); ok {
+		return x.BlogExpireRateLimitMsg
 	}
-	var l int
-	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
+		(*CronTask_GovTallyMsg)(nil),
+		(*CronTask_BlogDeleteArticleMsg)(nil),
+		(*CronTask_BlogRefundCommissionMsg)(nil),
+		(*CronTask_BlogExpireRateLimitMsg)(nil),
 	}
-	return n
 }
-func (m *Tx_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*CronTask)
+	// sum
+	switch x := m.Sum.(type) {
+	case *CronTask_GovTallyMsg:
+		_ = b.EncodeVarint(76<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovTallyMsg); err != nil {
+			return err
+		}
+	case *CronTask_BlogDeleteArticleMsg:
+		_ = b.EncodeVarint(120<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogDeleteArticleMsg); err != nil {
+			return err
+		}
+	case *CronTask_BlogRefundCommissionMsg:
+		_ = b.EncodeVarint(121<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogRefundCommissionMsg); err != nil {
+			return err
+		}
+	case *CronTask_BlogExpireRateLimitMsg:
+		_ = b.EncodeVarint(122<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.BlogExpireRateLimitMsg); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
 	}
-	return n
+	return nil
 }
-func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*CronTask)
+	switch tag {
+	case 76: // sum.gov_tally_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.TallyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_GovTallyMsg{msg}
+		return true, err
+	case 120: // sum.blog_delete_article_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.DeleteArticleMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_BlogDeleteArticleMsg{msg}
+		return true, err
+	case 121: // sum.blog_refund_commission_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.RefundCommissionMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_BlogRefundCommissionMsg{msg}
+		return true, err
+	case 122: // sum.blog_expire_rate_limit_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(blog.ExpireRateLimitMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_BlogExpireRateLimitMsg{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return n
 }
-func (m *Tx_ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ExecuteBatchMsg != nil {
-		l = m.ExecuteBatchMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func _CronTask_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*CronTask)
+	// sum
+	switch x := m.Sum.(type) {
+	case *CronTask_GovTallyMsg:
+		s := proto.Size(x.GovTallyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_BlogDeleteArticleMsg:
+		s := proto.Size(x.BlogDeleteArticleMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_BlogRefundCommissionMsg:
+		s := proto.Size(x.BlogRefundCommissionMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_BlogExpireRateLimitMsg:
+		s := proto.Size(x.BlogExpireRateLimitMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
 	return n
 }
-func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MigrationUpgradeSchemaMsg != nil {
-		l = m.MigrationUpgradeSchemaMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+
+// SimulateQuery is the data of a /simulate query. The transaction is checked
+// as if it was included in the block of given height and time.
+type SimulateQuery struct {
+	// Tx is the serialized transaction.
+	Tx     []byte                            `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Height int64                             `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=time,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"time,omitempty"`
 }
-func (m *Tx_BlogCreateUserMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlogCreateUserMsg != nil {
-		l = m.BlogCreateUserMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+
+func (m *SimulateQuery) Reset()         { *m = SimulateQuery{} }
+func (m *SimulateQuery) String() string { return proto.CompactTextString(m) }
+func (*SimulateQuery) ProtoMessage()    {}
+func (*SimulateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96adc38df3c83d28, []int{5}
 }
-func (m *Tx_BlogCreateBlogMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlogCreateBlogMsg != nil {
-		l = m.BlogCreateBlogMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *SimulateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *Tx_BlogChangeBlogOwnerMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SimulateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BlogChangeBlogOwnerMsg != nil {
-		l = m.BlogChangeBlogOwnerMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+}
+func (m *SimulateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateQuery.Merge(m, src)
+}
+func (m *SimulateQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateQuery proto.InternalMessageInfo
+
+func (m *SimulateQuery) GetTx() []byte {
+	if m != nil {
+		return m.Tx
 	}
-	return n
+	return nil
 }
-func (m *Tx_BlogCreateArticleMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SimulateQuery) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	if m.BlogCreateArticleMsg != nil {
-		l = m.BlogCreateArticleMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return 0
+}
+
+func (m *SimulateQuery) GetTime() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.Time
 	}
-	return n
+	return 0
 }
-func (m *Tx_BlogDeleteArticleMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+// SimulateResult is the result of a /simulate query.
+type SimulateResult struct {
+	// Code and Log describe the check failure. Code is zero if the
+	// transaction passed.
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Log  string `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	// Data is the check result data.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// GasAllocated is the maximum units of work the transaction can perform.
+	GasAllocated int64 `protobuf:"varint,4,opt,name=gas_allocated,json=gasAllocated,proto3" json:"gas_allocated,omitempty"`
+	// GasPayment is the fee paid by the transaction.
+	GasPayment int64 `protobuf:"varint,5,opt,name=gas_payment,json=gasPayment,proto3" json:"gas_payment,omitempty"`
+	// RequiredFee is the fee required by the handlers.
+	RequiredFee coin.Coin `protobuf:"bytes,6,opt,name=required_fee,json=requiredFee,proto3" json:"required_fee"`
+	// MinimalFee is the fee every transaction must pay, according to the cash
+	// configuration.
+	MinimalFee coin.Coin `protobuf:"bytes,7,opt,name=minimal_fee,json=minimalFee,proto3" json:"minimal_fee"`
+	// MessageFee is the fee of the transaction message, according to the
+	// msgfee configuration.
+	MessageFee coin.Coin `protobuf:"bytes,8,opt,name=message_fee,json=messageFee,proto3" json:"message_fee"`
+}
+
+func (m *SimulateResult) Reset()         { *m = SimulateResult{} }
+func (m *SimulateResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateResult) ProtoMessage()    {}
+func (*SimulateResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96adc38df3c83d28, []int{6}
+}
+func (m *SimulateResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BlogDeleteArticleMsg != nil {
-		l = m.BlogDeleteArticleMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+}
+func (m *SimulateResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateResult.Merge(m, src)
+}
+func (m *SimulateResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateResult proto.InternalMessageInfo
+
+func (m *SimulateResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	return n
+	return 0
 }
-func (m *Tx_BlogCancelDeleteArticleTaskMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SimulateResult) GetLog() string {
+	if m != nil {
+		return m.Log
 	}
-	var l int
-	_ = l
-	if m.BlogCancelDeleteArticleTaskMsg != nil {
-		l = m.BlogCancelDeleteArticleTaskMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return ""
+}
+
+func (m *SimulateResult) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return n
+	return nil
 }
-func (m *ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *SimulateResult) GetGasAllocated() int64 {
+	if m != nil {
+		return m.GasAllocated
 	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
-		}
+	return 0
+}
+
+func (m *SimulateResult) GetGasPayment() int64 {
+	if m != nil {
+		return m.GasPayment
 	}
-	return n
+	return 0
 }
 
-func (m *ExecuteBatchMsg_Union) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SimulateResult) GetRequiredFee() coin.Coin {
+	if m != nil {
+		return m.RequiredFee
 	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	return coin.Coin{}
+}
+
+func (m *SimulateResult) GetMinimalFee() coin.Coin {
+	if m != nil {
+		return m.MinimalFee
 	}
-	return n
+	return coin.Coin{}
 }
 
-func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SimulateResult) GetMessageFee() coin.Coin {
+	if m != nil {
+		return m.MessageFee
 	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+	return coin.Coin{}
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+func init() {
+	proto.RegisterType((*Tx)(nil), "blog.Tx")
+	proto.RegisterType((*ExecuteBatchMsg)(nil), "blog.ExecuteBatchMsg")
+	proto.RegisterType((*ExecuteBatchMsg_Union)(nil), "blog.ExecuteBatchMsg.Union")
+	proto.RegisterType((*ProposalOptions)(nil), "blog.ProposalOptions")
+	proto.RegisterType((*ExecuteProposalBatchMsg)(nil), "blog.ExecuteProposalBatchMsg")
+	proto.RegisterType((*ExecuteProposalBatchMsg_Union)(nil), "blog.ExecuteProposalBatchMsg.Union")
+	proto.RegisterType((*CronTask)(nil), "blog.CronTask")
+	proto.RegisterType((*SimulateQuery)(nil), "blog.SimulateQuery")
+	proto.RegisterType((*SimulateResult)(nil), "blog.SimulateResult")
+}
+
+func init() { proto.RegisterFile("cmd/blog/app/codec.proto", fileDescriptor_96adc38df3c83d28) }
+
+var fileDescriptor_96adc38df3c83d28 = []byte{
+	// 1515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x98, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0xc7, 0x6d, 0x49, 0xf6, 0xf5, 0x1d, 0xcb, 0xf9, 0x98, 0x38, 0xb6, 0xe2, 0x24, 0xb2, 0xe3,
+	0xe0, 0x5e, 0x18, 0xf7, 0xa2, 0x54, 0x13, 0x6f, 0x9a, 0xa2, 0x5d, 0x58, 0x8e, 0x8d, 0xa4, 0x4d,
+	0xe2, 0x94, 0xb6, 0x82, 0x02, 0x0d, 0x2a, 0x8c, 0xc9, 0x11, 0x35, 0x0d, 0xc9, 0x61, 0xc8, 0xa1,
+	0x22, 0xf7, 0x29, 0xb2, 0x6c, 0xb7, 0x5d, 0x74, 0x53, 0xf4, 0x3d, 0xb2, 0xcc, 0xb2, 0xab, 0xa0,
+	0x48, 0x9e, 0xa1, 0x9b, 0xac, 0x8a, 0x39, 0x33, 0xa4, 0x86, 0x94, 0x64, 0x14, 0x45, 0xbb, 0x48,
+	0x91, 0x1d, 0x79, 0xce, 0xff, 0xfc, 0xe6, 0x68, 0xce, 0x99, 0x0f, 0x11, 0x35, 0x9c, 0xc0, 0x6d,
+	0x1d, 0xfb, 0xdc, 0x6b, 0x91, 0x28, 0x6a, 0x39, 0xdc, 0xa5, 0x8e, 0x15, 0xc5, 0x5c, 0x70, 0x5c,
+	0x93, 0xd6, 0xb5, 0x73, 0x0e, 0x67, 0xa1, 0x69, 0x5f, 0xb3, 0x3c, 0x26, 0xfa, 0xe9, 0xb1, 0xe5,
+	0xf0, 0xa0, 0xc5, 0xf8, 0xe0, 0x03, 0x1e, 0xd2, 0xd6, 0x33, 0x4a, 0x06, 0xb4, 0x15, 0x30, 0x2f,
+	0x26, 0x82, 0xf1, 0xa2, 0xfe, 0xff, 0x53, 0xf5, 0xc3, 0x96, 0x43, 0x92, 0x7e, 0x41, 0xfc, 0xbf,
+	0x53, 0xc4, 0x1e, 0x1f, 0x14, 0xb4, 0xad, 0x53, 0xb4, 0x41, 0xea, 0x0b, 0x96, 0x30, 0xef, 0x0f,
+	0x67, 0x92, 0x30, 0x2f, 0x29, 0x88, 0x6f, 0x9c, 0x22, 0x1e, 0x10, 0x9f, 0xb9, 0x44, 0xf0, 0xb8,
+	0x18, 0xb2, 0xec, 0x71, 0x8f, 0xc3, 0x63, 0x4b, 0x3e, 0x69, 0x2b, 0x1e, 0xaa, 0xf9, 0x35, 0x94,
+	0x9b, 0x3f, 0x62, 0x54, 0x39, 0x1a, 0xe2, 0x6b, 0xa8, 0xd6, 0xa3, 0x34, 0x69, 0xcc, 0x6e, 0xcc,
+	0x6e, 0x2d, 0xde, 0x5c, 0xb2, 0xe4, 0x74, 0x58, 0xfb, 0x94, 0xde, 0x0d, 0x7b, 0xdc, 0x06, 0x17,
+	0xbe, 0x89, 0x50, 0xc2, 0xbc, 0x90, 0x88, 0x34, 0xa6, 0x49, 0xa3, 0xb2, 0x51, 0xdd, 0x5a, 0xbc,
+	0x89, 0x2d, 0x99, 0xad, 0x75, 0x28, 0xdc, 0xc3, 0xcc, 0x65, 0x1b, 0x2a, 0xbc, 0x86, 0x16, 0xb2,
+	0xdf, 0xdf, 0xa8, 0x6d, 0x54, 0xb7, 0xea, 0x76, 0xfe, 0x8e, 0xb7, 0xd1, 0x92, 0x1c, 0xa5, 0x9b,
+	0xd0, 0xd0, 0xed, 0x06, 0x89, 0xd7, 0xd8, 0x36, 0xc7, 0x3e, 0xa4, 0xa1, 0x7b, 0x3f, 0xf1, 0xee,
+	0xcc, 0xd8, 0x8b, 0xf2, 0x5d, 0xbf, 0xe2, 0x3d, 0x74, 0x21, 0x03, 0x74, 0x9d, 0x98, 0x12, 0x41,
+	0x21, 0xf4, 0x23, 0x08, 0xbd, 0x60, 0x65, 0x3e, 0x6b, 0x17, 0x7c, 0x0a, 0x70, 0x3e, 0xb3, 0xe6,
+	0xc6, 0x02, 0x26, 0x8d, 0xdc, 0x0c, 0x73, 0xab, 0x8c, 0xe9, 0x44, 0xee, 0x38, 0x26, 0x37, 0xe2,
+	0x0e, 0xba, 0x34, 0x2a, 0x40, 0x97, 0x44, 0x91, 0x7f, 0xd2, 0x75, 0x59, 0xaf, 0x07, 0xb0, 0x8f,
+	0x01, 0xd6, 0xb0, 0x46, 0x0a, 0x6b, 0x47, 0x2a, 0x6e, 0xb3, 0x5e, 0x4f, 0x11, 0x57, 0x46, 0x2e,
+	0xd3, 0x83, 0x77, 0xd1, 0x79, 0x3a, 0xa4, 0x4e, 0x2a, 0x68, 0xf7, 0x98, 0x08, 0xa7, 0x0f, 0xb8,
+	0x4f, 0x00, 0x77, 0xd1, 0x92, 0x15, 0xb4, 0xf6, 0x94, 0xbb, 0x2d, 0xbd, 0x8a, 0x75, 0x96, 0x16,
+	0x4d, 0xf8, 0x6b, 0x74, 0x25, 0x5f, 0x05, 0xdd, 0x34, 0xf2, 0x62, 0xe2, 0xd2, 0x6e, 0xe2, 0xf4,
+	0x69, 0x40, 0x80, 0xb7, 0x07, 0xbc, 0xcb, 0x56, 0x2e, 0xb2, 0x3a, 0x4a, 0x74, 0x08, 0x1a, 0x45,
+	0xbd, 0x94, 0x7b, 0xcb, 0x4e, 0x7c, 0x80, 0x56, 0x3d, 0x3e, 0xc8, 0x8a, 0x10, 0xc5, 0x3c, 0xe2,
+	0x09, 0xf1, 0x01, 0x7d, 0x17, 0xd0, 0x2b, 0x96, 0xc7, 0x07, 0xba, 0x10, 0x0f, 0xb5, 0x5b, 0x51,
+	0x97, 0x3d, 0x3e, 0x18, 0xb3, 0x67, 0x40, 0x97, 0xfa, 0xb4, 0x0c, 0xfc, 0xcc, 0x00, 0xde, 0x06,
+	0xff, 0x38, 0x70, 0xcc, 0x8e, 0x3f, 0x44, 0x75, 0x09, 0x1c, 0x70, 0x5d, 0xdd, 0xcf, 0x81, 0x52,
+	0x07, 0xca, 0x23, 0x9e, 0x95, 0x15, 0x79, 0x7c, 0xf0, 0x88, 0xe7, 0xf5, 0x94, 0x11, 0xba, 0x23,
+	0xa8, 0x4f, 0x1d, 0xc1, 0xe3, 0xac, 0x39, 0xee, 0xeb, 0x7a, 0xca, 0x70, 0xd5, 0x02, 0x7b, 0xb9,
+	0x40, 0xd7, 0xd3, 0xe3, 0x83, 0x09, 0x1e, 0xfc, 0x18, 0x5d, 0x29, 0x63, 0x65, 0x51, 0xe2, 0xd4,
+	0x57, 0xe4, 0x07, 0x40, 0x5e, 0x2b, 0x93, 0x19, 0x0f, 0xed, 0xd4, 0xd7, 0xec, 0x46, 0x91, 0x3d,
+	0xf2, 0xe1, 0x7d, 0xb4, 0x2c, 0x7b, 0x22, 0xab, 0x44, 0x9a, 0xd0, 0x18, 0xa8, 0xae, 0x6e, 0x66,
+	0xe9, 0xd4, 0x65, 0xe8, 0x24, 0x34, 0xd6, 0xcd, 0x2c, 0xad, 0x05, 0x63, 0x99, 0x03, 0xcf, 0x92,
+	0x43, 0xc7, 0x39, 0x6d, 0x9f, 0x7b, 0x63, 0x1c, 0x6d, 0xc4, 0x8f, 0xd0, 0x9a, 0xe2, 0xf4, 0x49,
+	0xe8, 0x69, 0x0e, 0x7f, 0x16, 0xea, 0xac, 0x7a, 0x7a, 0x16, 0x15, 0x0d, 0x24, 0x32, 0xf0, 0x40,
+	0x0a, 0xf4, 0x2c, 0x02, 0x72, 0xcc, 0x23, 0xfb, 0xc3, 0xcc, 0x8f, 0xc4, 0x82, 0x39, 0x7a, 0x02,
+	0x3d, 0xdd, 0x1f, 0x46, 0x8a, 0x3b, 0xca, 0xad, 0xfb, 0x63, 0x94, 0xe5, 0xc8, 0x9e, 0x03, 0x75,
+	0xc7, 0x99, 0xc0, 0xbe, 0x09, 0x54, 0x9d, 0x35, 0x0e, 0x2c, 0xdb, 0x31, 0x47, 0xd7, 0x55, 0x86,
+	0x24, 0x74, 0xa8, 0x5f, 0xe6, 0x0a, 0x92, 0x3c, 0x01, 0x38, 0x03, 0xf8, 0x86, 0xce, 0x16, 0xb4,
+	0x05, 0xd4, 0x11, 0x49, 0x9e, 0xa8, 0x61, 0x9a, 0x90, 0xf7, 0x54, 0x05, 0xbe, 0x8b, 0x2e, 0xc2,
+	0x80, 0x7d, 0xe6, 0x16, 0xf3, 0xff, 0x06, 0x86, 0x58, 0x56, 0x43, 0xdc, 0x61, 0x6e, 0x31, 0x7b,
+	0x2c, 0xcd, 0x45, 0x2b, 0x26, 0xe8, 0x2a, 0xa0, 0x74, 0x93, 0x3a, 0x3c, 0xec, 0x31, 0x2f, 0xd5,
+	0xdb, 0x87, 0x44, 0x3e, 0x01, 0xe4, 0x15, 0x85, 0x54, 0x9d, 0xb8, 0x6b, 0x8a, 0x14, 0x1a, 0x4a,
+	0x3f, 0xd9, 0x8b, 0xbf, 0x42, 0x97, 0xd5, 0xf4, 0xf0, 0x20, 0x60, 0x49, 0x22, 0xc1, 0x66, 0xce,
+	0xbe, 0x5e, 0x05, 0x6a, 0x5a, 0x72, 0x4d, 0x21, 0xf3, 0x06, 0x4c, 0xc8, 0x04, 0x5f, 0x0e, 0xef,
+	0xa5, 0x7e, 0x8f, 0xf9, 0xbe, 0x39, 0x88, 0x84, 0x07, 0x26, 0x7c, 0x5f, 0x69, 0x46, 0x1c, 0x03,
+	0x3e, 0xc9, 0x97, 0x2f, 0x8d, 0x88, 0x15, 0x53, 0x0e, 0xcd, 0xa5, 0xf1, 0x90, 0x15, 0x73, 0x85,
+	0xa5, 0x51, 0x30, 0xe2, 0x7b, 0x68, 0x45, 0x4d, 0x72, 0x58, 0x26, 0x71, 0x73, 0x77, 0xef, 0x84,
+	0x91, 0x19, 0x76, 0x67, 0xc6, 0xbe, 0x00, 0xd3, 0x1a, 0x46, 0x13, 0x69, 0x7a, 0x41, 0x24, 0x34,
+	0x66, 0x34, 0x01, 0x5a, 0x64, 0xd2, 0x54, 0xdf, 0x1f, 0x82, 0xd7, 0xa0, 0x95, 0xcc, 0xf8, 0x18,
+	0x41, 0xb7, 0xc9, 0x53, 0x4c, 0x1e, 0xc8, 0x9a, 0x66, 0xe6, 0xf8, 0xd4, 0xec, 0x80, 0x1d, 0x90,
+	0xa9, 0xf0, 0x42, 0xaa, 0xd0, 0x01, 0x93, 0xbd, 0xf9, 0x8a, 0x8b, 0x29, 0x8f, 0x5d, 0x1a, 0x9b,
+	0x29, 0xc7, 0xe6, 0x8a, 0xb3, 0x95, 0xdf, 0xcc, 0x19, 0x0a, 0x50, 0xb6, 0xe7, 0x49, 0xc7, 0x34,
+	0xe0, 0x03, 0x3a, 0x29, 0xe9, 0xc4, 0x4c, 0xda, 0x06, 0xd9, 0xb4, 0xa4, 0x27, 0x7b, 0xdb, 0x73,
+	0xa8, 0x9a, 0xa4, 0xc1, 0xe6, 0x6f, 0x15, 0x74, 0xb6, 0x74, 0xec, 0xe2, 0x4f, 0xd1, 0x42, 0x40,
+	0x93, 0x84, 0x78, 0x70, 0x73, 0xaa, 0xc2, 0x79, 0x3a, 0xe9, 0x7c, 0xb6, 0x3a, 0x21, 0xe3, 0x61,
+	0xbb, 0xf6, 0xe2, 0xd5, 0xfa, 0x8c, 0x9d, 0x87, 0xac, 0xfd, 0x50, 0x41, 0x73, 0xe0, 0xf9, 0x27,
+	0xdc, 0x85, 0xfe, 0xea, 0xed, 0x39, 0x9b, 0xf7, 0xef, 0xe6, 0xd0, 0xd9, 0xec, 0x54, 0x3f, 0x88,
+	0xe4, 0x5e, 0x92, 0xe0, 0xc7, 0x68, 0x2d, 0xbb, 0x20, 0xe5, 0xf7, 0x84, 0xf2, 0x4d, 0xe9, 0x6a,
+	0xa1, 0x12, 0x19, 0xc1, 0xb8, 0x31, 0xad, 0xd2, 0xc9, 0xae, 0x77, 0xf3, 0x16, 0x70, 0x8c, 0x9a,
+	0xc6, 0x75, 0x4c, 0xd0, 0xa1, 0xe8, 0xc6, 0x34, 0xe1, 0x7e, 0x9a, 0x6f, 0xe0, 0x07, 0x7a, 0x25,
+	0x8c, 0x6e, 0x65, 0x47, 0x74, 0x28, 0xec, 0x5c, 0xa4, 0x57, 0x42, 0x7e, 0x37, 0x1b, 0xf3, 0xfe,
+	0x6d, 0x27, 0xfb, 0x3b, 0x75, 0x8c, 0xb5, 0x17, 0xd0, 0x3c, 0x87, 0x3e, 0xdc, 0x7c, 0x3e, 0x87,
+	0x56, 0xa7, 0xf4, 0x17, 0xde, 0x1b, 0xdb, 0x1a, 0xae, 0x9f, 0xda, 0x90, 0x53, 0xb6, 0x88, 0x9f,
+	0x6b, 0xd9, 0x16, 0xf1, 0xbe, 0x2b, 0xdf, 0x77, 0xe5, 0x29, 0x5d, 0xa9, 0x77, 0xcb, 0xef, 0xab,
+	0x68, 0x61, 0x37, 0xe6, 0xa1, 0xbc, 0x21, 0xe2, 0x07, 0xe8, 0x0c, 0x49, 0x45, 0x9f, 0x86, 0x82,
+	0x39, 0xf0, 0x2f, 0x13, 0x3a, 0xb1, 0xde, 0xfe, 0xef, 0xdb, 0x57, 0xeb, 0x9b, 0xd3, 0x3e, 0x2a,
+	0x58, 0xbb, 0x3c, 0x74, 0x19, 0x14, 0xb1, 0x14, 0x2d, 0x4f, 0x29, 0x59, 0x4d, 0x41, 0x7c, 0xff,
+	0x04, 0xd2, 0xbe, 0xa7, 0x4f, 0x29, 0x59, 0xbc, 0x23, 0x69, 0xd5, 0xa7, 0x94, 0xc7, 0x07, 0xd9,
+	0xeb, 0x69, 0xb7, 0xec, 0xe1, 0x9f, 0xba, 0x65, 0x7f, 0xa9, 0xeb, 0x1d, 0xd3, 0x5e, 0x1a, 0xba,
+	0xe5, 0x8b, 0xde, 0x09, 0x30, 0x2f, 0x65, 0xe7, 0xbd, 0x94, 0x94, 0xef, 0x79, 0xab, 0xea, 0xb0,
+	0x1f, 0x73, 0xe5, 0x9d, 0x44, 0x87, 0x11, 0x8b, 0x69, 0x17, 0x56, 0x97, 0xcf, 0x02, 0x26, 0x80,
+	0xfc, 0xad, 0xd9, 0x49, 0x7b, 0x20, 0xb1, 0x89, 0xa0, 0xf7, 0xa4, 0xc0, 0xe8, 0xa4, 0x71, 0x4f,
+	0x56, 0x9b, 0x18, 0x2d, 0x1d, 0xb2, 0x20, 0xf5, 0x89, 0xa0, 0x5f, 0xa4, 0x34, 0x3e, 0xc1, 0x67,
+	0x50, 0x45, 0x0c, 0xe1, 0x93, 0x4b, 0xdd, 0xae, 0x88, 0x21, 0x5e, 0x41, 0xf3, 0x7d, 0xca, 0xbc,
+	0xbe, 0x68, 0x54, 0x36, 0x66, 0xb7, 0xaa, 0xb6, 0x7e, 0xc3, 0xb7, 0x50, 0x4d, 0xb0, 0x80, 0x36,
+	0xaa, 0xd2, 0xda, 0xfe, 0xcf, 0xdb, 0x57, 0xeb, 0xd7, 0xa6, 0x56, 0xaf, 0x13, 0xb2, 0xe1, 0x11,
+	0x0b, 0xa8, 0x0d, 0x21, 0x9b, 0x3f, 0x55, 0xd0, 0x99, 0x6c, 0x50, 0x9b, 0x26, 0xa9, 0x2f, 0x30,
+	0x46, 0x35, 0xf9, 0x01, 0x08, 0xc6, 0x5d, 0xb2, 0xe1, 0x19, 0x9f, 0x43, 0x55, 0x9f, 0x7b, 0x30,
+	0xec, 0xbf, 0x6d, 0xf9, 0x28, 0x55, 0x2e, 0x11, 0x04, 0xc6, 0xac, 0xdb, 0xf0, 0x8c, 0xaf, 0xa3,
+	0x25, 0x8f, 0x24, 0x5d, 0xe2, 0xfb, 0xdc, 0x21, 0x82, 0xba, 0x8d, 0x1a, 0xa4, 0x59, 0xf7, 0x48,
+	0xb2, 0x93, 0xd9, 0xf0, 0x3a, 0x5a, 0x94, 0xa2, 0x88, 0x9c, 0x04, 0x34, 0x14, 0x8d, 0x39, 0x90,
+	0x20, 0x8f, 0x24, 0x0f, 0x95, 0x05, 0x6f, 0xa3, 0x7a, 0x4c, 0x9f, 0xa6, 0x2c, 0xa6, 0x6e, 0xb7,
+	0x47, 0x69, 0x63, 0x1e, 0xe6, 0x15, 0x59, 0xf2, 0xf3, 0x9e, 0xb5, 0xcb, 0x59, 0xb6, 0x09, 0x2e,
+	0x66, 0xaa, 0x7d, 0x4a, 0xf1, 0x0d, 0xb4, 0x18, 0xb0, 0x90, 0x05, 0xc4, 0x87, 0x98, 0x7f, 0x4d,
+	0x89, 0x41, 0x5a, 0x94, 0x85, 0xa8, 0x6d, 0x14, 0x42, 0x16, 0xa6, 0x86, 0x28, 0xd1, 0x3e, 0xa5,
+	0xed, 0xc6, 0x8b, 0xd7, 0xcd, 0xd9, 0x97, 0xaf, 0x9b, 0xb3, 0xbf, 0xbe, 0x6e, 0xce, 0x3e, 0x7f,
+	0xd3, 0x9c, 0x79, 0xf9, 0xa6, 0x39, 0xf3, 0xcb, 0x9b, 0xe6, 0xcc, 0xf1, 0x3c, 0x7c, 0x2d, 0xdb,
+	0xfe, 0x7d, 0x00, 0x4f, 0x84, 0xbd, 0x48, 0xa5, 0x14, 0x00, 0x00,
+}
+
+func (m *Tx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Tx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	if m.Fees != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Fees.Size()))
+		n1, err := m.Fees.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
-	return n
+	if len(m.Signatures) > 0 {
+		for _, msg := range m.Signatures {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Multisig) > 0 {
+		for _, b := range m.Multisig {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Sum != nil {
+		nn2, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn2
+	}
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *Tx_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n3, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
-	var l int
-	_ = l
+	return i, nil
+}
+func (m *Tx_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n4, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	return i, nil
+}
+func (m *Tx_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
 	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n5, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
-	return n
+	return i, nil
 }
-func (m *CronTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tx_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n6, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
 	}
-	var l int
-	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *Tx_ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ExecuteBatchMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteBatchMsg.Size()))
+		n7, err := m.ExecuteBatchMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n7
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	return i, nil
+}
+func (m *Tx_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationUpgradeSchemaMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
+		n8, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
-	return n
+	return i, nil
 }
-
-func (m *CronTask_BlogDeleteArticleMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Tx_GovCreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateProposalMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateProposalMsg.Size()))
+		n9, err := m.GovCreateProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
-	var l int
-	_ = l
-	if m.BlogDeleteArticleMsg != nil {
-		l = m.BlogDeleteArticleMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *Tx_GovDeleteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovDeleteProposalMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovDeleteProposalMsg.Size()))
+		n10, err := m.GovDeleteProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	return n
+	return i, nil
 }
-
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *Tx_GovVoteMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovVoteMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovVoteMsg.Size()))
+		n11, err := m.GovVoteMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n11
 	}
-	return n
+	return i, nil
 }
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *Tx_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n12, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	return i, nil
 }
-func (m *Tx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
+func (m *Tx_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n13, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	return i, nil
+}
+func (m *Tx_BlogCreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogCreateUserMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateUserMsg.Size()))
+		n14, err := m.BlogCreateUserMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+func (m *Tx_BlogCreateBlogMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogCreateBlogMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateBlogMsg.Size()))
+		n15, err := m.BlogCreateBlogMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	return i, nil
+}
+func (m *Tx_BlogChangeBlogOwnerMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogChangeBlogOwnerMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogChangeBlogOwnerMsg.Size()))
+		n16, err := m.BlogChangeBlogOwnerMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	return i, nil
+}
+func (m *Tx_BlogCreateArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogCreateArticleMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateArticleMsg.Size()))
+		n17, err := m.BlogCreateArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	return i, nil
+}
+func (m *Tx_BlogDeleteArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogDeleteArticleMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogDeleteArticleMsg.Size()))
+		n18, err := m.BlogDeleteArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	return i, nil
+}
+func (m *Tx_BlogCancelDeleteArticleTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogCancelDeleteArticleTaskMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCancelDeleteArticleTaskMsg.Size()))
+		n19, err := m.BlogCancelDeleteArticleTaskMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+func (m *Tx_BlogHideArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogHideArticleMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogHideArticleMsg.Size()))
+		n20, err := m.BlogHideArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	return i, nil
+}
+func (m *Tx_BlogUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogUpdateConfigurationMsg.Size()))
+		n21, err := m.BlogUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	return i, nil
+}
+func (m *Tx_BlogCommissionArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogCommissionArticleMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCommissionArticleMsg.Size()))
+		n22, err := m.BlogCommissionArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	return i, nil
+}
+func (m *Tx_BlogFulfillCommissionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogFulfillCommissionMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogFulfillCommissionMsg.Size()))
+		n23, err := m.BlogFulfillCommissionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+func (m *Tx_BlogPinArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogPinArticleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogPinArticleMsg.Size()))
+		n24, err := m.BlogPinArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	return i, nil
+}
+func (m *Tx_BlogUnpinArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogUnpinArticleMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogUnpinArticleMsg.Size()))
+		n25, err := m.BlogUnpinArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+func (m *Tx_BlogCreateSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogCreateSeriesMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateSeriesMsg.Size()))
+		n26, err := m.BlogCreateSeriesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	return i, nil
+}
+func (m *Tx_BlogAppendSeriesArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogAppendSeriesArticleMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogAppendSeriesArticleMsg.Size()))
+		n27, err := m.BlogAppendSeriesArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	return i, nil
+}
+func (m *Tx_BlogReorderSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogReorderSeriesMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogReorderSeriesMsg.Size()))
+		n28, err := m.BlogReorderSeriesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	return i, nil
+}
+func (m *Tx_BlogRemoveSeriesArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogRemoveSeriesArticleMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogRemoveSeriesArticleMsg.Size()))
+		n29, err := m.BlogRemoveSeriesArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn30, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn30
+	}
+	return i, nil
+}
+
+func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n31, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n32, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n33, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_BlogCreateArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogCreateArticleMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogCreateArticleMsg.Size()))
+		n34, err := m.BlogCreateArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Option != nil {
+		nn35, err := m.Option.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn35
+	}
+	return i, nil
+}
+
+func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ExecuteProposalBatchMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
+		n36, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n37, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n38, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateTextResolutionMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
+		n39, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	return i, nil
+}
+func (m *ProposalOptions_BlogChangeBlogOwnerMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogChangeBlogOwnerMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogChangeBlogOwnerMsg.Size()))
+		n40, err := m.BlogChangeBlogOwnerMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+func (m *ProposalOptions_BlogHideArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogHideArticleMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogHideArticleMsg.Size()))
+		n41, err := m.BlogHideArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	return i, nil
+}
+func (m *ProposalOptions_BlogUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogUpdateConfigurationMsg.Size()))
+		n42, err := m.BlogUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Sum != nil {
+		nn43, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn43
+	}
+	return i, nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n44, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n45, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n45
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateTextResolutionMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
+		n46, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n46
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogChangeBlogOwnerMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogChangeBlogOwnerMsg.Size()))
+		n47, err := m.BlogChangeBlogOwnerMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n47
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_BlogHideArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogHideArticleMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogHideArticleMsg.Size()))
+		n48, err := m.BlogHideArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogUpdateConfigurationMsg.Size()))
+		n49, err := m.BlogUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	return i, nil
+}
+func (m *CronTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Sum != nil {
+		nn50, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn50
+	}
+	return i, nil
+}
+
+func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovTallyMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
+		n51, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	return i, nil
+}
+func (m *CronTask_BlogDeleteArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogDeleteArticleMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogDeleteArticleMsg.Size()))
+		n52, err := m.BlogDeleteArticleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	return i, nil
+}
+func (m *CronTask_BlogRefundCommissionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogRefundCommissionMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogRefundCommissionMsg.Size()))
+		n53, err := m.BlogRefundCommissionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	return i, nil
+}
+func (m *CronTask_BlogExpireRateLimitMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlogExpireRateLimitMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BlogExpireRateLimitMsg.Size()))
+		n54, err := m.BlogExpireRateLimitMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	return i, nil
+}
+func (m *SimulateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tx) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Tx)))
+		i += copy(dAtA[i:], m.Tx)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Height))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *SimulateResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Log) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Log)))
+		i += copy(dAtA[i:], m.Log)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.GasAllocated != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasAllocated))
+	}
+	if m.GasPayment != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GasPayment))
+	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.RequiredFee.Size()))
+	n55, err := m.RequiredFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n55
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.MinimalFee.Size()))
+	n56, err := m.MinimalFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n56
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.MessageFee.Size()))
+	n57, err := m.MessageFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n57
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Tx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Multisig) > 0 {
+		for _, b := range m.Multisig {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *Tx_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteBatchMsg != nil {
+		l = m.ExecuteBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationUpgradeSchemaMsg != nil {
+		l = m.MigrationUpgradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovCreateProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateProposalMsg != nil {
+		l = m.GovCreateProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovDeleteProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovDeleteProposalMsg != nil {
+		l = m.GovDeleteProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovVoteMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovVoteMsg != nil {
+		l = m.GovVoteMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogCreateUserMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogCreateUserMsg != nil {
+		l = m.BlogCreateUserMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogCreateBlogMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogCreateBlogMsg != nil {
+		l = m.BlogCreateBlogMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogChangeBlogOwnerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogChangeBlogOwnerMsg != nil {
+		l = m.BlogChangeBlogOwnerMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogCreateArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogCreateArticleMsg != nil {
+		l = m.BlogCreateArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogDeleteArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogDeleteArticleMsg != nil {
+		l = m.BlogDeleteArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogCancelDeleteArticleTaskMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogCancelDeleteArticleTaskMsg != nil {
+		l = m.BlogCancelDeleteArticleTaskMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogHideArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogHideArticleMsg != nil {
+		l = m.BlogHideArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogUpdateConfigurationMsg != nil {
+		l = m.BlogUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogCommissionArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogCommissionArticleMsg != nil {
+		l = m.BlogCommissionArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogFulfillCommissionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogFulfillCommissionMsg != nil {
+		l = m.BlogFulfillCommissionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogPinArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogPinArticleMsg != nil {
+		l = m.BlogPinArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogUnpinArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogUnpinArticleMsg != nil {
+		l = m.BlogUnpinArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogCreateSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogCreateSeriesMsg != nil {
+		l = m.BlogCreateSeriesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogAppendSeriesArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogAppendSeriesArticleMsg != nil {
+		l = m.BlogAppendSeriesArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogReorderSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogReorderSeriesMsg != nil {
+		l = m.BlogReorderSeriesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_BlogRemoveSeriesArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogRemoveSeriesArticleMsg != nil {
+		l = m.BlogRemoveSeriesArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteBatchMsg_Union) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_BlogCreateArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogCreateArticleMsg != nil {
+		l = m.BlogCreateArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != nil {
+		n += m.Option.Size()
+	}
+	return n
+}
+
+func (m *ProposalOptions_ExecuteProposalBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteProposalBatchMsg != nil {
+		l = m.ExecuteProposalBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovCreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateTextResolutionMsg != nil {
+		l = m.GovCreateTextResolutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_BlogChangeBlogOwnerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogChangeBlogOwnerMsg != nil {
+		l = m.BlogChangeBlogOwnerMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_BlogHideArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogHideArticleMsg != nil {
+		l = m.BlogHideArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_BlogUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogUpdateConfigurationMsg != nil {
+		l = m.BlogUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateTextResolutionMsg != nil {
+		l = m.GovCreateTextResolutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogChangeBlogOwnerMsg != nil {
+		l = m.BlogChangeBlogOwnerMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_BlogHideArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogHideArticleMsg != nil {
+		l = m.BlogHideArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogUpdateConfigurationMsg != nil {
+		l = m.BlogUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *CronTask_GovTallyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovTallyMsg != nil {
+		l = m.GovTallyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_BlogDeleteArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogDeleteArticleMsg != nil {
+		l = m.BlogDeleteArticleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_BlogRefundCommissionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogRefundCommissionMsg != nil {
+		l = m.BlogRefundCommissionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_BlogExpireRateLimitMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlogExpireRateLimitMsg != nil {
+		l = m.BlogExpireRateLimitMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *SimulateQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCodec(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovCodec(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *SimulateResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovCodec(uint64(m.Code))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.GasAllocated != 0 {
+		n += 1 + sovCodec(uint64(m.GasAllocated))
+	}
+	if m.GasPayment != 0 {
+		n += 1 + sovCodec(uint64(m.GasPayment))
+	}
+	l = m.RequiredFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.MinimalFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.MessageFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Tx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &cash.FeeInfo{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
+			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashSendMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ExecuteBatchMsg{v}
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.UpgradeSchemaMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.CreateProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovCreateProposalMsg{v}
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.DeleteProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovDeleteProposalMsg{v}
+			iNdEx = postIndex
+		case 75:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.VoteMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovVoteMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectionRuleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateUserMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.CreateUserMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogCreateUserMsg{v}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateBlogMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.CreateBlogMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogCreateBlogMsg{v}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogChangeBlogOwnerMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.ChangeBlogOwnerMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogChangeBlogOwnerMsg{v}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.CreateArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogCreateArticleMsg{v}
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogDeleteArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.DeleteArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogDeleteArticleMsg{v}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogCancelDeleteArticleTaskMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.CancelDeleteArticleTaskMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogCancelDeleteArticleTaskMsg{v}
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogHideArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.HideArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogHideArticleMsg{v}
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogCommissionArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.CommissionArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogCommissionArticleMsg{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogFulfillCommissionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.FulfillCommissionMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogFulfillCommissionMsg{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogPinArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.PinArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogPinArticleMsg{v}
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogUnpinArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.UnpinArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogUnpinArticleMsg{v}
+			iNdEx = postIndex
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateSeriesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.CreateSeriesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogCreateSeriesMsg{v}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogAppendSeriesArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.AppendSeriesArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogAppendSeriesArticleMsg{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogReorderSeriesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.ReorderSeriesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogReorderSeriesMsg{v}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogRemoveSeriesArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.RemoveSeriesArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_BlogRemoveSeriesArticleMsg{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateArticleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &blog.CreateArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_BlogCreateArticleMsg{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteProposalBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteProposalBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_ExecuteProposalBatchMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &gov.UpdateElectionRuleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1705,16 +5807,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &cash.FeeInfo{}
-			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &gov.CreateTextResolutionMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
 			iNdEx = postIndex
-		case 2:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogChangeBlogOwnerMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1741,16 +5842,17 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &blog.ChangeBlogOwnerMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Option = &ProposalOptions_BlogChangeBlogOwnerMsg{v}
 			iNdEx = postIndex
-		case 4:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogHideArticleMsg", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1760,27 +5862,30 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
-			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			v := &blog.HideArticleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_BlogHideArticleMsg{v}
 			iNdEx = postIndex
-		case 51:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1807,15 +5912,68 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &blog.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashSendMsg{v}
+			m.Option = &ProposalOptions_BlogUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 56:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteProposalBatchMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteProposalBatchMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteProposalBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1842,15 +6000,102 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			m.Messages = append(m.Messages, ExecuteProposalBatchMsg_Union{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigCreateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1877,15 +6122,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &gov.UpdateElectionRuleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigUpdateMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1912,15 +6157,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &gov.CreateTextResolutionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{v}
 			iNdEx = postIndex
-		case 60:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogChangeBlogOwnerMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1947,15 +6192,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteBatchMsg{}
+			v := &blog.ChangeBlogOwnerMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ExecuteBatchMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogHideArticleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1982,15 +6227,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &blog.HideArticleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			m.Sum = &ExecuteProposalBatchMsg_Union_BlogHideArticleMsg{v}
 			iNdEx = postIndex
-		case 100:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateUserMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2017,17 +6262,70 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &blog.CreateUserMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			v := &blog.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Sum = &Tx_BlogCreateUserMsg{v}
-			iNdEx = postIndex
-		case 101:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateBlogMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2037,30 +6335,27 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &blog.CreateBlogMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sum = &Tx_BlogCreateBlogMsg{v}
+			m.Authenticators = append(m.Authenticators, make([]byte, postIndex-iNdEx))
+			copy(m.Authenticators[len(m.Authenticators)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 102:
+		case 76:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogChangeBlogOwnerMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovTallyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2087,15 +6382,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &blog.ChangeBlogOwnerMsg{}
+			v := &gov.TallyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_BlogChangeBlogOwnerMsg{v}
+			m.Sum = &CronTask_GovTallyMsg{v}
 			iNdEx = postIndex
-		case 103:
+		case 120:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogCreateArticleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogDeleteArticleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2122,15 +6417,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &blog.CreateArticleMsg{}
+			v := &blog.DeleteArticleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_BlogCreateArticleMsg{v}
+			m.Sum = &CronTask_BlogDeleteArticleMsg{v}
 			iNdEx = postIndex
-		case 104:
+		case 121:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogDeleteArticleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogRefundCommissionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2157,15 +6452,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &blog.DeleteArticleMsg{}
+			v := &blog.RefundCommissionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_BlogDeleteArticleMsg{v}
+			m.Sum = &CronTask_BlogRefundCommissionMsg{v}
 			iNdEx = postIndex
-		case 105:
+		case 122:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogCancelDeleteArticleTaskMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogExpireRateLimitMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2192,11 +6487,11 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &blog.CancelDeleteArticleTaskMsg{}
+			v := &blog.ExpireRateLimitMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_BlogCancelDeleteArticleTaskMsg{v}
+			m.Sum = &CronTask_BlogExpireRateLimitMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2222,7 +6517,7 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
+func (m *SimulateQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2245,17 +6540,17 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2265,26 +6560,64 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2309,7 +6642,7 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+func (m *SimulateResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2332,17 +6665,36 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 51:
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2352,32 +6704,29 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 56:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2387,30 +6736,67 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAllocated", wireType)
+			}
+			m.GasAllocated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasAllocated |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPayment", wireType)
+			}
+			m.GasPayment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPayment |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2437,70 +6823,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RequiredFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CronTask) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CronTask: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronTask: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimalFee", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2510,27 +6841,28 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authenticators = append(m.Authenticators, make([]byte, postIndex-iNdEx))
-			copy(m.Authenticators[len(m.Authenticators)-1], dAtA[iNdEx:postIndex])
+			if err := m.MinimalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 120:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogDeleteArticleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2557,11 +6889,9 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &blog.DeleteArticleMsg{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MessageFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &CronTask_BlogDeleteArticleMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/blog-tutorial/x/blog"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/gov"
 )
 
 // CronTaskMarshaler is a task marshaler implementation to be used by the weave
@@ -24,10 +25,22 @@ func (taskMarshaler) MarshalTask(auth []weave.Condition, msg weave.Msg) ([]byte,
 	default:
 		return nil, errors.Wrapf(errors.ErrType, "unsupported message type: %T", msg)
 
+	case *gov.TallyMsg:
+		t.Sum = &CronTask_GovTallyMsg{
+			GovTallyMsg: msg,
+		}
 	case *blog.DeleteArticleMsg:
 		t.Sum = &CronTask_BlogDeleteArticleMsg{
 			BlogDeleteArticleMsg: msg,
 		}
+	case *blog.RefundCommissionMsg:
+		t.Sum = &CronTask_BlogRefundCommissionMsg{
+			BlogRefundCommissionMsg: msg,
+		}
+	case *blog.ExpireRateLimitMsg:
+		t.Sum = &CronTask_BlogExpireRateLimitMsg{
+			BlogExpireRateLimitMsg: msg,
+		}
 	}
 
 	raw, err := t.Marshal()
@@ -0,0 +1,44 @@
+package blog
+
+import (
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/batch"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/utils"
+)
+
+// decodeProposalOptions decodes the raw option of a governance proposal into
+// the message that is executed once the proposal is accepted.
+func decodeProposalOptions(raw []byte) (weave.Msg, error) {
+	model := ProposalOptions{}
+	err := model.Unmarshal(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot parse data into ProposalOptions struct")
+	}
+	return weave.ExtractMsgFromSum(model.Option)
+}
+
+// proposalOptionsExecutor will set up an executor to allow governance-internal
+// actions. Moderation decisions of the blog extension are executed this way.
+func proposalOptionsExecutor() gov.Executor {
+	r := app.NewRouter()
+
+	// we only allow these to be authenticated by the governance context, not by sigs or other items
+	auth := gov.Authenticate{}
+
+	// Make sure to register for all items in ProposalOptions
+	gov.RegisterBasicProposalRouters(r, auth)
+	blog.RegisterProposalRoutes(r, auth)
+
+	// We must wrap with batch middleware so it can process ExecuteProposalBatchMsg.
+	// We add ActionTagger here, so the messages executed as a result of a governance vote also get properly tagged.
+	stack := app.ChainDecorators(
+		batch.NewDecorator(),
+		utils.NewActionTagger(),
+	).WithHandler(r)
+
+	return gov.HandlerAsExecutor(stack)
+}
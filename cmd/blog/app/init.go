@@ -2,11 +2,13 @@ package blog
 
 import (
 	"context"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"path/filepath"
 
+	"github.com/iov-one/blog-tutorial/x/blog"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/coin"
@@ -14,6 +16,8 @@ import (
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/validators"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -56,6 +60,13 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 	// stashed and then distributed to stakeholders
 	collectorAddr := cond1.Address()
 
+	// moderatorsAddr is the address of the first election rule. Blog
+	// moderation decisions are voted by the moderators electorate and
+	// executed with this address.
+	firstRuleID := make([]byte, 8)
+	binary.BigEndian.PutUint64(firstRuleID, 1)
+	moderatorsAddr := gov.ElectionCondition(firstRuleID).Address()
+
 	return json.Marshal(dict{
 		"cash": array{
 			dict{
@@ -77,13 +88,44 @@ func GenInitOptions(args []string) (json.RawMessage, error) {
 				// admin is who can change this redistribution address to other address
 				"admin": addr,
 			},
+			"blog": dict{
+				"owner":              moderatorsAddr,
+				"article_rate_limit": 10,
+				"rate_limit_window":  "1h",
+			},
+		},
+		"governance": dict{
+			"electorate": array{
+				dict{
+					"admin": addr,
+					"title": "Blog moderators",
+					"electors": array{
+						dict{"address": addr, "weight": 1},
+					},
+				},
+			},
+			"rules": array{
+				dict{
+					"admin":         addr,
+					"electorate_id": 1,
+					"title":         "Blog moderation",
+					"voting_period": "1h",
+					"threshold": dict{
+						"numerator":   1,
+						"denominator": 2,
+					},
+				},
+			},
 		},
 		"initialize_schema": []dict{
 			{"pkg": "migration", "ver": 1},
 			// TODO add blog when migration is implemented
 			{"pkg": "cash", "ver": 1},
+			{"pkg": "msgfee", "ver": 1},
 			{"pkg": "sigs", "ver": 1},
 			{"pkg": "multisig", "ver": 1},
+			{"pkg": "gov", "ver": 1},
+			{"pkg": "cron", "ver": 1},
 			{"pkg": "utils", "ver": 1},
 			{"pkg": "validators", "ver": 1},
 		},
@@ -104,7 +146,8 @@ func GenerateApp(options *server.Options) (abci.Application, error) {
 		return nil, err
 	}
 
-	return DecorateApp(application, options.Logger), nil
+	application.BaseApp = DecorateApp(application.BaseApp, options.Logger)
+	return application, nil
 }
 
 // DecorateApp adds initializers and Logger to an Application
@@ -112,8 +155,11 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 	application.WithInit(app.ChainInitializers(
 		&migration.Initializer{},
 		&cash.Initializer{},
+		&msgfee.Initializer{},
 		&multisig.Initializer{},
 		&validators.Initializer{},
+		&gov.Initializer{},
+		&blog.Initializer{},
 	))
 	application.WithLogger(logger)
 	return application
@@ -124,7 +170,9 @@ func InlineApp(kv weave.CommitKVStore, logger log.Logger, debug bool) abci.Appli
 	minFee := coin.Coin{}
 	stack := Stack(nil, minFee)
 	ctx := context.Background()
-	store := app.NewStoreApp("blog", kv, QueryRouter(), ctx)
+	qr := QueryRouter()
+	store := app.NewStoreApp("blog", kv, qr, ctx)
+	RegisterSimulateQuery(qr, store.GetChainID)
 	base := app.NewBaseApp(store, TxDecoder, stack, nil, debug)
 	return DecorateApp(base, logger)
 }
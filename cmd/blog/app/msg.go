@@ -30,3 +30,29 @@ func (msg *ExecuteBatchMsg) MsgList() ([]weave.Msg, error) {
 	}
 	return messages, nil
 }
+
+// Boiler-plate needed to bridge the ExecuteProposalBatchMsg protobuf type into something usable by the batch extension
+var _ batch.Msg = (*ExecuteProposalBatchMsg)(nil)
+
+// Path returns path of execute proposal batch message
+func (*ExecuteProposalBatchMsg) Path() string {
+	return batch.PathExecuteBatchMsg
+}
+
+// Validate validates execute proposal batch message
+func (msg *ExecuteProposalBatchMsg) Validate() error {
+	return batch.Validate(msg)
+}
+
+// MsgList decode msg.Messages to weave.Msg array
+func (msg *ExecuteProposalBatchMsg) MsgList() ([]weave.Msg, error) {
+	var err error
+	messages := make([]weave.Msg, len(msg.Messages))
+	for i, m := range msg.Messages {
+		messages[i], err = weave.ExtractMsgFromSum(m.GetSum())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return messages, nil
+}
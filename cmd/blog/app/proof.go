@@ -0,0 +1,93 @@
+package blog
+
+import (
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	tmiavl "github.com/tendermint/iavl"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// ProvingApp is a BaseApp that attaches merkle proofs to the query results
+// when requested.
+//
+// Because a single query can return many models, the proof contains one
+// independent IAVL value operation per returned model, in the order of the
+// models. Each operation proves that the model key and value are stored in
+// the state of the query height, computing the app hash of that state.
+// A proof cannot show that a query result is complete, and models that are
+// not stored as returned (i.e. resolved series) cannot be proven.
+//
+// An empty result of a raw key lookup, the "/" path without a modifier, is
+// proven with a single IAVL absence operation of the queried key.
+type ProvingApp struct {
+	app.BaseApp
+	tree  *tmiavl.MutableTree
+	debug bool
+}
+
+// NewProvingApp returns an application that uses given tree to prove the
+// query results. The tree must be the one that the application store is
+// backed by.
+func NewProvingApp(base app.BaseApp, tree *tmiavl.MutableTree, debug bool) ProvingApp {
+	return ProvingApp{
+		BaseApp: base,
+		tree:    tree,
+		debug:   debug,
+	}
+}
+
+// Query implements abci.Application interface.
+func (a ProvingApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	res := a.BaseApp.Query(req)
+	if !req.Prove || res.IsErr() {
+		return res
+	}
+	if len(res.Key) == 0 {
+		if req.Path == "/" {
+			return a.proveAbsence(req.Data, res)
+		}
+		return res
+	}
+
+	var keys app.ResultSet
+	if err := keys.Unmarshal(res.Key); err != nil {
+		return a.queryError(errors.Wrap(err, "cannot unmarshal keys"))
+	}
+	proof := &merkle.Proof{
+		Ops: make([]merkle.ProofOp, 0, len(keys.Results)),
+	}
+	for _, key := range keys.Results {
+		_, rp, err := a.tree.GetVersionedWithProof(key, res.Height)
+		if err != nil {
+			return a.queryError(errors.Wrap(errors.ErrDatabase, err.Error()))
+		}
+		proof.Ops = append(proof.Ops, tmiavl.NewIAVLValueOp(key, rp).ProofOp())
+	}
+	res.Proof = proof
+	return res
+}
+
+// proveAbsence attaches the proof that given key is not stored in the
+// state of the query height.
+func (a ProvingApp) proveAbsence(key []byte, res abci.ResponseQuery) abci.ResponseQuery {
+	value, rp, err := a.tree.GetVersionedWithProof(key, res.Height)
+	if err != nil {
+		return a.queryError(errors.Wrap(errors.ErrDatabase, err.Error()))
+	}
+	if value != nil {
+		return a.queryError(errors.Wrap(errors.ErrState, "key is stored"))
+	}
+	res.Proof = &merkle.Proof{
+		Ops: []merkle.ProofOp{tmiavl.NewIAVLAbsenceOp(key, rp).ProofOp()},
+	}
+	return res
+}
+
+func (a ProvingApp) queryError(err error) abci.ResponseQuery {
+	code, log := errors.ABCIInfo(err, a.debug)
+	return abci.ResponseQuery{
+		Code: code,
+		Log:  log,
+	}
+}
@@ -0,0 +1,120 @@
+package blog
+
+import (
+	"context"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/msgfee"
+)
+
+// SimulatePath is the query path that checks a transaction without
+// committing it.
+const SimulatePath = "/simulate"
+
+// simulateQuery runs a transaction through the Check of the application
+// handler. The query gets a cache of the last committed state, so the
+// changes made by the check are discarded with it. Transactions waiting in
+// the mempool are not part of that state, so a transaction that depends on
+// them, i.e. using a later nonce, fails.
+//
+// A failed check is reported in the result rather than as a query error, so
+// that the fees are reported for any transaction.
+//
+// The message fee is added to the required fee of the result, but it is not
+// enforced: the chain does not charge message fees.
+type simulateQuery struct {
+	handler weave.Handler
+	decoder weave.TxDecoder
+	chainID func() string
+}
+
+// RegisterSimulateQuery registers the query path that checks transactions
+// with the standard application stack. Given function returns the chain ID
+// the transactions are checked for, i.e. StoreApp.GetChainID.
+func RegisterSimulateQuery(r weave.QueryRouter, chainID func() string) {
+	r.Register(SimulatePath, simulateQuery{
+		handler: Stack(nil, coin.Coin{}),
+		decoder: TxDecoder,
+		chainID: chainID,
+	})
+}
+
+func (q simulateQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != "" {
+		return nil, errors.Wrapf(errors.ErrInput, "unsupported query modifier %q", mod)
+	}
+	var req SimulateQuery
+	if err := req.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot unmarshal simulate query")
+	}
+	if req.Height <= 0 || req.Time == 0 {
+		return nil, errors.Wrap(errors.ErrInput, "height and time are required")
+	}
+	chainID := q.chainID()
+	if chainID == "" {
+		return nil, errors.Wrap(errors.ErrState, "chain is not initialized")
+	}
+	// Changes made by the check are kept in memory and discarded.
+	cache := store.NewBTreeCacheWrap(db, store.NewNonAtomicBatch(store.MemStore()), nil)
+
+	var res SimulateResult
+	tx, err := q.decoder(req.Tx)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot decode transaction")
+	}
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get message")
+	}
+	var fee msgfee.MsgFee
+	switch err := msgfee.NewMsgFeeBucket().One(cache, []byte(msg.Path()), &fee); {
+	case err == nil:
+		res.MessageFee = fee.Fee
+	case !errors.ErrNotFound.Is(err):
+		return nil, errors.Wrap(err, "cannot load message fee")
+	}
+	var conf cash.Configuration
+	if err := gconf.Load(cache, "cash", &conf); err != nil {
+		return nil, errors.Wrap(err, "cannot load cash configuration")
+	}
+	res.MinimalFee = conf.MinimalFee
+
+	ctx := weave.WithHeight(context.Background(), req.Height)
+	ctx = weave.WithBlockTime(ctx, req.Time.Time())
+	ctx = weave.WithChainID(ctx, chainID)
+	switch cres, err := q.check(ctx, cache, tx); {
+	case err != nil:
+		res.Code, res.Log = errors.ABCIInfo(err, false)
+	default:
+		res.Log = cres.Log
+		res.Data = cres.Data
+		res.GasAllocated = cres.GasAllocated
+		res.GasPayment = cres.GasPayment
+		res.RequiredFee = cres.RequiredFee
+		// Same as the msgfee decorator would do.
+		if !res.MessageFee.IsZero() {
+			total, err := res.RequiredFee.Add(res.MessageFee)
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot apply message fee")
+			}
+			res.RequiredFee = total
+		}
+	}
+
+	raw, err := res.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal result")
+	}
+	return []weave.Model{weave.Pair([]byte(SimulatePath), raw)}, nil
+}
+
+// check runs the handler Check, turning panics into errors.
+func (q simulateQuery) check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (res *weave.CheckResult, err error) {
+	defer errors.Recover(&err)
+	return q.handler.Check(ctx, store, tx)
+}
@@ -0,0 +1,292 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// BlogUserResponse is a response on a query for a blog user
+type BlogUserResponse struct {
+	Key    []byte
+	User   blog.User
+	Height int64
+}
+
+// GetBlogUser will return a blog user given its key
+// Error codes are used when the query failed on the server
+func (cc *BlogClient) GetBlogUser(key []byte, opts ...QueryOption) (*BlogUserResponse, error) {
+	model, height, err := cc.getBySequence("/users", "user", key, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := BlogUserResponse{
+		Key:    key,
+		Height: height,
+	}
+	if err := out.User.Unmarshal(model.Value); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BlogResponse is a response on a query for a blog
+type BlogResponse struct {
+	Key    []byte
+	Blog   blog.Blog
+	Height int64
+}
+
+// GetBlog will return a blog given its key
+// Error codes are used when the query failed on the server
+func (cc *BlogClient) GetBlog(key []byte, opts ...QueryOption) (*BlogResponse, error) {
+	model, height, err := cc.getBySequence("/blogs", "blog", key, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := BlogResponse{
+		Key:    key,
+		Height: height,
+	}
+	if err := out.Blog.Unmarshal(model.Value); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ArticleResponse is a response on a query for an article
+type ArticleResponse struct {
+	Key     []byte
+	Article blog.Article
+	Height  int64
+}
+
+// GetArticle will return an article given its key
+// Error codes are used when the query failed on the server
+func (cc *BlogClient) GetArticle(key []byte, opts ...QueryOption) (*ArticleResponse, error) {
+	model, height, err := cc.getBySequence("/articles", "article", key, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := ArticleResponse{
+		Key:    key,
+		Height: height,
+	}
+	if err := out.Article.Unmarshal(model.Value); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// getBySequence queries a single entity by its sequence key and makes sure
+// the returned model is the one requested. A verified query looks up the raw
+// database key, so that a missing entity is proven to be absent.
+func (cc *BlogClient) getBySequence(path, bucket string, key []byte, opts []QueryOption) (*weave.Model, int64, error) {
+	if len(key) != 8 {
+		return nil, 0, errors.Wrapf(ErrInvalid, "invalid %s key", bucket)
+	}
+	data := key
+	if parseQueryOptions(opts).verified {
+		path = "/"
+		data = append([]byte(bucket+":"), key...)
+	}
+	resp, err := cc.query(path, data, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(resp.Models) == 0 { // empty list or nil
+		return nil, 0, errors.Wrap(errors.ErrNotFound, "model not found")
+	}
+	// assume only one result
+	model := resp.Models[0]
+	// make sure the return value is expected
+	got, err := bucketKey(bucket, model.Key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !bytes.Equal(key, got) {
+		return nil, 0, errors.Wrapf(ErrNoMatch, "queried %X, returned %X", key, got)
+	}
+	return &model, resp.Height, nil
+}
+
+// BlogListResponse is a response on a query for a list of blogs
+type BlogListResponse struct {
+	Blogs  []*blog.Blog
+	Height int64
+}
+
+// ListBlogsByOwner will return all blogs owned by given address
+// Error codes are used when the query failed on the server
+func (cc *BlogClient) ListBlogsByOwner(owner weave.Address, opts ...QueryOption) (*BlogListResponse, error) {
+	// make sure we send a valid address to the server
+	if err := owner.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid address")
+	}
+
+	resp, err := cc.query("/blogs/user", owner, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := BlogListResponse{
+		Blogs:  make([]*blog.Blog, 0, len(resp.Models)),
+		Height: resp.Height,
+	}
+	for _, m := range resp.Models {
+		if _, err := bucketKey("blog", m.Key); err != nil {
+			return nil, err
+		}
+		var b blog.Blog
+		if err := b.Unmarshal(m.Value); err != nil {
+			return nil, err
+		}
+		if !owner.Equals(b.Owner) {
+			return nil, errors.Wrapf(ErrNoMatch, "queried %s, returned %s", owner, b.Owner)
+		}
+		out.Blogs = append(out.Blogs, &b)
+	}
+	return &out, nil
+}
+
+// ArticleListResponse is a response on a query for a list of articles
+type ArticleListResponse struct {
+	Articles []*blog.Article
+	Height   int64
+}
+
+// ListArticlesByBlog will return all articles of given blog, pinned articles
+// first
+// Error codes are used when the query failed on the server
+func (cc *BlogClient) ListArticlesByBlog(blogKey []byte, opts ...QueryOption) (*ArticleListResponse, error) {
+	if len(blogKey) != 8 {
+		return nil, errors.Wrap(ErrInvalid, "invalid blog key")
+	}
+	resp, err := cc.query("/articles/blog", blogKey, opts)
+	if err != nil {
+		return nil, err
+	}
+	return articleList(blogKey, resp)
+}
+
+// LatestArticles will return at most n most recent articles of given blog,
+// the most recent first
+// Error codes are used when the query failed on the server
+func (cc *BlogClient) LatestArticles(blogKey []byte, n int, opts ...QueryOption) (*ArticleListResponse, error) {
+	if len(blogKey) != 8 {
+		return nil, errors.Wrap(ErrInvalid, "invalid blog key")
+	}
+	if n <= 0 || n > blog.MaxPageLimit {
+		return nil, errors.Wrapf(ErrInvalid, "n must be between 1 and %d", blog.MaxPageLimit)
+	}
+	resp, err := cc.AbciQueryTimeRange(blogKey, 0, 0, nil, n, true, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return articleList(blogKey, resp)
+}
+
+// articleList decodes articles of a single blog, making sure that all
+// returned articles belong to that blog.
+func articleList(blogKey []byte, resp AbciResponse) (*ArticleListResponse, error) {
+	out := ArticleListResponse{
+		Articles: make([]*blog.Article, 0, len(resp.Models)),
+		Height:   resp.Height,
+	}
+	for _, m := range resp.Models {
+		if _, err := bucketKey("article", m.Key); err != nil {
+			return nil, err
+		}
+		var a blog.Article
+		if err := a.Unmarshal(m.Value); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(blogKey, a.BlogKey) {
+			return nil, errors.Wrapf(ErrNoMatch, "queried blog %X, returned %X", blogKey, a.BlogKey)
+		}
+		out.Articles = append(out.Articles, &a)
+	}
+	return &out, nil
+}
+
+// BlogTxQuery selects blog transactions by the tags added by the blog
+// handlers. Empty fields do not restrict the search.
+type BlogTxQuery struct {
+	// Action is the message path, for example "blog/create_article"
+	Action string
+	// Blog is the key of the affected blog
+	Blog []byte
+	// Article is the key of the affected article
+	Article []byte
+	// Owner is the owner of the affected entity
+	Owner weave.Address
+	// Username is the name of the affected user
+	Username string
+	// MinHeight and MaxHeight limit the block height, zero is unbounded
+	MinHeight int64
+	MaxHeight int64
+}
+
+// Query returns the TxSearch query matching all the set fields.
+func (q BlogTxQuery) Query() (string, error) {
+	type cond struct{ tag, value string }
+	tags := []cond{
+		{blog.TagAction, q.Action},
+		{blog.TagUsername, q.Username},
+	}
+	if len(q.Blog) != 0 {
+		tags = append(tags, cond{blog.TagBlog, blog.TagKeyValue(q.Blog)})
+	}
+	if len(q.Article) != 0 {
+		tags = append(tags, cond{blog.TagArticle, blog.TagKeyValue(q.Article)})
+	}
+	if len(q.Owner) != 0 {
+		tags = append(tags, cond{blog.TagOwner, q.Owner.String()})
+	}
+
+	var conds []string
+	for _, t := range tags {
+		if t.value == "" {
+			continue
+		}
+		// the query language has no way to escape quotes
+		if strings.ContainsRune(t.value, '\'') {
+			return "", errors.Wrapf(errors.ErrInput, "invalid %s value", t.tag)
+		}
+		conds = append(conds, fmt.Sprintf("%s='%s'", t.tag, t.value))
+	}
+	if len(conds) == 0 {
+		return "", errors.Wrap(errors.ErrEmpty, "no blog tag selected")
+	}
+	if q.MinHeight > 0 {
+		conds = append(conds, fmt.Sprintf("tx.height>=%d", q.MinHeight))
+	}
+	if q.MaxHeight > 0 {
+		conds = append(conds, fmt.Sprintf("tx.height<=%d", q.MaxHeight))
+	}
+	return strings.Join(conds, " AND "), nil
+}
+
+// SearchBlogTxs returns a page of transactions matching given query. Pages
+// are numbered from 1.
+// The node must index the blog tags, see the tx_index node configuration.
+func (cc *BlogClient) SearchBlogTxs(q BlogTxQuery, page, perPage int) (*ctypes.ResultTxSearch, error) {
+	query, err := q.Query()
+	if err != nil {
+		return nil, err
+	}
+	return cc.TxSearch(query, false, page, perPage)
+}
+
+// key is the sequence prefixed with "<bucket>:"
+func bucketKey(bucket string, key []byte) ([]byte, error) {
+	prefix := []byte(bucket + ":")
+	if !bytes.HasPrefix(key, prefix) {
+		return nil, errors.Wrapf(ErrNoMatch, "returned key %X is not of %s bucket", key, bucket)
+	}
+	return key[len(prefix):], nil
+}
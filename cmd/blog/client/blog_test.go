@@ -0,0 +1,223 @@
+package client
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client/clienttest"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBlogQueries(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	author := clienttest.NewAuthor()
+	owner := author.PublicKey().Address()
+	poster := NewPoster(bc, author, coin.Coin{})
+
+	userRes, err := poster.Post(BuildCreateUserTx("Crypt0xxx", "Best hacker in the universe"))
+	assert.Nil(t, err)
+	blogRes, err := poster.Post(BuildCreateBlogTx("Best hacker's blog", "Best description ever"))
+	assert.Nil(t, err)
+	blogKey := blogRes.Key
+	assert.Equal(t, 8, len(blogKey))
+
+	blogs, err := bc.ListBlogsByOwner(owner)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blogs.Blogs))
+	assert.Equal(t, blogKey, blogs.Blogs[0].PrimaryKey)
+
+	for _, title := range []string{"first", "second", "third"} {
+		_, err := poster.Post(BuildCreateArticleTx(blogKey, title, "Best content ever", 0))
+		assert.Nil(t, err)
+	}
+
+	b, err := bc.GetBlog(blogKey)
+	assert.Nil(t, err)
+	assert.Equal(t, "Best hacker's blog", b.Blog.Title)
+	assert.Equal(t, true, b.Height > 0)
+
+	articles, err := bc.ListArticlesByBlog(blogKey)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(articles.Articles))
+	assert.Equal(t, "first", articles.Articles[0].Title)
+
+	latest, err := bc.LatestArticles(blogKey, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(latest.Articles))
+	assert.Equal(t, "third", latest.Articles[0].Title)
+	assert.Equal(t, "second", latest.Articles[1].Title)
+
+	// Pages of the time range query follow each other, even if articles
+	// are created at the same time.
+	var (
+		titles []string
+		after  []byte
+	)
+	for i := 0; i < 3; i++ {
+		resp, err := bc.AbciQueryTimeRange(blogKey, 0, 0, after, 1, false)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(resp.Models))
+		var a blog.Article
+		assert.Nil(t, a.Unmarshal(resp.Models[0].Value))
+		titles = append(titles, a.Title)
+		after = resp.NextCursor
+	}
+	assert.Equal(t, []string{"first", "second", "third"}, titles)
+	assert.Nil(t, after)
+
+	article, err := bc.GetArticle(articles.Articles[0].PrimaryKey)
+	assert.Nil(t, err)
+	assert.Equal(t, "first", article.Article.Title)
+
+	user, err := bc.GetBlogUser(userRes.Key)
+	assert.Nil(t, err)
+	assert.Equal(t, "Crypt0xxx", user.User.Username)
+
+	// Verified queries require a verifier.
+	_, err = bc.GetBlog(blogKey, Verified())
+	assert.IsErr(t, ErrInvalid, err)
+
+	// The verifier trusts only the header given by the caller.
+	trustedHeight := int64(1)
+	commit, err := conn.Commit(&trustedHeight)
+	assert.Nil(t, err)
+	trustedHash := commit.SignedHeader.Hash()
+	dir, err := ioutil.TempDir("", "verifier")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	_, err = NewVerifier(bc, clienttest.ChainID(), dir, trustedHeight, []byte("forged hash"))
+	assert.IsErr(t, ErrInvalidProof, err)
+	verifier, err := NewVerifier(bc, clienttest.ChainID(), dir, trustedHeight, trustedHash)
+	assert.Nil(t, err)
+	bc.WithVerifier(verifier)
+
+	vb, err := bc.GetBlog(blogKey, Verified())
+	assert.Nil(t, err)
+	assert.Equal(t, b.Blog.Title, vb.Blog.Title)
+
+	vlatest, err := bc.LatestArticles(blogKey, 2, Verified())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(vlatest.Articles))
+
+	missing := []byte{0, 0, 0, 0, 0, 0, 1, 0}
+	_, err = bc.GetBlog(missing)
+	assert.IsErr(t, errors.ErrNotFound, err)
+	// A missing entity is proven to be absent.
+	_, err = bc.GetBlog(missing, Verified())
+	assert.IsErr(t, errors.ErrNotFound, err)
+	// An empty list cannot be proven to be complete.
+	_, err = bc.ListBlogsByOwner(GenPrivateKey().PublicKey().Address(), Verified())
+	assert.IsErr(t, ErrIncomplete, err)
+
+	_, err = bc.GetArticle([]byte{1, 2, 3})
+	assert.IsErr(t, ErrInvalid, err)
+
+	_, err = bc.LatestArticles(blogKey, 0)
+	assert.IsErr(t, ErrInvalid, err)
+}
+
+func TestBucketKey(t *testing.T) {
+	key, err := bucketKey("blog", []byte("blog:\x00\x01"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{0, 1}, key)
+
+	_, err = bucketKey("blog", []byte("article:\x00\x01"))
+	assert.IsErr(t, ErrNoMatch, err)
+}
+
+func TestBlogTxQuery(t *testing.T) {
+	owner := GenPrivateKey().PublicKey().Address()
+
+	cases := map[string]struct {
+		q       BlogTxQuery
+		want    string
+		wantErr *errors.Error
+	}{
+		"empty": {
+			wantErr: errors.ErrEmpty,
+		},
+		"heights only": {
+			q:       BlogTxQuery{MinHeight: 1},
+			wantErr: errors.ErrEmpty,
+		},
+		"articles of a blog": {
+			q:    BlogTxQuery{Action: "blog/create_article", Blog: []byte{0, 0, 0, 0, 0, 0, 0, 7}},
+			want: "blog.action='blog/create_article' AND blog.blog='7'",
+		},
+		"all fields": {
+			q: BlogTxQuery{
+				Action:    "blog/pin_article",
+				Blog:      []byte{0, 0, 0, 0, 0, 0, 0, 7},
+				Article:   []byte{0, 0, 0, 0, 0, 0, 1, 0},
+				Owner:     owner,
+				Username:  "alice",
+				MinHeight: 5,
+				MaxHeight: 9,
+			},
+			want: "blog.action='blog/pin_article' AND blog.username='alice' AND blog.blog='7' AND blog.article='256' AND blog.owner='" + owner.String() + "' AND tx.height>=5 AND tx.height<=9",
+		},
+		"quote": {
+			q:       BlogTxQuery{Username: "x' OR tx.height>0"},
+			wantErr: errors.ErrInput,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.q.Query()
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSearchBlogTxs(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	author := clienttest.NewAuthor()
+	owner := author.PublicKey().Address()
+	poster := NewPoster(bc, author, coin.Coin{})
+
+	_, err := poster.Post(BuildCreateUserTx("searcher", "Finds everything"))
+	assert.Nil(t, err)
+	blogRes, err := poster.Post(BuildCreateBlogTx("Searched blog", "description"))
+	assert.Nil(t, err)
+	var articles [][]byte
+	for _, title := range []string{"first", "second"} {
+		res, err := poster.Post(BuildCreateArticleTx(blogRes.Key, title, "content", 0))
+		assert.Nil(t, err)
+		articles = append(articles, res.Key)
+	}
+
+	res, err := bc.SearchBlogTxs(BlogTxQuery{Action: "blog/create_article", Blog: blogRes.Key}, 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, res.TotalCount)
+	for _, tx := range res.Txs {
+		found := false
+		for _, key := range articles {
+			found = found || bytes.Equal(key, tx.TxResult.Data)
+		}
+		assert.Equal(t, true, found)
+	}
+
+	res, err = bc.SearchBlogTxs(BlogTxQuery{Owner: owner}, 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, res.TotalCount)
+
+	res, err = bc.SearchBlogTxs(BlogTxQuery{Article: articles[1]}, 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, res.TotalCount)
+
+	res, err = bc.SearchBlogTxs(BlogTxQuery{Username: "searcher"}, 1, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, res.TotalCount)
+}
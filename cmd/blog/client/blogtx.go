@@ -0,0 +1,227 @@
+package client
+
+import (
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+)
+
+// BuildCreateUserTx will create an unsigned tx to register a blog user
+func BuildCreateUserTx(username, bio string) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogCreateUserMsg{
+			BlogCreateUserMsg: &blog.CreateUserMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Username: username,
+				Bio:      bio,
+			},
+		},
+	}
+}
+
+// BuildCreateBlogTx will create an unsigned tx to create a blog
+func BuildCreateBlogTx(title, description string) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogCreateBlogMsg{
+			BlogCreateBlogMsg: &blog.CreateBlogMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Title:       title,
+				Description: description,
+			},
+		},
+	}
+}
+
+// BuildChangeBlogOwnerTx will create an unsigned tx to transfer a blog to
+// a new owner
+func BuildChangeBlogOwnerTx(blogKey []byte, newOwner weave.Address) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogChangeBlogOwnerMsg{
+			BlogChangeBlogOwnerMsg: &blog.ChangeBlogOwnerMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				BlogKey:  blogKey,
+				NewOwner: newOwner,
+			},
+		},
+	}
+}
+
+// BuildCreateArticleTx will create an unsigned tx to post an article. Zero
+// deleteAt creates an article that is never deleted automatically.
+func BuildCreateArticleTx(blogKey []byte, title, content string, deleteAt weave.UnixTime) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogCreateArticleMsg{
+			BlogCreateArticleMsg: &blog.CreateArticleMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				BlogKey:  blogKey,
+				Title:    title,
+				Content:  content,
+				DeleteAt: deleteAt,
+			},
+		},
+	}
+}
+
+// BuildDeleteArticleTx will create an unsigned tx to delete an article
+func BuildDeleteArticleTx(articleKey []byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogDeleteArticleMsg{
+			BlogDeleteArticleMsg: &blog.DeleteArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: articleKey,
+			},
+		},
+	}
+}
+
+// BuildCancelDeleteArticleTaskTx will create an unsigned tx to cancel
+// a scheduled deletion of an article
+func BuildCancelDeleteArticleTaskTx(articleKey []byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogCancelDeleteArticleTaskMsg{
+			BlogCancelDeleteArticleTaskMsg: &blog.CancelDeleteArticleTaskMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: articleKey,
+			},
+		},
+	}
+}
+
+// BuildHideArticleTx will create an unsigned tx to hide or unhide an article
+func BuildHideArticleTx(articleKey []byte, hidden bool) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogHideArticleMsg{
+			BlogHideArticleMsg: &blog.HideArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: articleKey,
+				Hidden:     hidden,
+			},
+		},
+	}
+}
+
+// BuildUpdateConfigurationTx will create an unsigned tx to update the blog
+// configuration. Only non zero fields of the patch are updated.
+func BuildUpdateConfigurationTx(patch *blog.Configuration) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogUpdateConfigurationMsg{
+			BlogUpdateConfigurationMsg: &blog.UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    patch,
+			},
+		},
+	}
+}
+
+// BuildCommissionArticleTx will create an unsigned tx to commission an
+// article from a blog owner
+func BuildCommissionArticleTx(blogKey []byte, amount coin.Coins, description string, timeout weave.UnixTime) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogCommissionArticleMsg{
+			BlogCommissionArticleMsg: &blog.CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     blogKey,
+				Amount:      amount,
+				Description: description,
+				Timeout:     timeout,
+			},
+		},
+	}
+}
+
+// BuildFulfillCommissionTx will create an unsigned tx to fulfill
+// a commission with an article
+func BuildFulfillCommissionTx(commissionKey, articleKey []byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogFulfillCommissionMsg{
+			BlogFulfillCommissionMsg: &blog.FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: commissionKey,
+				ArticleKey:    articleKey,
+			},
+		},
+	}
+}
+
+// BuildPinArticleTx will create an unsigned tx to pin an article
+func BuildPinArticleTx(articleKey []byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogPinArticleMsg{
+			BlogPinArticleMsg: &blog.PinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: articleKey,
+			},
+		},
+	}
+}
+
+// BuildUnpinArticleTx will create an unsigned tx to unpin an article
+func BuildUnpinArticleTx(articleKey []byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogUnpinArticleMsg{
+			BlogUnpinArticleMsg: &blog.UnpinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: articleKey,
+			},
+		},
+	}
+}
+
+// BuildCreateSeriesTx will create an unsigned tx to create a series of
+// articles of a blog
+func BuildCreateSeriesTx(blogKey []byte, title, description string, articleKeys [][]byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogCreateSeriesMsg{
+			BlogCreateSeriesMsg: &blog.CreateSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     blogKey,
+				Title:       title,
+				Description: description,
+				ArticleKeys: articleKeys,
+			},
+		},
+	}
+}
+
+// BuildAppendSeriesArticleTx will create an unsigned tx to append an article
+// to a series
+func BuildAppendSeriesArticleTx(seriesKey, articleKey []byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogAppendSeriesArticleMsg{
+			BlogAppendSeriesArticleMsg: &blog.AppendSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesKey,
+				ArticleKey: articleKey,
+			},
+		},
+	}
+}
+
+// BuildReorderSeriesTx will create an unsigned tx to change the order of
+// the series articles
+func BuildReorderSeriesTx(seriesKey []byte, articleKeys [][]byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogReorderSeriesMsg{
+			BlogReorderSeriesMsg: &blog.ReorderSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesKey:   seriesKey,
+				ArticleKeys: articleKeys,
+			},
+		},
+	}
+}
+
+// BuildRemoveSeriesArticleTx will create an unsigned tx to remove an article
+// from a series
+func BuildRemoveSeriesArticleTx(seriesKey, articleKey []byte) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_BlogRemoveSeriesArticleMsg{
+			BlogRemoveSeriesArticleMsg: &blog.RemoveSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesKey,
+				ArticleKey: articleKey,
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"testing"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestBuildBlogTxs(t *testing.T) {
+	key := weavetest.SequenceID(1)
+	other := weavetest.SequenceID(2)
+	addr := weavetest.NewCondition().Address()
+
+	txs := map[string]*app.Tx{
+		"create user":         BuildCreateUserTx("Crypt0xxx", "Best hacker in the universe"),
+		"create blog":         BuildCreateBlogTx("Best hacker's blog", "Best description ever"),
+		"change blog owner":   BuildChangeBlogOwnerTx(key, addr),
+		"create article":      BuildCreateArticleTx(key, "Best hacker's article", "Best content ever", 0),
+		"delete article":      BuildDeleteArticleTx(key),
+		"cancel delete":       BuildCancelDeleteArticleTaskTx(key),
+		"hide article":        BuildHideArticleTx(key, true),
+		"update config":       BuildUpdateConfigurationTx(&blog.Configuration{Metadata: &weave.Metadata{Schema: 1}, MaxPinnedArticles: 5}),
+		"commission article":  BuildCommissionArticleTx(key, coin.Coins{coin.NewCoinp(1, 0, "IOV")}, "Write about weave", 1000),
+		"fulfill commission":  BuildFulfillCommissionTx(key, other),
+		"pin article":         BuildPinArticleTx(key),
+		"unpin article":       BuildUnpinArticleTx(key),
+		"create series":       BuildCreateSeriesTx(key, "Weave tutorial", "All you need to know", [][]byte{key, other}),
+		"append series":       BuildAppendSeriesArticleTx(key, other),
+		"reorder series":      BuildReorderSeriesTx(key, [][]byte{other, key}),
+		"remove series entry": BuildRemoveSeriesArticleTx(key, other),
+	}
+	for testName, tx := range txs {
+		t.Run(testName, func(t *testing.T) {
+			// Serialization must preserve the message.
+			raw, err := tx.Marshal()
+			if err != nil {
+				t.Fatalf("cannot marshal: %s", err)
+			}
+			parsed, err := ParseBlogTx(raw)
+			if err != nil {
+				t.Fatalf("cannot parse: %s", err)
+			}
+			msg, err := parsed.GetMsg()
+			if err != nil {
+				t.Fatalf("cannot get message: %s", err)
+			}
+			if err := msg.Validate(); err != nil {
+				t.Fatalf("invalid message: %s", err)
+			}
+		})
+	}
+}
@@ -1,16 +1,21 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
+	"sync"
 	"time"
 
+	"github.com/iov-one/blog-tutorial/x/blog"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/sigs"
+	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	"github.com/tendermint/tendermint/lite"
 	"github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -30,16 +35,33 @@ type Client interface {
 	GetUser(addr weave.Address) (*UserResponse, error)
 	// GetWallet will return a wallet given an address
 	GetWallet(addr weave.Address) (*WalletResponse, error)
+	// GetBlogUser will return a blog user given its key
+	GetBlogUser(key []byte, opts ...QueryOption) (*BlogUserResponse, error)
+	// GetBlog will return a blog given its key
+	GetBlog(key []byte, opts ...QueryOption) (*BlogResponse, error)
+	// GetArticle will return an article given its key
+	GetArticle(key []byte, opts ...QueryOption) (*ArticleResponse, error)
+	// ListBlogsByOwner will return all blogs owned by given address
+	ListBlogsByOwner(owner weave.Address, opts ...QueryOption) (*BlogListResponse, error)
+	// ListArticlesByBlog will return all articles of given blog
+	ListArticlesByBlog(blogKey []byte, opts ...QueryOption) (*ArticleListResponse, error)
+	// LatestArticles will return at most n most recent articles of given blog
+	LatestArticles(blogKey []byte, n int, opts ...QueryOption) (*ArticleListResponse, error)
+	// SearchBlogTxs returns a page of transactions matching the blog tags
+	SearchBlogTxs(q BlogTxQuery, page, perPage int) (*ctypes.ResultTxSearch, error)
 	// BroadcastTx serializes a signed transaction and writes to the
 	// blockchain. It returns when the tx is committed to the blockchain.
 	BroadcastTx(tx weave.Tx) BroadcastTxResponse
-	// BroadcastTxAsync can be run in a goroutine and will output the
-	// result or error to the given channel.
+	// BroadcastTxAsync returns immediately and will output the result
+	// or error to the given channel.
 	BroadcastTxAsync(tx weave.Tx, out chan<- BroadcastTxResponse)
 	// BroadcastTxSync brodcasts transactions synchronously
 	BroadcastTxSync(tx weave.Tx, timeout time.Duration) BroadcastTxResponse
 	// AbciQuery calls abci query on tendermint rpc.
 	AbciQuery(path string, data []byte) (AbciResponse, error)
+	// AbciQueryVerified calls abci query on tendermint rpc and verifies
+	// the result merkle proofs.
+	AbciQueryVerified(path string, data []byte) (AbciResponse, error)
 	// NextNonce queries the blockchain for the next nonce
 	NextNonce(client Client, addr weave.Address) (int64, error)
 }
@@ -48,6 +70,12 @@ type Client interface {
 // simple access to the data structures used in blog module.
 type BlogClient struct {
 	conn client.Client
+	// start starts the connection before the first subscription, see
+	// subscribe. Failure to start is kept in startErr.
+	start    sync.Once
+	startErr error
+	// verifier is used to verify signed headers in verified queries
+	verifier lite.Verifier
 	// subscriber is a unique identifier for subscriptions
 	subscriber string
 }
@@ -57,7 +85,7 @@ type BlogClient struct {
 func NewClient(conn client.Client) *BlogClient {
 	return &BlogClient{
 		conn:       conn,
-		subscriber: "tools-client",
+		subscriber: "tools-client-" + hex.EncodeToString(cmn.RandBytes(4)),
 	}
 }
 
@@ -100,6 +128,31 @@ func (cc *BlogClient) Height() (int64, error) {
 	return status.SyncInfo.LatestBlockHeight, nil
 }
 
+// GetBlock returns the committed block of given height together with the
+// results of its execution.
+func (cc *BlogClient) GetBlock(height int64) (*Block, error) {
+	block, err := cc.conn.Block(&height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot load block %d", height)
+	}
+	results, err := cc.conn.BlockResults(&height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot load block %d results", height)
+	}
+	txs := block.Block.Data.Txs
+	if len(txs) != len(results.Results.DeliverTx) {
+		return nil, errors.Wrapf(errors.ErrState, "block %d has %d transactions and %d results",
+			height, len(txs), len(results.Results.DeliverTx))
+	}
+	return &Block{
+		Height:     height,
+		Time:       block.Block.Header.Time,
+		Txs:        txs,
+		BeginBlock: results.Results.BeginBlock,
+		DeliverTx:  results.Results.DeliverTx,
+	}, nil
+}
+
 // AbciResponse contains a query result:
 // a (possibly empty) list of key-value pairs, and the height
 // at which it queried
@@ -107,6 +160,10 @@ type AbciResponse struct {
 	// a list of key/value pairs
 	Models []weave.Model
 	Height int64
+	// NextCursor is set by AbciQueryPage and AbciQueryTimeRange when
+	// there are more results. Pass it as the after argument to fetch the
+	// next page.
+	NextCursor []byte
 }
 
 // AbciQuery calls abci query on tendermint rpc,
@@ -120,7 +177,14 @@ func (cc *BlogClient) AbciQuery(path string, data []byte) (AbciResponse, error)
 	if err != nil {
 		return out, err
 	}
-	resp := q.Response
+	return parseQueryResponse(q.Response)
+}
+
+// parseQueryResponse verifies if the ABCI query response is an error or
+// empty, and if there is data pulls out the ResultSets from keys and values
+// into a useful AbciResponse struct
+func parseQueryResponse(resp abci.ResponseQuery) (AbciResponse, error) {
+	var out AbciResponse
 	if resp.IsErr() {
 		return out, errors.ABCIError(resp.Code, resp.Log)
 	}
@@ -132,7 +196,7 @@ func (cc *BlogClient) AbciQuery(path string, data []byte) (AbciResponse, error)
 
 	// assume there is data, parse the result sets
 	var keys, vals app.ResultSet
-	err = keys.Unmarshal(resp.Key)
+	err := keys.Unmarshal(resp.Key)
 	if err != nil {
 		return out, err
 	}
@@ -145,6 +209,90 @@ func (cc *BlogClient) AbciQuery(path string, data []byte) (AbciResponse, error)
 	return out, err
 }
 
+// AbciQueryPage executes a range query on a paginated path (/users, /blogs,
+// /articles) and returns at most limit entities stored after the given
+// cursor. Use nil cursor to start from the beginning and zero limit for the
+// default page size. Returned response contains the cursor of the next page
+// if there are more entities.
+func (cc *BlogClient) AbciQueryPage(path string, after []byte, limit int, descending bool, opts ...QueryOption) (AbciResponse, error) {
+	if limit < 0 {
+		return AbciResponse{}, errors.Wrap(errors.ErrInput, "negative limit")
+	}
+	q := blog.PageQuery{
+		After:      after,
+		Limit:      uint32(limit),
+		Descending: descending,
+	}
+	data, err := q.Marshal()
+	if err != nil {
+		return AbciResponse{}, errors.Wrap(err, "cannot marshal page query")
+	}
+	out, err := cc.query(path+"?"+weave.RangeQueryMod, data, opts)
+	if err != nil {
+		return out, err
+	}
+	if limit == 0 {
+		limit = blog.DefaultPageLimit
+	}
+	// The node returns one entity more if there is a next page.
+	if len(out.Models) > limit {
+		out.Models = out.Models[:limit]
+		out.NextCursor = pageCursor(out.Models[limit-1].Key)
+	}
+	return out, nil
+}
+
+// AbciQueryTimeRange returns at most limit articles of the given blog, created
+// within [from, to) time range and following the given cursor. Zero to value
+// does not bound the range, nil cursor starts from the beginning and zero
+// limit uses the default page size. Articles are ordered by their creation
+// time. Returned response contains the cursor of the next page if there are
+// more articles.
+func (cc *BlogClient) AbciQueryTimeRange(blogKey []byte, from, to weave.UnixTime, after []byte, limit int, descending bool, opts ...QueryOption) (AbciResponse, error) {
+	if limit < 0 {
+		return AbciResponse{}, errors.Wrap(errors.ErrInput, "negative limit")
+	}
+	q := blog.TimeRangeQuery{
+		BlogKey:    blogKey,
+		From:       from,
+		To:         to,
+		Limit:      uint32(limit),
+		Descending: descending,
+		After:      after,
+	}
+	data, err := q.Marshal()
+	if err != nil {
+		return AbciResponse{}, errors.Wrap(err, "cannot marshal time range query")
+	}
+	out, err := cc.query("/articles/timedBlog?"+weave.RangeQueryMod, data, opts)
+	if err != nil {
+		return out, err
+	}
+	if limit == 0 {
+		limit = blog.DefaultPageLimit
+	}
+	// The node returns one article more if there is a next page.
+	if len(out.Models) <= limit {
+		return out, nil
+	}
+	out.Models = out.Models[:limit]
+	articles := make([]*blog.Article, len(out.Models))
+	for i, m := range out.Models {
+		articles[i] = &blog.Article{}
+		if err := articles[i].Unmarshal(m.Value); err != nil {
+			return out, errors.Wrap(err, "cannot unmarshal article")
+		}
+	}
+	out.NextCursor, err = blog.NextTimeRangeCursor(after, articles)
+	return out, err
+}
+
+// pageCursor returns the bucket local part of a database key, that is the
+// key without the "<bucket name>:" prefix.
+func pageCursor(key []byte) []byte {
+	return key[bytes.IndexByte(key, ':')+1:]
+}
+
 // TxSearch searches transactions using underlying tendermint client
 func (cc *BlogClient) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
 	return cc.conn.TxSearch(query, prove, page, perPage)
@@ -177,22 +325,29 @@ func (b BroadcastTxResponse) IsError() error {
 // blockchain. It returns when the tx is committed to the
 // blockchain.
 //
-// If you want high-performance, parallel sending, use BroadcastTxAsync
+// If you want high-performance, parallel sending, use a Pipeline
 func (cc *BlogClient) BroadcastTx(tx weave.Tx) BroadcastTxResponse {
-	out := make(chan BroadcastTxResponse, 1)
-	defer close(out)
-	go cc.BroadcastTxAsync(tx, out)
-	res := <-out
-	return res
+	return cc.BroadcastTxSync(tx, BroadcastTxSyncDefaultTimeOut)
 }
 
-// BroadcastTxSync brodcasts transactions synchronously
+// BroadcastTxSync brodcasts transactions synchronously. The transaction is
+// submitted with the tendermint BroadcastTxSync call, so a CheckTx failure is
+// returned without waiting for the timeout.
 func (cc *BlogClient) BroadcastTxSync(tx weave.Tx, timeout time.Duration) BroadcastTxResponse {
 	data, err := tx.Marshal()
 	if err != nil {
 		return BroadcastTxResponse{Error: err}
 	}
 
+	// subscribe before broadcasting, so that the event cannot be missed
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	evts, unsubscribe, err := cc.subscribeTx(ctx, data)
+	if err != nil {
+		return BroadcastTxResponse{Error: err}
+	}
+	defer unsubscribe()
+
 	res, err := cc.conn.BroadcastTxSync(data)
 	if err != nil {
 		return BroadcastTxResponse{Error: err}
@@ -203,17 +358,18 @@ func (cc *BlogClient) BroadcastTxSync(tx weave.Tx, timeout time.Duration) Broadc
 	}
 
 	// and wait for confirmation
-	evt, err := cc.WaitForTxEvent(data, tmtypes.EventTx, timeout)
-	if err != nil {
+	var evt ctypes.ResultEvent
+	select {
+	case evt = <-evts:
+	case <-ctx.Done():
+		err = errors.Wrap(errors.ErrTimeout, "waiting for event timed out")
 		return BroadcastTxResponse{Error: err}
 	}
 
-	txe, ok := evt.(tmtypes.EventDataTx)
+	txe, ok := evt.Data.(tmtypes.EventDataTx)
 	if !ok {
-		if err != nil {
-			err = errors.Wrap(err, "WaitForOneEvent did not return an EventDataTx object")
-			return BroadcastTxResponse{Error: err}
-		}
+		err = errors.Wrap(errors.ErrType, "event is not an EventDataTx object")
+		return BroadcastTxResponse{Error: err}
 	}
 
 	return BroadcastTxResponse{
@@ -229,16 +385,13 @@ func (cc *BlogClient) BroadcastTxSync(tx weave.Tx, timeout time.Duration) Broadc
 func (cc *BlogClient) WaitForTxEvent(tx tmtypes.Tx, evtTyp string, timeout time.Duration) (tmtypes.TMEventData, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
-	query := tmtypes.EventQueryTxFor(tx)
 
-	uuid := hex.EncodeToString(append(tx.Hash(), cmn.RandBytes(2)...))
-	evts, err := cc.conn.Subscribe(ctx, uuid, query.String())
+	evts, unsubscribe, err := cc.subscribeTx(ctx, tx)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to subscribe")
+		return nil, err
 	}
-
 	// make sure to unregister after the test is over
-	defer cc.conn.UnsubscribeAll(ctx, uuid)
+	defer unsubscribe()
 
 	select {
 	case evt := <-evts:
@@ -248,29 +401,43 @@ func (cc *BlogClient) WaitForTxEvent(tx tmtypes.Tx, evtTyp string, timeout time.
 	}
 }
 
-// BroadcastTxAsync can be run in a goroutine and will output
-// the result or error to the given channel.
-// Useful if you want to send many tx in parallel
-func (cc *BlogClient) BroadcastTxAsync(tx weave.Tx, out chan<- BroadcastTxResponse) {
-	data, err := tx.Marshal()
+// subscribeTx subscribes to the event of given transaction being committed.
+// Returned function cancels the subscription.
+func (cc *BlogClient) subscribeTx(ctx context.Context, tx tmtypes.Tx) (<-chan ctypes.ResultEvent, func(), error) {
+	query := tmtypes.EventQueryTxFor(tx)
+	uuid := hex.EncodeToString(append(tx.Hash(), cmn.RandBytes(2)...))
+	evts, err := cc.subscribe(ctx, uuid, query.String())
 	if err != nil {
-		out <- BroadcastTxResponse{Error: err}
-		return
+		return nil, nil, errors.Wrap(err, "failed to subscribe")
 	}
-
-	// TODO: make this async, maybe adjust return value
-	res, err := cc.conn.BroadcastTxCommit(data)
-	msg := BroadcastTxResponse{
-		Error:    err,
-		Response: res,
+	unsubscribe := func() {
+		cc.conn.UnsubscribeAll(context.Background(), uuid)
 	}
-	out <- msg
+	return evts, unsubscribe, nil
+}
+
+// BroadcastTxAsync returns immediately and outputs the result or error
+// to the given channel once the transaction is committed or rejected.
+// Useful if you want to send many tx in parallel. Transactions
+// signed by the same key must reach the mempool in the nonce order,
+// so use a Pipeline to send many transactions from one key.
+//
+// The transaction is broadcast with BroadcastTxSync in a new goroutine, so
+// that a CheckTx failure is reported right away.
+func (cc *BlogClient) BroadcastTxAsync(tx weave.Tx, out chan<- BroadcastTxResponse) {
+	go func() {
+		out <- cc.BroadcastTxSync(tx, BroadcastTxSyncDefaultTimeOut)
+	}()
 }
 
 // SubscribeHeaders queries for headers and starts a goroutine
 // to typecase the events into Headers. Returns a cancel
 // function. If you don't want the automatic goroutine, use
 // Subscribe(QueryNewBlockHeader, out)
+//
+// The subscription is not restored when the connection is lost
+// and headers dropped by the node are missing. Use a
+// SubscriptionManager to receive every header.
 func (cc *BlogClient) SubscribeHeaders(out chan<- *tmtypes.Header) (func(), error) {
 	query := tmtypes.EventQueryNewBlockHeader
 	pipe, cancel, err := cc.Subscribe(query)
@@ -281,8 +448,7 @@ func (cc *BlogClient) SubscribeHeaders(out chan<- *tmtypes.Header) (func(), erro
 		for msg := range pipe {
 			evt, ok := msg.Data.(tmtypes.EventDataNewBlockHeader)
 			if !ok {
-				// TODO: something else?
-				panic("Unexpected event type")
+				continue
 			}
 			out <- &evt.Header
 		}
@@ -291,13 +457,28 @@ func (cc *BlogClient) SubscribeHeaders(out chan<- *tmtypes.Header) (func(), erro
 	return cancel, nil
 }
 
+// subscribe subscribes to events matching given query. Events are delivered
+// over a websocket that a remote connection opens only when started, so the
+// connection is started with the first subscription if it is not running.
+func (cc *BlogClient) subscribe(ctx context.Context, subscriber, query string) (<-chan ctypes.ResultEvent, error) {
+	cc.start.Do(func() {
+		if !cc.conn.IsRunning() {
+			cc.startErr = cc.conn.Start()
+		}
+	})
+	if cc.startErr != nil {
+		return nil, errors.Wrap(cc.startErr, "cannot start connection")
+	}
+	return cc.conn.Subscribe(ctx, subscriber, query)
+}
+
 // Subscribe will take an arbitrary query and push all events to
 // the given channel. If there is no error,
 // returns a cancel function that can be called to cancel
 // the subscription
 func (cc *BlogClient) Subscribe(query tmpubsub.Query) (<-chan ctypes.ResultEvent, func(), error) {
 	ctx := context.Background()
-	out, err := cc.conn.Subscribe(ctx, cc.subscriber, query.String())
+	out, err := cc.subscribe(ctx, cc.subscriber, query.String())
 	if err != nil {
 		return out, nil, err
 	}
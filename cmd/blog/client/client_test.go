@@ -5,6 +5,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/iov-one/blog-tutorial/cmd/blog/client/clienttest"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest/assert"
@@ -65,8 +68,8 @@ func TestWalletQuery(t *testing.T) {
 	// check the wallet
 	assert.Equal(t, 1, len(wallet.Wallet.Coins))
 	coin := wallet.Wallet.Coins[0]
-	assert.Equal(t, initBalance.Whole, coin.Whole)
-	assert.Equal(t, initBalance.Ticker, coin.Ticker)
+	assert.Equal(t, clienttest.InitBalance.Whole, coin.Whole)
+	assert.Equal(t, clienttest.InitBalance.Ticker, coin.Ticker)
 }
 
 func TestNonce(t *testing.T) {
@@ -74,7 +77,7 @@ func TestNonce(t *testing.T) {
 	rcpt := GenPrivateKey().PublicKey().Address()
 	conn := NewLocalConnection(node)
 	blog := NewClient(conn)
-	chainID := getChainID()
+	chainID := clienttest.ChainID()
 
 	n, err := blog.NextNonce(src)
 	assert.Nil(t, err)
@@ -86,7 +89,7 @@ func TestNonce(t *testing.T) {
 	assert.Equal(t, int64(0), n)
 
 	// prepare the tx
-	amount := coin.Coin{Whole: 1000, Ticker: initBalance.Ticker}
+	amount := coin.Coin{Whole: 1000, Ticker: clienttest.InitBalance.Ticker}
 	tx := BuildSendTx(src, rcpt, amount, "Send 1")
 	n, err = blog.NextNonce(src)
 	assert.Nil(t, err)
@@ -109,10 +112,10 @@ func TestSendMoney(t *testing.T) {
 	rcpt := GenPrivateKey().PublicKey().Address()
 	src := faucet.PublicKey().Address()
 
-	chainID := getChainID()
+	chainID := clienttest.ChainID()
 
 	// build the tx
-	amount := coin.Coin{Whole: 1000, Ticker: initBalance.Ticker}
+	amount := coin.Coin{Whole: 1000, Ticker: clienttest.InitBalance.Ticker}
 	tx := BuildSendTx(src, rcpt, amount, "Send 1")
 	n, err := blog.NextNonce(src)
 	assert.Nil(t, err)
@@ -135,7 +138,70 @@ func TestSendMoney(t *testing.T) {
 	assert.Equal(t, 1, len(wallet.Wallet.Coins))
 	coin := wallet.Wallet.Coins[0]
 	assert.Equal(t, int64(1000), coin.Whole)
-	assert.Equal(t, initBalance.Ticker, coin.Ticker)
+	assert.Equal(t, clienttest.InitBalance.Ticker, coin.Ticker)
+}
+
+func TestAbciQueryPage(t *testing.T) {
+	conn := NewLocalConnection(node)
+	blog := NewClient(conn)
+
+	// No blog can exist after the greatest possible key.
+	last := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	resp, err := blog.AbciQueryPage("/blogs", last, 1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(resp.Models))
+	assert.Nil(t, resp.NextCursor)
+
+	poster := NewPoster(blog, GenPrivateKey(), coin.Coin{})
+	var keys [][]byte
+	for _, title := range []string{"First page", "Last page"} {
+		res, err := poster.Post(BuildCreateBlogTx(title, "Best description ever"))
+		assert.Nil(t, err)
+		keys = append(keys, res.Key)
+	}
+
+	resp, err = blog.AbciQueryPage("/blogs", nil, 1, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.Models))
+	assert.Equal(t, keys[1], resp.NextCursor)
+
+	// A full page that ends with the last blog has no next page.
+	resp, err = blog.AbciQueryPage("/blogs", keys[0], 1, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(resp.Models))
+	assert.Nil(t, resp.NextCursor)
+
+	// Paths that do not support pagination must not silently return
+	// an unbounded result.
+	_, err = blog.AbciQueryPage("/wallets", nil, 1, false)
+	if err == nil {
+		t.Fatal("want error for a non paginated path")
+	}
+
+	_, err = blog.AbciQueryPage("/blogs", nil, -1, false)
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestAbciQueryTimeRange(t *testing.T) {
+	conn := NewLocalConnection(node)
+	blog := NewClient(conn)
+
+	blogKey := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	resp, err := blog.AbciQueryTimeRange(blogKey, 0, 0, nil, 0, true)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(resp.Models))
+
+	_, err = blog.AbciQueryTimeRange(nil, 0, 0, nil, 0, false)
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestPageCursor(t *testing.T) {
+	assert.Equal(t, []byte{0, 1}, pageCursor([]byte("blog:\x00\x01")))
+	assert.Equal(t, []byte("raw"), pageCursor([]byte("raw")))
 }
 
 func TestSubscribeHeaders(t *testing.T) {
@@ -177,7 +243,7 @@ func TestSendMultipleTx(t *testing.T) {
 	src := faucet.PublicKey().Address()
 
 	chainID, err := blog.ChainID()
-	amount := coin.Coin{Whole: 1000, Ticker: initBalance.Ticker}
+	amount := coin.Coin{Whole: 1000, Ticker: clienttest.InitBalance.Ticker}
 	assert.Nil(t, err)
 
 	// a prep transaction, so the recipient has something to send
@@ -235,3 +301,64 @@ func TestSendMultipleTx(t *testing.T) {
 	assert.Equal(t, true, resp.Response.Height > prepH+1)
 	assert.Equal(t, true, resp2.Response.Height > prepH+1)
 }
+
+func TestSimulate(t *testing.T) {
+	conn := NewLocalConnection(node)
+	blog := NewClient(conn)
+
+	rcpt := GenPrivateKey().PublicKey().Address()
+	src := faucet.PublicKey().Address()
+	amount := coin.Coin{Whole: 1, Ticker: clienttest.InitBalance.Ticker}
+
+	tx := BuildSendTx(src, rcpt, amount, "Simulated")
+	n, err := blog.NextNonce(src)
+	assert.Nil(t, err)
+	assert.Nil(t, SignTx(tx, faucet, clienttest.ChainID(), n))
+
+	res, err := blog.Simulate(tx)
+	assert.Nil(t, err)
+	assert.Nil(t, res.Err())
+	assert.Equal(t, true, res.Height > 0)
+
+	// nothing was committed
+	n2, err := blog.NextNonce(src)
+	assert.Nil(t, err)
+	assert.Equal(t, n, n2)
+	_, err = blog.GetWallet(rcpt)
+	assert.IsErr(t, errors.ErrNotFound, err)
+
+	// a failed check is reported in the response
+	unsigned := BuildSendTx(src, rcpt, amount, "Unsigned")
+	res, err = blog.Simulate(unsigned)
+	assert.Nil(t, err)
+	assert.IsErr(t, errors.ErrUnauthorized, res.Err())
+
+	stale := BuildSendTx(src, rcpt, amount, "Future nonce")
+	assert.Nil(t, SignTx(stale, faucet, clienttest.ChainID(), n+1))
+	res, err = blog.Simulate(stale)
+	assert.Nil(t, err)
+	if res.Err() == nil {
+		t.Fatal("want the check to fail")
+	}
+}
+
+func TestSimulateMessageFee(t *testing.T) {
+	bc := NewClient(NewLocalConnection(node))
+	src := faucet.PublicKey().Address()
+	fee := clienttest.UpdateConfigurationFee
+
+	n, err := bc.NextNonce(src)
+	assert.Nil(t, err)
+
+	tx := BuildUpdateConfigurationTx(&blog.Configuration{
+		Metadata:          &weave.Metadata{Schema: 1},
+		MaxPinnedArticles: 5,
+	})
+	assert.Nil(t, SignTx(tx, faucet, clienttest.ChainID(), n))
+	res, err := bc.Simulate(tx)
+	assert.Nil(t, err)
+	// The message fee is reported, but the chain does not enforce it.
+	assert.Nil(t, res.Err())
+	assert.Equal(t, fee, res.MessageFee)
+	assert.Equal(t, fee, res.RequiredFee)
+}
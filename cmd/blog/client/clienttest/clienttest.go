@@ -0,0 +1,143 @@
+/*
+Package clienttest runs the blog application with an in-process tendermint
+node, so that the client and the services built on top of it can be tested
+against a real chain.
+*/
+package clienttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	weaveClient "github.com/iov-one/weave/client"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/commands/server"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x/cash"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	nm "github.com/tendermint/tendermint/node"
+	rpctest "github.com/tendermint/tendermint/rpc/test"
+	tm "github.com/tendermint/tendermint/types"
+)
+
+// InitBalance is the genesis balance of the admin account.
+var InitBalance = coin.Coin{
+	Whole:  100200300,
+	Ticker: "BLOG",
+}
+
+// UpdateConfigurationFee is the message fee of the blog configuration
+// update, the only message with a fee configured on the test chain. Message
+// fees are reported by the simulation, but not charged.
+var UpdateConfigurationFee = coin.Coin{
+	Whole:  2,
+	Ticker: "BLOG",
+}
+
+// adjust this to get debug output
+var logger = log.NewNopLogger() // log.NewTMLogger()
+
+// ChainID returns the chain ID of the test node.
+func ChainID() string {
+	return rpctest.GetConfig().ChainID()
+}
+
+// NewAuthor returns a new key for posting blog content. Tests use a fresh
+// author instead of the admin account, because the nonce of the admin account
+// is asserted by tests that run against the same node. Blog messages do not
+// require a balance, so the author is not funded.
+func NewAuthor() *crypto.PrivateKey {
+	return crypto.GenPrivKeyEd25519()
+}
+
+// RunWithNode starts a blog node and runs the tests of m against it. The
+// admin account holds the genesis balance and owns the blog configuration,
+// which makes it the moderator of the chain. Given function is called with
+// the node before the tests run. The exit code of the tests is returned, so
+// that it can be passed to os.Exit from TestMain.
+func RunWithNode(m *testing.M, admin weave.Address, setNode func(*nm.Node)) int {
+	config := rpctest.GetConfig()
+	config.Moniker = "SetInTestMain"
+	// index all tags, so that blog transactions can be searched
+	config.TxIndex.IndexTags = ""
+	config.TxIndex.IndexAllTags = true
+
+	// set up our application
+	app, err := initApp(config, admin)
+	if err != nil {
+		panic(err) // what else to do???
+	}
+	return weaveClient.TestWithTendermint(app, setNode, m)
+}
+
+func initApp(config *cfg.Config, addr weave.Address) (abci.Application, error) {
+	opts := &server.Options{
+		MinFee: coin.Coin{},
+		Home:   config.RootDir,
+		Logger: logger,
+		Debug:  false,
+	}
+	blog, err := blog.GenerateApp(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate genesis file...
+	err = initGenesis(config.GenesisFile(), addr)
+	return blog, err
+}
+
+func initGenesis(filename string, addr weave.Address) error {
+	doc, err := tm.GenesisDocFromFile(filename)
+	if err != nil {
+		return err
+	}
+	appState, err := json.Marshal(map[string]interface{}{
+		"cash": []interface{}{
+			dict{
+				"address": addr,
+				"coins":   coin.Coins{&InitBalance},
+			},
+		},
+		"msgfee": []dict{
+			{"msg_path": "blog/update_configuration", "fee": UpdateConfigurationFee},
+		},
+		"conf": dict{
+			"cash": cash.Configuration{
+				CollectorAddress: weave.NewAddress([]byte("fake-collector-address")),
+				MinimalFee:       coin.Coin{}, // no fee
+			},
+			"migration": migration.Configuration{
+				Admin: weave.Condition("multisig/usage/0000000000000001").Address(),
+			},
+			"blog": dict{
+				"owner":              addr,
+				"article_rate_limit": 10,
+				"rate_limit_window":  "1h",
+			},
+		},
+		"initialize_schema": []dict{
+			{"pkg": "migration", "ver": 1},
+			{"pkg": "cash", "ver": 1},
+			{"pkg": "msgfee", "ver": 1},
+			{"pkg": "sigs", "ver": 1},
+			{"pkg": "multisig", "ver": 1},
+			{"pkg": "cron", "ver": 1},
+			{"pkg": "utils", "ver": 1},
+			{"pkg": "validators", "ver": 1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("serialize state: %s", err)
+	}
+	doc.AppState = appState
+	return doc.SaveAs(filename)
+}
+
+type dict map[string]interface{}
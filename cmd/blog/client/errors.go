@@ -11,4 +11,9 @@ var (
 	ErrInvalid = errors.Register(122, "invalid")
 	// ErrPermission is returned when an action is not permitted
 	ErrPermission = errors.Register(123, "not permitted")
+	// ErrInvalidProof is returned when a query result cannot be proven
+	ErrInvalidProof = errors.Register(124, "invalid proof")
+	// ErrIncomplete is returned when a verified query result cannot be
+	// proven to be complete
+	ErrIncomplete = errors.Register(125, "completeness not verified")
 )
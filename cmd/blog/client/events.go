@@ -0,0 +1,263 @@
+package client
+
+import (
+	"bytes"
+	"encoding/hex"
+	"time"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/batch"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// BlogEvent is a change of the blog state decoded from a committed block.
+// It is one of UserCreated, BlogCreated, ArticleCreated, ArticleDeleted and
+// EntityUpdated.
+type BlogEvent interface {
+	// EventHeight returns the height of the block with the change.
+	EventHeight() int64
+}
+
+// EventMeta describes where a blog event comes from.
+type EventMeta struct {
+	Height int64
+	// TxHash is the hash of the transaction that caused the change. It is
+	// nil for changes made by scheduled tasks.
+	TxHash []byte
+}
+
+// EventHeight implements BlogEvent.
+func (m EventMeta) EventHeight() int64 {
+	return m.Height
+}
+
+// UserCreated is emitted when a blog user is registered.
+type UserCreated struct {
+	EventMeta
+	Key      []byte
+	Username string
+	Bio      string
+}
+
+// BlogCreated is emitted when a blog is created.
+type BlogCreated struct {
+	EventMeta
+	Key         []byte
+	Title       string
+	Description string
+}
+
+// ArticleCreated is emitted when an article is created.
+type ArticleCreated struct {
+	EventMeta
+	Key     []byte
+	BlogKey []byte
+	Title   string
+}
+
+// ArticleDeleted is emitted when an article is deleted, either by its owner
+// or by the scheduled deletion task.
+type ArticleDeleted struct {
+	EventMeta
+	Key []byte
+	// Cron is true if the article was deleted by the scheduled task.
+	Cron bool
+}
+
+// EntityUpdated is emitted when a user, a blog or an article is changed by
+// anything other than its creation, for example when a governance proposal
+// hides an article. The new state must be queried.
+type EntityUpdated struct {
+	EventMeta
+	// Bucket is one of "user", "blog" and "article".
+	Bucket string
+	Key    []byte
+}
+
+// Block is a committed block together with the results of its execution.
+type Block struct {
+	Height int64
+	Time   time.Time
+	Txs    tmtypes.Txs
+	// BeginBlock holds the results of the scheduled tasks executed before
+	// the transactions.
+	BeginBlock *abci.ResponseBeginBlock
+	// DeliverTx holds the result of every transaction, in the same order.
+	DeliverTx []*abci.ResponseDeliverTx
+}
+
+// Events returns the blog events of the block.
+func (b *Block) Events() []BlogEvent {
+	return blockEvents(b.Height, b.Txs, b.BeginBlock, b.DeliverTx)
+}
+
+// blockEvents decodes the blog events of a single block, in the order the
+// changes were made: scheduled tasks run before the transactions.
+func blockEvents(height int64, txs tmtypes.Txs, begin *abci.ResponseBeginBlock, results []*abci.ResponseDeliverTx) []BlogEvent {
+	var events []BlogEvent
+	if begin != nil {
+		events = append(events, tagEvents(EventMeta{Height: height}, begin.Tags, nil)...)
+	}
+	for i, tx := range txs {
+		if i >= len(results) || results[i].IsErr() {
+			continue
+		}
+		events = append(events, txEvents(height, tx, results[i])...)
+	}
+	return events
+}
+
+// txEvents decodes the blog events of a single successful transaction.
+// Messages of a batch are decoded one by one.
+func txEvents(height int64, tx tmtypes.Tx, res *abci.ResponseDeliverTx) []BlogEvent {
+	meta := EventMeta{Height: height, TxHash: tx.Hash()}
+
+	var events []BlogEvent
+	created := make(map[string]bool)
+	var t app.Tx
+	if err := t.Unmarshal(tx); err == nil {
+		var msgs []ExecutedMsg
+		if msg, err := t.GetMsg(); err == nil {
+			msgs, _ = ExecutedMsgs(msg, res.Data)
+		}
+		for _, m := range msgs {
+			switch msg := m.Msg.(type) {
+			case *blog.CreateUserMsg:
+				created["user:"+string(m.Data)] = true
+				events = append(events, UserCreated{
+					EventMeta: meta,
+					Key:       m.Data,
+					Username:  msg.Username,
+					Bio:       msg.Bio,
+				})
+			case *blog.CreateBlogMsg:
+				created["blog:"+string(m.Data)] = true
+				events = append(events, BlogCreated{
+					EventMeta:   meta,
+					Key:         m.Data,
+					Title:       msg.Title,
+					Description: msg.Description,
+				})
+			case *blog.CreateArticleMsg:
+				created["article:"+string(m.Data)] = true
+				events = append(events, ArticleCreated{
+					EventMeta: meta,
+					Key:       m.Data,
+					BlogKey:   msg.BlogKey,
+					Title:     msg.Title,
+				})
+			}
+		}
+	}
+	return append(events, tagEvents(meta, res.Tags, created)...)
+}
+
+// tagEvents returns the deletions and the updates recorded by the key
+// tags, so that they are found regardless of the message that caused them.
+// Entities that were just created are not reported as updated. Deletions
+// without a transaction hash come from the scheduled tasks.
+func tagEvents(meta EventMeta, tags []cmn.KVPair, created map[string]bool) []BlogEvent {
+	var events []BlogEvent
+	for _, key := range DeletedArticles(tags) {
+		events = append(events, ArticleDeleted{EventMeta: meta, Key: key, Cron: meta.TxHash == nil})
+	}
+	for _, c := range KeyChanges(tags, "user", "blog", "article") {
+		if c.Deleted || len(c.Key) != 8 || created[c.Bucket+":"+string(c.Key)] {
+			continue
+		}
+		events = append(events, EntityUpdated{EventMeta: meta, Bucket: c.Bucket, Key: c.Key})
+	}
+	return events
+}
+
+// KeyChange is a change of a stored entity recorded by the key tags.
+type KeyChange struct {
+	// Bucket is the name of the bucket the entity is stored in.
+	Bucket string
+	Key    []byte
+	// Deleted is true if the entity was deleted, false if it was set.
+	Deleted bool
+}
+
+// KeyChanges returns the changes of the entities stored in any of given
+// buckets according to the key tags. Each tag key is a hex encoded database
+// key and its value is "s" for set or "d" for delete.
+func KeyChanges(tags []cmn.KVPair, buckets ...string) []KeyChange {
+	var changes []KeyChange
+	for _, tag := range tags {
+		action := string(tag.Value)
+		if action != "s" && action != "d" {
+			continue
+		}
+		raw, err := hex.DecodeString(string(tag.Key))
+		if err != nil {
+			continue
+		}
+		for _, bucket := range buckets {
+			prefix := []byte(bucket + ":")
+			if bytes.HasPrefix(raw, prefix) {
+				changes = append(changes, KeyChange{
+					Bucket:  bucket,
+					Key:     raw[len(prefix):],
+					Deleted: action == "d",
+				})
+				break
+			}
+		}
+	}
+	return changes
+}
+
+// DeletedArticles returns the keys of the articles deleted according to the
+// key tags.
+func DeletedArticles(tags []cmn.KVPair) [][]byte {
+	var keys [][]byte
+	for _, c := range KeyChanges(tags, "article") {
+		if c.Deleted && len(c.Key) == 8 {
+			keys = append(keys, c.Key)
+		}
+	}
+	return keys
+}
+
+// ExecutedMsg is a message executed by a transaction together with the data
+// returned by its handler.
+type ExecutedMsg struct {
+	Msg  weave.Msg
+	Data []byte
+}
+
+// ExecutedMsgs returns the messages executed by a transaction, given its
+// message and the data of its result. Batch messages are unpacked
+// recursively and each message is paired with its part of the batch result.
+func ExecutedMsgs(msg weave.Msg, data []byte) ([]ExecutedMsg, error) {
+	b, ok := msg.(batch.Msg)
+	if !ok {
+		return []ExecutedMsg{{Msg: msg, Data: data}}, nil
+	}
+	msgs, err := b.MsgList()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get batch messages")
+	}
+	var results batch.ByteArrayList
+	if err := results.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(err, "cannot decode batch result")
+	}
+	if len(results.Elements) != len(msgs) {
+		return nil, errors.Wrapf(errors.ErrState, "batch of %d messages with %d results", len(msgs), len(results.Elements))
+	}
+	var executed []ExecutedMsg
+	for i, m := range msgs {
+		inner, err := ExecutedMsgs(m, results.Elements[i])
+		if err != nil {
+			return nil, err
+		}
+		executed = append(executed, inner...)
+	}
+	return executed, nil
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/stellar/go/exp/crypto/derivation"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ed25519"
+)
+
+// HDCoinPath is the BIP-44 derivation path of the coin type used by the
+// blog application. Accounts are derived as its hardened children.
+const HDCoinPath = "m/44'/988'"
+
+// AccountPath returns the derivation path of the account with given index.
+func AccountPath(index uint32) string {
+	return fmt.Sprintf("%s/%d'", HDCoinPath, index)
+}
+
+// ValidateMnemonic returns an error if given mnemonic is not valid.
+// Whitespaces are relevant.
+//
+// Use this instead of bip39.IsMnemonicValid because this function ensures the
+// checksum consistency. bip39.IsMnemonicValid does not test the checksum. It
+// also ignores whitespaces.
+//
+// This function ensures that the mnemonic is a single space separated list of
+// words as this is important during seed creation.
+func ValidateMnemonic(mnemonic string) error {
+	// A lazy way to check that words are exactly single space separated.
+	expected := strings.Join(strings.Fields(mnemonic), " ")
+	if mnemonic != expected {
+		return errors.Wrap(errors.ErrInput, "whitespace violation")
+	}
+
+	// Entropy generation does base validation of checking if words are
+	// valid and in the right amount. It also tests the checksum.
+	if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
+		return errors.Wrapf(errors.ErrInput, "entropy: %s", err)
+	}
+	return nil
+}
+
+// HDWallet derives ed25519 keys from a BIP-39 mnemonic using SLIP-0010
+// derivation. Only hardened derivation paths are supported.
+type HDWallet struct {
+	seed []byte
+}
+
+// NewHDWallet returns a wallet of given mnemonic. The optional passphrase
+// is the BIP-39 passphrase, that results in a completely different set of
+// keys for the same mnemonic.
+func NewHDWallet(mnemonic, passphrase string) (*HDWallet, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, errors.Wrap(err, "invalid mnemonic")
+	}
+	return &HDWallet{seed: bip39.NewSeed(mnemonic, passphrase)}, nil
+}
+
+// Derive returns the private key of given derivation path.
+func (w *HDWallet) Derive(path string) (*crypto.PrivateKey, error) {
+	key, err := derivation.DeriveForPath(path, w.seed)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot derive key for %q: %s", path, err)
+	}
+	return privateKey(key)
+}
+
+// Account returns the private key of the account with given index.
+func (w *HDWallet) Account(index uint32) (*crypto.PrivateKey, error) {
+	return w.Derive(AccountPath(index))
+}
+
+// Accounts returns the private keys of n sequential accounts, starting with
+// the account of given index.
+func (w *HDWallet) Accounts(first, n uint32) ([]*crypto.PrivateKey, error) {
+	if first+n < first || first+n > derivation.FirstHardenedIndex {
+		return nil, errors.Wrap(errors.ErrInput, "account index out of range")
+	}
+	// Derive the common parent only once.
+	parent, err := derivation.DeriveForPath(HDCoinPath, w.seed)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot derive key for %q: %s", HDCoinPath, err)
+	}
+	keys := make([]*crypto.PrivateKey, 0, n)
+	for i := first; i < first+n; i++ {
+		child, err := parent.Derive(derivation.FirstHardenedIndex + i)
+		if err != nil {
+			return nil, errors.Wrapf(errors.ErrInput, "cannot derive key for %q: %s", AccountPath(i), err)
+		}
+		key, err := privateKey(child)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// privateKey returns the ed25519 private key of a derived key.
+func privateKey(key *derivation.Key) (*crypto.PrivateKey, error) {
+	_, priv, err := ed25519.GenerateKey(bytes.NewReader(key.Key))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot generate ed25519 private key")
+	}
+	return &crypto.PrivateKey{
+		Priv: &crypto.PrivateKey_Ed25519{Ed25519: priv},
+	}, nil
+}
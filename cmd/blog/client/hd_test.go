@@ -0,0 +1,45 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestHDWallet(t *testing.T) {
+	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+
+	w, err := NewHDWallet(mnemonic, "")
+	assert.Nil(t, err)
+
+	// the account derived at the default path of keygen
+	key, err := w.Derive("m/44'/988'/0'")
+	assert.Nil(t, err)
+	acc, err := w.Account(0)
+	assert.Nil(t, err)
+	assert.Equal(t, key, acc)
+
+	keys, err := w.Accounts(2, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(keys))
+	for i, k := range keys {
+		want, err := w.Derive(AccountPath(uint32(2 + i)))
+		assert.Nil(t, err)
+		assert.Equal(t, want, k)
+	}
+
+	// a passphrase results in different keys
+	withPass, err := NewHDWallet(mnemonic, "my passphrase")
+	assert.Nil(t, err)
+	passKey, err := withPass.Account(0)
+	assert.Nil(t, err)
+	assert.Equal(t, false, key.PublicKey().Address().Equals(passKey.PublicKey().Address()))
+
+	_, err = w.Derive("m/44'/988'/0")
+	assert.IsErr(t, errors.ErrInput, err)
+	_, err = w.Accounts(1<<31-1, 2)
+	assert.IsErr(t, errors.ErrInput, err)
+	_, err = NewHDWallet("shy else mystery", "")
+	assert.IsErr(t, errors.ErrInput, err)
+}
@@ -0,0 +1,267 @@
+package client
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// KeystoreVersion is the version of the keystore file format.
+const KeystoreVersion = 1
+
+// Default scrypt cost parameters used to derive the encryption key from a
+// passphrase, as recommended for interactive logins.
+const (
+	ScryptN = 1 << 15
+	ScryptR = 8
+	ScryptP = 1
+)
+
+// Keystore holds named private keys, each encrypted with a key derived from
+// a passphrase. Addresses are stored in plain text, so that keys can be
+// listed without the passphrase.
+//
+// Keystore is read and written as a whole. Changes are persisted only after
+// calling Save.
+type Keystore struct {
+	path string
+	keys map[string]*EncryptedKey
+}
+
+// EncryptedKey is a private key protected by NaCl secretbox, with the secret
+// key derived from a passphrase using scrypt.
+type EncryptedKey struct {
+	Address weave.Address `json:"address"`
+	Scrypt  ScryptParams  `json:"scrypt"`
+	// Nonce is the secretbox nonce.
+	Nonce hexBytes `json:"nonce"`
+	// Ciphertext is the protobuf serialized crypto.PrivateKey, sealed
+	// with secretbox.
+	Ciphertext hexBytes `json:"ciphertext"`
+}
+
+// ScryptParams are the parameters of the scrypt key derivation.
+type ScryptParams struct {
+	N    int      `json:"n"`
+	R    int      `json:"r"`
+	P    int      `json:"p"`
+	Salt hexBytes `json:"salt"`
+}
+
+type keystoreFile struct {
+	Version int                      `json:"version"`
+	Keys    map[string]*EncryptedKey `json:"keys"`
+}
+
+type hexBytes []byte
+
+func (b hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hex.EncodeToString(b))
+}
+
+func (b *hexBytes) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return err
+	}
+	val, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	*b = val
+	return nil
+}
+
+// OpenKeystore loads the keystore stored in given file. If the file does
+// not exist, an empty keystore is returned and the file is created by Save.
+func OpenKeystore(path string) (*Keystore, error) {
+	ks := &Keystore{path: path, keys: make(map[string]*EncryptedKey)}
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ks, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	var f keystoreFile
+	if err := json.Unmarshal(raw, &f); err != nil {
+		return nil, errors.Wrap(ErrInvalid, "cannot decode keystore")
+	}
+	if f.Version != KeystoreVersion {
+		return nil, errors.Wrapf(ErrInvalid, "unsupported keystore version %d", f.Version)
+	}
+	for name, k := range f.Keys {
+		ks.keys[name] = k
+	}
+	return ks, nil
+}
+
+// Path returns the path of the keystore file.
+func (ks *Keystore) Path() string {
+	return ks.path
+}
+
+// Names returns the sorted names of all stored keys.
+func (ks *Keystore) Names() []string {
+	names := make([]string, 0, len(ks.keys))
+	for name := range ks.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Address returns the address of the named key.
+func (ks *Keystore) Address(name string) (weave.Address, error) {
+	k, ok := ks.keys[name]
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrNotFound, "key %q", name)
+	}
+	return k.Address, nil
+}
+
+// Add encrypts the key with given passphrase and stores it under given
+// name. Existing keys are never overwritten.
+func (ks *Keystore) Add(name string, key *crypto.PrivateKey, passphrase []byte) error {
+	if name == "" {
+		return errors.Wrap(errors.ErrEmpty, "key name")
+	}
+	if _, ok := ks.keys[name]; ok {
+		return errors.Wrapf(errors.ErrDuplicate, "key %q", name)
+	}
+	if len(passphrase) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "passphrase")
+	}
+	k, err := EncryptKey(key, passphrase, rand.Reader)
+	if err != nil {
+		return err
+	}
+	ks.keys[name] = k
+	return nil
+}
+
+// Get decrypts and returns the named key.
+func (ks *Keystore) Get(name string, passphrase []byte) (*crypto.PrivateKey, error) {
+	k, ok := ks.keys[name]
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrNotFound, "key %q", name)
+	}
+	return k.Decrypt(passphrase)
+}
+
+// Delete removes the named key.
+func (ks *Keystore) Delete(name string) error {
+	if _, ok := ks.keys[name]; !ok {
+		return errors.Wrapf(errors.ErrNotFound, "key %q", name)
+	}
+	delete(ks.keys, name)
+	return nil
+}
+
+// Save writes the keystore to its file. The file is replaced atomically, so
+// that a failure never leaves a partially written keystore.
+func (ks *Keystore) Save() error {
+	raw, err := json.MarshalIndent(keystoreFile{Version: KeystoreVersion, Keys: ks.keys}, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "cannot encode keystore")
+	}
+	fd, err := ioutil.TempFile(filepath.Dir(ks.path), filepath.Base(ks.path)+".tmp")
+	if err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	defer os.Remove(fd.Name())
+	if err := fd.Chmod(KeyPerm); err != nil {
+		fd.Close()
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if _, err := fd.Write(raw); err != nil {
+		fd.Close()
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if err := fd.Close(); err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if err := os.Rename(fd.Name(), ks.path); err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+// EncryptKey encrypts the private key with a secret derived from given
+// passphrase. Random values are read from given source.
+func EncryptKey(key *crypto.PrivateKey, passphrase []byte, random io.Reader) (*EncryptedKey, error) {
+	raw, err := key.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot serialize key")
+	}
+	k := &EncryptedKey{
+		Address: key.PublicKey().Address(),
+		Scrypt: ScryptParams{
+			N:    ScryptN,
+			R:    ScryptR,
+			P:    ScryptP,
+			Salt: make([]byte, 32),
+		},
+	}
+	var nonce [24]byte
+	if _, err := io.ReadFull(random, k.Scrypt.Salt); err != nil {
+		return nil, errors.Wrap(err, "cannot generate salt")
+	}
+	if _, err := io.ReadFull(random, nonce[:]); err != nil {
+		return nil, errors.Wrap(err, "cannot generate nonce")
+	}
+	secret, err := k.Scrypt.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	k.Nonce = nonce[:]
+	k.Ciphertext = secretbox.Seal(nil, raw, &nonce, secret)
+	return k, nil
+}
+
+// Decrypt returns the private key. ErrUnauthorized is returned if the
+// passphrase is not correct.
+func (k *EncryptedKey) Decrypt(passphrase []byte) (*crypto.PrivateKey, error) {
+	if len(k.Nonce) != 24 {
+		return nil, errors.Wrap(ErrInvalid, "nonce")
+	}
+	secret, err := k.Scrypt.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [24]byte
+	copy(nonce[:], k.Nonce)
+	raw, ok := secretbox.Open(nil, k.Ciphertext, &nonce, secret)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "invalid passphrase")
+	}
+	var key crypto.PrivateKey
+	if err := key.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(ErrInvalid, "cannot decode key")
+	}
+	if !key.PublicKey().Address().Equals(k.Address) {
+		return nil, errors.Wrap(ErrNoMatch, "key does not match address")
+	}
+	return &key, nil
+}
+
+func (p ScryptParams) deriveKey(passphrase []byte) (*[32]byte, error) {
+	raw, err := scrypt.Key(passphrase, p.Salt, p.N, p.R, p.P, 32)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalid, err.Error())
+	}
+	var secret [32]byte
+	copy(secret[:], raw)
+	return &secret, nil
+}
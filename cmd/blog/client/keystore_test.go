@@ -0,0 +1,64 @@
+package client
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestKeystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keystore.json")
+
+	// a missing file is an empty keystore
+	ks, err := OpenKeystore(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(ks.Names()))
+
+	alice, bob := GenPrivateKey(), GenPrivateKey()
+	assert.Nil(t, ks.Add("alice", alice, []byte("alice secret")))
+	assert.Nil(t, ks.Add("bob", bob, []byte("bob secret")))
+	assert.IsErr(t, errors.ErrDuplicate, ks.Add("alice", bob, []byte("other")))
+	assert.IsErr(t, errors.ErrEmpty, ks.Add("carol", bob, nil))
+	assert.Nil(t, ks.Save())
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(KeyPerm), info.Mode().Perm())
+
+	// keys are not stored in plain text
+	raw, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	enc, err := EncodePrivateKey(alice)
+	assert.Nil(t, err)
+	assert.Equal(t, false, strings.Contains(string(raw), enc))
+
+	ks, err = OpenKeystore(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"alice", "bob"}, ks.Names())
+	addr, err := ks.Address("bob")
+	assert.Nil(t, err)
+	assert.Equal(t, bob.PublicKey().Address(), addr)
+
+	key, err := ks.Get("alice", []byte("alice secret"))
+	assert.Nil(t, err)
+	assert.Equal(t, alice, key)
+	_, err = ks.Get("alice", []byte("bob secret"))
+	assert.IsErr(t, errors.ErrUnauthorized, err)
+	_, err = ks.Get("carol", []byte("alice secret"))
+	assert.IsErr(t, errors.ErrNotFound, err)
+
+	assert.Nil(t, ks.Delete("alice"))
+	assert.IsErr(t, errors.ErrNotFound, ks.Delete("alice"))
+	assert.Nil(t, ks.Save())
+	ks, err = OpenKeystore(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bob"}, ks.Names())
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/x/multisig"
+)
+
+// ContractResponse is a response on a query for a multisig contract
+type ContractResponse struct {
+	Key      []byte
+	Contract multisig.Contract
+	Height   int64
+}
+
+// GetContract will return a multisig contract given its key
+// Error codes are used when the query failed on the server
+func (cc *BlogClient) GetContract(key []byte, opts ...QueryOption) (*ContractResponse, error) {
+	model, height, err := cc.getBySequence("/contracts", "contracts", key, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := ContractResponse{
+		Key:    key,
+		Height: height,
+	}
+	if err := out.Contract.Unmarshal(model.Value); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SignedWeight returns the sum of weights of the contract participants that
+// are present in given conditions, together with the addresses of those
+// participants. Conditions are the addresses of the transaction signers and
+// of the contracts that are already activated.
+func SignedWeight(c *multisig.Contract, conditions []weave.Address) (multisig.Weight, []weave.Address) {
+	var (
+		weight multisig.Weight
+		signed []weave.Address
+	)
+	for _, p := range c.Participants {
+		for _, cond := range conditions {
+			if p.Signature.Equals(cond) {
+				weight += p.Weight
+				signed = append(signed, p.Signature)
+				break
+			}
+		}
+	}
+	return weight, signed
+}
+
+// BuildCreateContractTx will create an unsigned tx to create a multisig
+// contract
+func BuildCreateContractTx(participants []*multisig.Participant, activation, admin multisig.Weight) *app.Tx {
+	return &app.Tx{
+		Sum: &app.Tx_MultisigCreateMsg{
+			MultisigCreateMsg: &multisig.CreateMsg{
+				Metadata:            &weave.Metadata{Schema: 1},
+				Participants:        participants,
+				ActivationThreshold: activation,
+				AdminThreshold:      admin,
+			},
+		},
+	}
+}
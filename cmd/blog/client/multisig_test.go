@@ -0,0 +1,84 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/multisig"
+)
+
+func TestContractQuery(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	// Use a dedicated creator to not interfere with faucet nonce tests.
+	poster := NewPoster(bc, GenPrivateKey(), coin.Coin{})
+
+	alice := GenPrivateKey().PublicKey().Address()
+	bob := GenPrivateKey().PublicKey().Address()
+	participants := []*multisig.Participant{
+		{Signature: alice, Weight: 2},
+		{Signature: bob, Weight: 1},
+	}
+	res, err := poster.Post(BuildCreateContractTx(participants, 2, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, 8, len(res.Key))
+
+	c, err := bc.GetContract(res.Key)
+	assert.Nil(t, err)
+	assert.Equal(t, multisig.Weight(2), c.Contract.ActivationThreshold)
+	assert.Equal(t, 2, len(c.Contract.Participants))
+	assert.Equal(t, true, c.Height > 0)
+
+	_, err = bc.GetContract([]byte{0, 0, 0, 0, 0, 1, 0, 0})
+	assert.IsErr(t, errors.ErrNotFound, err)
+	_, err = bc.GetContract([]byte{1})
+	assert.IsErr(t, ErrInvalid, err)
+}
+
+func TestSignedWeight(t *testing.T) {
+	alice := GenPrivateKey().PublicKey().Address()
+	bob := GenPrivateKey().PublicKey().Address()
+	carol := GenPrivateKey().PublicKey().Address()
+	contract := multisig.Contract{
+		Participants: []*multisig.Participant{
+			{Signature: alice, Weight: 2},
+			{Signature: bob, Weight: 1},
+		},
+		ActivationThreshold: 3,
+	}
+
+	cases := map[string]struct {
+		conditions []weave.Address
+		wantWeight multisig.Weight
+		wantSigned []weave.Address
+	}{
+		"no conditions": {
+			wantWeight: 0,
+		},
+		"not a participant": {
+			conditions: []weave.Address{carol},
+			wantWeight: 0,
+		},
+		"single participant": {
+			conditions: []weave.Address{carol, alice},
+			wantWeight: 2,
+			wantSigned: []weave.Address{alice},
+		},
+		"all participants": {
+			conditions: []weave.Address{bob, alice, alice},
+			wantWeight: 3,
+			wantSigned: []weave.Address{alice, bob},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			weight, signed := SignedWeight(&contract, tc.conditions)
+			assert.Equal(t, tc.wantWeight, weight)
+			assert.Equal(t, tc.wantSigned, signed)
+		})
+	}
+}
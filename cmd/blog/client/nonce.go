@@ -0,0 +1,70 @@
+package client
+
+import (
+	"sync"
+
+	"github.com/iov-one/weave"
+)
+
+// NonceManager hands out consecutive nonces per address, so that many
+// transactions signed by the same key can be broadcast before any of them is
+// committed. The chain is queried only for the first nonce of an address and
+// after a reset.
+//
+// The manager is safe for concurrent use, but transactions must reach the
+// mempool in the nonce order, so broadcasting for a single address should be
+// serialized, as the Pipeline does.
+type NonceManager struct {
+	client *BlogClient
+
+	mu   sync.Mutex
+	next map[string]int64
+}
+
+// NewNonceManager returns a nonce manager that loads nonces unknown locally
+// from the chain using given client.
+func NewNonceManager(client *BlogClient) *NonceManager {
+	return &NonceManager{
+		client: client,
+		next:   make(map[string]int64),
+	}
+}
+
+// Next returns the nonce that the next transaction signed by given address
+// must use and marks it as used.
+func (m *NonceManager) Next(addr weave.Address) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	n, ok := m.next[addr.String()]
+	if !ok {
+		var err error
+		if n, err = m.client.NextNonce(addr); err != nil {
+			return 0, err
+		}
+	}
+	m.next[addr.String()] = n + 1
+	return n, nil
+}
+
+// Release returns a nonce of a transaction that was rejected by CheckTx and
+// therefore did not consume it. Because the chain requires nonces without
+// gaps, the next transaction of this address reuses the released nonce.
+func (m *NonceManager) Release(addr weave.Address, nonce int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if n, ok := m.next[addr.String()]; ok && nonce < n {
+		m.next[addr.String()] = nonce
+	}
+}
+
+// Reset forgets the local nonce of given address, so that the next nonce is
+// loaded from the chain again. Use it when the local state is out of sync,
+// for example when the same key is used by another process.
+func (m *NonceManager) Reset(addr weave.Address) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.next, addr.String())
+}
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/sigs"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// pipelineCheckInterval is how often the pipeline polls the node for new
+// blocks, in case a header event was dropped, and checks pending
+// transactions for timeout.
+const pipelineCheckInterval = time.Second
+
+// Pipeline broadcasts many transactions without waiting for each of them to
+// be committed, so that a single key can submit many transactions per block.
+//
+// Transactions are signed using nonces handed out by a NonceManager and
+// broadcast with BroadcastTxSync, which returns after CheckTx. Commits are
+// tracked per block instead of per transaction: for every new block the
+// pipeline loads its transactions and their results, so the number of
+// transactions in flight is limited neither by the subscriptions allowed by
+// the node nor by events dropped when the client falls behind.
+type Pipeline struct {
+	client *BlogClient
+	nonces *NonceManager
+
+	// Timeout is the maximum time a broadcast transaction waits to be
+	// committed.
+	Timeout time.Duration
+
+	chainID    string
+	subscriber string
+	headers    <-chan ctypes.ResultEvent
+	stop       chan struct{}
+	done       chan struct{}
+
+	// height is the last block searched for pending transactions. It is
+	// used only by the run goroutine.
+	height int64
+
+	// broadcast serializes broadcasting, so that transactions of each
+	// signer reach the mempool in the nonce order.
+	broadcast sync.Mutex
+
+	mu      sync.Mutex
+	pending map[string]*pendingTx
+	// closed is set once the pipeline stops tracking commits. No more
+	// transactions are accepted.
+	closed bool
+}
+
+type pendingTx struct {
+	out      chan BroadcastTxResponse
+	deadline time.Time
+	signer   weave.Address
+}
+
+// NewPipeline subscribes to new block headers and returns a pipeline ready
+// to submit transactions. Close must be called to release the subscription.
+func NewPipeline(client *BlogClient, nonces *NonceManager) (*Pipeline, error) {
+	chainID, err := client.ChainID()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get chain ID")
+	}
+	height, err := client.Height()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get height")
+	}
+	subscriber := "pipeline-" + hex.EncodeToString(cmn.RandBytes(8))
+	headers, err := client.subscribe(context.Background(), subscriber, QueryNewBlockHeader.String())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to subscribe")
+	}
+	p := &Pipeline{
+		client:     client,
+		nonces:     nonces,
+		Timeout:    BroadcastTxSyncDefaultTimeOut,
+		chainID:    chainID,
+		subscriber: subscriber,
+		headers:    headers,
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+		height:     height,
+		pending:    make(map[string]*pendingTx),
+	}
+	go p.run()
+	return p, nil
+}
+
+// Submit signs given transaction with the next nonce of the signer and
+// broadcasts it. It returns once the transaction passed CheckTx. The result
+// of DeliverTx is sent to the returned channel when the transaction is
+// committed, or an error if it is not committed before the timeout.
+//
+// Fees, if any, must be set before calling Submit and paid by the signer.
+//
+// When CheckTx rejects the transaction the nonce is released, so that the
+// next transaction fills the gap. A nonce mismatch makes the pipeline load
+// the nonce from the chain again for the next transaction.
+//
+// Submit fails once the pipeline is closed or the node cancelled the header
+// subscription. Create a new pipeline to continue.
+func (p *Pipeline) Submit(tx *app.Tx, signer *crypto.PrivateKey) (<-chan BroadcastTxResponse, error) {
+	p.broadcast.Lock()
+	defer p.broadcast.Unlock()
+
+	addr := signer.PublicKey().Address()
+	nonce, err := p.nonces.Next(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get nonce")
+	}
+	if err := SignTx(tx, signer, p.chainID, nonce); err != nil {
+		p.nonces.Release(addr, nonce)
+		return nil, errors.Wrap(err, "cannot sign transaction")
+	}
+	data, err := tx.Marshal()
+	if err != nil {
+		p.nonces.Release(addr, nonce)
+		return nil, errors.Wrap(err, "cannot marshal transaction")
+	}
+
+	// Register before broadcasting, so that the commit cannot be missed.
+	hash := string(tmtypes.Tx(data).Hash())
+	out := make(chan BroadcastTxResponse, 1)
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		p.nonces.Release(addr, nonce)
+		return nil, errors.Wrap(errors.ErrState, "pipeline is closed")
+	}
+	p.pending[hash] = &pendingTx{out: out, deadline: time.Now().Add(p.Timeout), signer: addr}
+	p.mu.Unlock()
+
+	res, err := p.client.conn.BroadcastTxSync(data)
+	if err == nil && res.Code != 0 {
+		err = errors.Wrap(errors.ABCIError(res.Code, res.Log), "CheckTx error")
+	}
+	if err != nil {
+		p.mu.Lock()
+		delete(p.pending, hash)
+		p.mu.Unlock()
+		p.resync(addr, nonce, err)
+		return nil, err
+	}
+	return out, nil
+}
+
+// resync updates the local nonce of a signer after its transaction failed.
+func (p *Pipeline) resync(addr weave.Address, nonce int64, err error) {
+	if sigs.ErrInvalidSequence.Is(err) {
+		p.nonces.Reset(addr)
+		return
+	}
+	p.nonces.Release(addr, nonce)
+}
+
+// run searches new blocks for the pending transactions until the pipeline is
+// closed or the header subscription is cancelled by the node.
+func (p *Pipeline) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(pipelineCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			p.shutdown()
+			return
+		case now := <-ticker.C:
+			if height, err := p.client.Height(); err == nil {
+				p.searchBlocks(height)
+			}
+			p.expire(now)
+		case evt, ok := <-p.headers:
+			if !ok {
+				p.shutdown()
+				return
+			}
+			if h, ok := evt.Data.(tmtypes.EventDataNewBlockHeader); ok {
+				p.searchBlocks(h.Header.Height)
+			}
+		}
+	}
+}
+
+// shutdown fails all pending transactions and rejects new ones.
+func (p *Pipeline) shutdown() {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+	p.expire(time.Time{})
+}
+
+// searchBlocks delivers the results of pending transactions committed in
+// blocks up to given height. Blocks are searched only when there are pending
+// transactions. A block that cannot be loaded is searched again next time.
+func (p *Pipeline) searchBlocks(height int64) {
+	for p.height < height {
+		p.mu.Lock()
+		idle := len(p.pending) == 0
+		p.mu.Unlock()
+		// A transaction registered later cannot be included in any of
+		// the blocks committed so far.
+		if idle {
+			p.height = height
+			return
+		}
+
+		block, err := p.client.GetBlock(p.height + 1)
+		if err != nil {
+			return
+		}
+		for i, tx := range block.Txs {
+			p.deliver(block.Height, tx, block.DeliverTx[i])
+		}
+		p.height = block.Height
+	}
+}
+
+// deliver sends the result of a committed transaction to its channel, if the
+// transaction was submitted by this pipeline.
+func (p *Pipeline) deliver(height int64, tx tmtypes.Tx, result *abci.ResponseDeliverTx) {
+	hash := tx.Hash()
+	p.mu.Lock()
+	ptx, ok := p.pending[string(hash)]
+	delete(p.pending, string(hash))
+	p.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	if result.Code == sigs.ErrInvalidSequence.ABCICode() {
+		// Transactions accepted by CheckTx only fail on the nonce when
+		// the local state is out of sync.
+		var t app.Tx
+		if err := t.Unmarshal(tx); err == nil && len(t.Signatures) != 0 && t.Signatures[0].Pubkey != nil {
+			p.nonces.Reset(t.Signatures[0].Pubkey.Address())
+		}
+	}
+	ptx.out <- BroadcastTxResponse{
+		Response: &ctypes.ResultBroadcastTxCommit{
+			DeliverTx: *result,
+			Height:    height,
+			Hash:      hash,
+		},
+	}
+}
+
+// expire fails all pending transactions with a deadline before now. Zero
+// time fails all of them.
+//
+// An expired transaction may have been dropped from the mempool, leaving a
+// gap that stalls every later transaction of the signer. The nonce of the
+// signer is loaded from the chain again for the next transaction.
+func (p *Pipeline) expire(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for hash, ptx := range p.pending {
+		if !now.IsZero() && now.Before(ptx.deadline) {
+			continue
+		}
+		err := errors.Wrap(errors.ErrTimeout, "transaction not committed")
+		ptx.out <- BroadcastTxResponse{Error: err}
+		delete(p.pending, hash)
+		p.nonces.Reset(ptx.signer)
+	}
+}
+
+// Close cancels the header subscription and fails all pending transactions.
+func (p *Pipeline) Close() error {
+	err := p.client.conn.Unsubscribe(context.Background(), p.subscriber, QueryNewBlockHeader.String())
+	close(p.stop)
+	<-p.done
+	return err
+}
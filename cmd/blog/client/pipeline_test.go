@@ -0,0 +1,149 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client/clienttest"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/sigs"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+func TestNonceManager(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+	addr := GenPrivateKey().PublicKey().Address()
+	nonces := NewNonceManager(bc)
+
+	next := func(want int64) {
+		t.Helper()
+		n, err := nonces.Next(addr)
+		assert.Nil(t, err)
+		assert.Equal(t, want, n)
+	}
+
+	// an unused address starts at 0
+	next(0)
+	next(1)
+	next(2)
+	// a released nonce is reused to fill the gap
+	nonces.Release(addr, 1)
+	next(1)
+	next(2)
+	// releasing a nonce that was not handed out does nothing
+	nonces.Release(addr, 7)
+	next(3)
+	// reset loads the nonce from the chain again
+	nonces.Reset(addr)
+	next(0)
+}
+
+func TestPipeline(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	author := clienttest.NewAuthor()
+	owner := author.PublicKey().Address()
+	nonces := NewNonceManager(bc)
+	pipeline, err := NewPipeline(bc, nonces)
+	assert.Nil(t, err)
+	defer pipeline.Close()
+
+	const n = 100
+	results := make([]<-chan BroadcastTxResponse, 0, n)
+	for i := 0; i < n; i++ {
+		res, err := pipeline.Submit(BuildCreateBlogTx(fmt.Sprintf("blog %d", i), "description"), author)
+		assert.Nil(t, err)
+		results = append(results, res)
+
+		if i == n/2 {
+			// CheckTx failure must not leave a nonce gap
+			_, err := pipeline.Submit(BuildCreateBlogTx("", ""), author)
+			if err == nil {
+				t.Fatal("invalid blog accepted")
+			}
+		}
+	}
+
+	keys := make(map[string]struct{})
+	for _, res := range results {
+		r := <-res
+		assert.Nil(t, r.IsError())
+		keys[string(r.Response.DeliverTx.Data)] = struct{}{}
+	}
+	assert.Equal(t, n, len(keys))
+
+	blogs, err := bc.ListBlogsByOwner(owner)
+	assert.Nil(t, err)
+	assert.Equal(t, n, len(blogs.Blogs))
+
+	// A transaction signed outside of the pipeline makes the local nonce
+	// out of sync. The pipeline must resync after the failure.
+	_, err = NewPoster(bc, author, coin.Coin{}).Post(BuildCreateBlogTx("outside", "description"))
+	assert.Nil(t, err)
+	_, err = pipeline.Submit(BuildCreateBlogTx("stale", "description"), author)
+	assert.IsErr(t, sigs.ErrInvalidSequence, err)
+	res, err := pipeline.Submit(BuildCreateBlogTx("resynced", "description"), author)
+	assert.Nil(t, err)
+	assert.Nil(t, (<-res).IsError())
+}
+
+func TestPipelineHeadersClosed(t *testing.T) {
+	bc := NewClient(NewLocalConnection(node))
+	headers := make(chan ctypes.ResultEvent)
+	p := &Pipeline{
+		client:  bc,
+		nonces:  NewNonceManager(bc),
+		Timeout: time.Minute,
+		chainID: clienttest.ChainID(),
+		headers: headers,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+		pending: make(map[string]*pendingTx),
+	}
+	out := make(chan BroadcastTxResponse, 1)
+	p.pending["tx"] = &pendingTx{out: out, deadline: time.Now().Add(time.Minute)}
+	go p.run()
+
+	// The node cancelled the subscription.
+	close(headers)
+	select {
+	case <-p.done:
+	case <-time.After(time.Second):
+		t.Fatal("pipeline still running")
+	}
+	assert.IsErr(t, errors.ErrTimeout, (<-out).IsError())
+
+	_, err := p.Submit(BuildCreateBlogTx("late", "description"), GenPrivateKey())
+	assert.IsErr(t, errors.ErrState, err)
+}
+
+func TestPipelineTimeout(t *testing.T) {
+	bc := NewClient(NewLocalConnection(node))
+	author := GenPrivateKey()
+	addr := author.PublicKey().Address()
+	nonces := NewNonceManager(bc)
+	pipeline, err := NewPipeline(bc, nonces)
+	assert.Nil(t, err)
+	defer pipeline.Close()
+
+	// A transaction that took the first nonce was dropped from the
+	// mempool and is never committed.
+	_, err = nonces.Next(addr)
+	assert.Nil(t, err)
+	dropped := make(chan BroadcastTxResponse, 1)
+	pipeline.mu.Lock()
+	pipeline.pending["dropped"] = &pendingTx{out: dropped, deadline: time.Now(), signer: addr}
+	pipeline.mu.Unlock()
+	pipeline.expire(time.Now())
+	assert.IsErr(t, errors.ErrTimeout, (<-dropped).IsError())
+
+	// The next transaction must not leave a gap after the dropped one.
+	res, err := pipeline.Submit(BuildCreateBlogTx("after timeout", "description"), author)
+	assert.Nil(t, err)
+	assert.Nil(t, (<-res).IsError())
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/cash"
+)
+
+// Poster signs transactions with a single private key and broadcasts them,
+// so that a transaction built with one of the Build*Tx functions can be
+// submitted with a single call.
+type Poster struct {
+	client *BlogClient
+	signer *crypto.PrivateKey
+	fee    coin.Coin
+
+	// Timeout is the maximum time Post waits for a transaction to be
+	// committed.
+	Timeout time.Duration
+
+	mu      sync.Mutex
+	chainID string
+}
+
+// NewPoster returns a poster that signs transactions with given key. Non
+// zero fee is attached to every transaction and paid by the signer.
+func NewPoster(client *BlogClient, signer *crypto.PrivateKey, fee coin.Coin) *Poster {
+	return &Poster{
+		client:  client,
+		signer:  signer,
+		fee:     fee,
+		Timeout: BroadcastTxSyncDefaultTimeOut,
+	}
+}
+
+// PostResponse is the result of a committed transaction.
+type PostResponse struct {
+	// Key is the primary key of the entity created or modified by the
+	// transaction, if the handler returns one.
+	Key    []byte
+	Height int64
+}
+
+// Post attaches the fee to given transaction, signs it using the next nonce
+// of the signer and broadcasts it. It returns when the transaction is
+// committed.
+//
+// Post is not meant to be called concurrently for the same signer, because
+// each call uses the nonce that is currently stored on the chain.
+func (p *Poster) Post(tx *app.Tx) (*PostResponse, error) {
+	chainID, err := p.loadChainID()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get chain ID")
+	}
+	addr := p.signer.PublicKey().Address()
+	nonce, err := p.client.NextNonce(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get nonce")
+	}
+	if !p.fee.IsZero() {
+		fee := p.fee
+		tx.Fees = &cash.FeeInfo{
+			Payer: addr,
+			Fees:  &fee,
+		}
+	}
+	if err := SignTx(tx, p.signer, chainID, nonce); err != nil {
+		return nil, errors.Wrap(err, "cannot sign transaction")
+	}
+
+	res := p.client.BroadcastTxSync(tx, p.Timeout)
+	if err := res.IsError(); err != nil {
+		return nil, err
+	}
+	return &PostResponse{
+		Key:    res.Response.DeliverTx.Data,
+		Height: res.Response.Height,
+	}, nil
+}
+
+// loadChainID returns the chain ID, fetching it only once.
+func (p *Poster) loadChainID() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.chainID != "" {
+		return p.chainID, nil
+	}
+	chainID, err := p.client.ChainID()
+	if err != nil {
+		return "", err
+	}
+	p.chainID = chainID
+	return chainID, nil
+}
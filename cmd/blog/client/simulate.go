@@ -0,0 +1,64 @@
+package client
+
+import (
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// SimulateResponse is the result of checking a transaction without
+// committing it.
+type SimulateResponse struct {
+	blog.SimulateResult
+	// Height is the height of the state the transaction was checked
+	// against.
+	Height int64
+}
+
+// Err returns the error the transaction check failed with, or nil if the
+// transaction passed it.
+func (r SimulateResponse) Err() error {
+	if r.Code == 0 {
+		return nil
+	}
+	return errors.ABCIError(r.Code, r.Log)
+}
+
+// Simulate runs the transaction through the application check, as if it was
+// included in the next block, without committing it. It reports the gas
+// allocated by the check and the fees the transaction must pay.
+//
+// A failed check is not returned as an error, use the Err method of the
+// response. The transaction is checked against the committed state only, so
+// it must not depend on transactions that wait in the mempool.
+func (cc *BlogClient) Simulate(tx weave.Tx) (*SimulateResponse, error) {
+	raw, err := tx.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal transaction")
+	}
+	status, err := cc.conn.Status()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get status")
+	}
+	q := blog.SimulateQuery{
+		Tx:     raw,
+		Height: status.SyncInfo.LatestBlockHeight + 1,
+		Time:   weave.AsUnixTime(status.SyncInfo.LatestBlockTime),
+	}
+	data, err := q.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal query")
+	}
+	resp, err := cc.AbciQuery(blog.SimulatePath, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Models) != 1 {
+		return nil, errors.Wrapf(errors.ErrState, "want one result, got %d", len(resp.Models))
+	}
+	out := SimulateResponse{Height: resp.Height}
+	if err := out.SimulateResult.Unmarshal(resp.Models[0].Value); err != nil {
+		return nil, errors.Wrap(err, "cannot decode result")
+	}
+	return &out, nil
+}
@@ -0,0 +1,208 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/iov-one/weave/errors"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// SubscriptionManager follows the chain block by block and delivers what
+// happened in each block on channels. It is resilient to dropped events and
+// lost connections: events only trigger loading new blocks, which are also
+// polled periodically, and every failure is retried with an exponential
+// backoff from the last height that was fully delivered.
+type SubscriptionManager struct {
+	client *BlogClient
+
+	// MinBackoff is the delay before the first retry after a failure.
+	MinBackoff time.Duration
+	// MaxBackoff is the maximum delay between retries.
+	MaxBackoff time.Duration
+	// PollInterval is how often the node is asked for new blocks, in case
+	// a header event was dropped.
+	PollInterval time.Duration
+	// OnError, if set, is called with every failure before retrying.
+	OnError func(error)
+}
+
+// NewSubscriptionManager returns a subscription manager with default backoff
+// and poll settings.
+func NewSubscriptionManager(client *BlogClient) *SubscriptionManager {
+	return &SubscriptionManager{
+		client:       client,
+		MinBackoff:   100 * time.Millisecond,
+		MaxBackoff:   30 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+}
+
+// blockHandler processes the block of given height. It must be safe to call
+// again for the same height after it failed.
+type blockHandler func(ctx context.Context, height int64) error
+
+// SubscribeHeaders delivers headers of all blocks after given height, or
+// after the current height if zero, until the context is cancelled. The
+// channel is closed when the subscription ends.
+func (m *SubscriptionManager) SubscribeHeaders(ctx context.Context, after int64) (<-chan *tmtypes.Header, error) {
+	after, err := m.startHeight(after)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *tmtypes.Header)
+	handle := func(ctx context.Context, height int64) error {
+		block, err := m.client.conn.Block(&height)
+		if err != nil {
+			return errors.Wrapf(err, "cannot load block %d", height)
+		}
+		select {
+		case out <- &block.Block.Header:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	go func() {
+		defer close(out)
+		m.follow(ctx, after, handle)
+	}()
+	return out, nil
+}
+
+// SubscribeBlogEvents delivers blog events of all blocks after given height,
+// or after the current height if zero, until the context is cancelled. Events
+// are delivered in the order of blocks, so the height of the last event can
+// be used to resume after a restart. The channel is closed when the
+// subscription ends.
+func (m *SubscriptionManager) SubscribeBlogEvents(ctx context.Context, after int64) (<-chan BlogEvent, error) {
+	after, err := m.startHeight(after)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan BlogEvent)
+	handle := func(ctx context.Context, height int64) error {
+		block, err := m.client.GetBlock(height)
+		if err != nil {
+			return err
+		}
+		for _, e := range block.Events() {
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}
+	go func() {
+		defer close(out)
+		m.follow(ctx, after, handle)
+	}()
+	return out, nil
+}
+
+// SubscribeBlocks delivers all blocks after given height, or after the
+// current height if zero, together with their execution results until the
+// context is cancelled. The channel is closed when the subscription ends.
+func (m *SubscriptionManager) SubscribeBlocks(ctx context.Context, after int64) (<-chan *Block, error) {
+	after, err := m.startHeight(after)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *Block)
+	handle := func(ctx context.Context, height int64) error {
+		block, err := m.client.GetBlock(height)
+		if err != nil {
+			return err
+		}
+		select {
+		case out <- block:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	go func() {
+		defer close(out)
+		m.follow(ctx, after, handle)
+	}()
+	return out, nil
+}
+
+// startHeight returns given height or the current one if zero.
+func (m *SubscriptionManager) startHeight(after int64) (int64, error) {
+	if after > 0 {
+		return after, nil
+	}
+	height, err := m.client.Height()
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot get height")
+	}
+	return height, nil
+}
+
+// follow calls handle for every block after given height until the context
+// is cancelled, reconnecting with backoff after failures.
+func (m *SubscriptionManager) follow(ctx context.Context, last int64, handle blockHandler) {
+	backoff := m.MinBackoff
+	for {
+		progress := func() { backoff = m.MinBackoff }
+		err := m.followOnce(ctx, &last, handle, progress)
+		if ctx.Err() != nil {
+			return
+		}
+		if m.OnError != nil {
+			m.OnError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > m.MaxBackoff {
+			backoff = m.MaxBackoff
+		}
+	}
+}
+
+// followOnce subscribes to new block headers and handles new blocks until
+// the first failure. Last is updated with every handled block.
+func (m *SubscriptionManager) followOnce(ctx context.Context, last *int64, handle blockHandler, progress func()) error {
+	subscriber := "subscription-" + hex.EncodeToString(cmn.RandBytes(8))
+	query := QueryNewBlockHeader.String()
+	headers, err := m.client.subscribe(ctx, subscriber, query)
+	if err != nil {
+		return errors.Wrap(err, "failed to subscribe")
+	}
+	defer m.client.conn.Unsubscribe(context.Background(), subscriber, query)
+
+	ticker := time.NewTicker(m.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		height, err := m.client.Height()
+		if err != nil {
+			return errors.Wrap(err, "cannot get height")
+		}
+		for *last < height {
+			if err := handle(ctx, *last+1); err != nil {
+				return err
+			}
+			*last++
+			progress()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case _, ok := <-headers:
+			if !ok {
+				return errors.Wrap(errors.ErrState, "subscription closed")
+			}
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client/clienttest"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/batch"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDeletedArticles(t *testing.T) {
+	tag := func(key string, value string) cmn.KVPair {
+		return cmn.KVPair{
+			Key:   []byte(strings.ToUpper(hex.EncodeToString([]byte(key)))),
+			Value: []byte(value),
+		}
+	}
+	articleKey := "\x00\x00\x00\x00\x00\x00\x00\x07"
+
+	cases := map[string]struct {
+		tags []cmn.KVPair
+		want [][]byte
+	}{
+		"no tags": {},
+		"article deleted": {
+			tags: []cmn.KVPair{tag("article:"+articleKey, "d")},
+			want: [][]byte{[]byte(articleKey)},
+		},
+		"article set": {
+			tags: []cmn.KVPair{tag("article:"+articleKey, "s")},
+		},
+		"article index deleted": {
+			tags: []cmn.KVPair{tag("_i.article_blog:"+articleKey, "d")},
+		},
+		"other bucket deleted": {
+			tags: []cmn.KVPair{tag("blog:"+articleKey, "d")},
+		},
+		"not hex": {
+			tags: []cmn.KVPair{{Key: []byte("article"), Value: []byte("d")}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, DeletedArticles(tc.tags))
+		})
+	}
+}
+
+func TestBlockEvents(t *testing.T) {
+	tag := func(bucket string, key []byte, value string) cmn.KVPair {
+		raw := append([]byte(bucket+":"), key...)
+		return cmn.KVPair{
+			Key:   []byte(strings.ToUpper(hex.EncodeToString(raw))),
+			Value: []byte(value),
+		}
+	}
+	key := func(n byte) []byte { return []byte{0, 0, 0, 0, 0, 0, 0, n} }
+	blogKey := key(9)
+
+	create := func(title string) app.ExecuteBatchMsg_Union {
+		return app.ExecuteBatchMsg_Union{Sum: &app.ExecuteBatchMsg_Union_BlogCreateArticleMsg{
+			BlogCreateArticleMsg: BuildCreateArticleTx(blogKey, title, "content", 0).GetBlogCreateArticleMsg(),
+		}}
+	}
+	batchTx, err := (&app.Tx{Sum: &app.Tx_ExecuteBatchMsg{ExecuteBatchMsg: &app.ExecuteBatchMsg{
+		Messages: []app.ExecuteBatchMsg_Union{create("first"), create("second")},
+	}}}).Marshal()
+	assert.Nil(t, err)
+	data, err := (&batch.ByteArrayList{Elements: [][]byte{key(1), key(2)}}).Marshal()
+	assert.Nil(t, err)
+
+	begin := &abci.ResponseBeginBlock{Tags: []cmn.KVPair{
+		tag("article", key(3), "s"),
+		tag("article", key(4), "d"),
+	}}
+	results := []*abci.ResponseDeliverTx{{
+		Data: data,
+		Tags: []cmn.KVPair{
+			tag("article", key(1), "s"),
+			tag("article", key(2), "s"),
+			tag("blog", blogKey, "s"),
+			tag("_i.article_blog", key(1), "s"),
+		},
+	}}
+
+	want := []BlogEvent{
+		ArticleDeleted{Key: key(4), Cron: true},
+		EntityUpdated{Bucket: "article", Key: key(3)},
+		ArticleCreated{Key: key(1), BlogKey: blogKey, Title: "first"},
+		ArticleCreated{Key: key(2), BlogKey: blogKey, Title: "second"},
+		EntityUpdated{Bucket: "blog", Key: blogKey},
+	}
+	got := blockEvents(7, tmtypes.Txs{batchTx}, begin, results)
+	assert.Equal(t, len(want), len(got))
+	for i := range want {
+		if !sameEvent(want[i], got[i]) {
+			t.Errorf("event %d: want %#v, got %#v", i, want[i], got[i])
+		}
+		assert.Equal(t, int64(7), got[i].EventHeight())
+	}
+}
+
+func TestSubscribeBlogEvents(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sm := NewSubscriptionManager(bc)
+	events, err := sm.SubscribeBlogEvents(ctx, 0)
+	assert.Nil(t, err)
+
+	author := clienttest.NewAuthor()
+	poster := NewPoster(bc, author, coin.Coin{})
+	userRes, err := poster.Post(BuildCreateUserTx("subscriber", "Events fan"))
+	assert.Nil(t, err)
+	blogRes, err := poster.Post(BuildCreateBlogTx("Events blog", "description"))
+	assert.Nil(t, err)
+	batchRes, err := poster.Post(&app.Tx{Sum: &app.Tx_ExecuteBatchMsg{ExecuteBatchMsg: &app.ExecuteBatchMsg{
+		Messages: []app.ExecuteBatchMsg_Union{
+			{Sum: &app.ExecuteBatchMsg_Union_BlogCreateArticleMsg{
+				BlogCreateArticleMsg: BuildCreateArticleTx(blogRes.Key, "batched one", "content", 0).GetBlogCreateArticleMsg(),
+			}},
+			{Sum: &app.ExecuteBatchMsg_Union_BlogCreateArticleMsg{
+				BlogCreateArticleMsg: BuildCreateArticleTx(blogRes.Key, "batched two", "content", 0).GetBlogCreateArticleMsg(),
+			}},
+		},
+	}}})
+	assert.Nil(t, err)
+	var batchKeys batch.ByteArrayList
+	assert.Nil(t, batchKeys.Unmarshal(batchRes.Key))
+	assert.Equal(t, 2, len(batchKeys.Elements))
+	deleted, err := poster.Post(BuildCreateArticleTx(blogRes.Key, "deleted", "content", 0))
+	assert.Nil(t, err)
+	_, err = poster.Post(BuildDeleteArticleTx(deleted.Key))
+	assert.Nil(t, err)
+	// Block time of the test chain is not the wall clock time.
+	status, err := bc.Status()
+	assert.Nil(t, err)
+	deleteAt := weave.AsUnixTime(status.SyncInfo.LatestBlockTime.Add(5 * time.Second))
+	expiring, err := poster.Post(BuildCreateArticleTx(blogRes.Key, "expiring", "content", deleteAt))
+	assert.Nil(t, err)
+
+	want := []BlogEvent{
+		UserCreated{Key: userRes.Key, Username: "subscriber", Bio: "Events fan"},
+		BlogCreated{Key: blogRes.Key, Title: "Events blog", Description: "description"},
+		ArticleCreated{Key: batchKeys.Elements[0], BlogKey: blogRes.Key, Title: "batched one"},
+		ArticleCreated{Key: batchKeys.Elements[1], BlogKey: blogRes.Key, Title: "batched two"},
+		ArticleCreated{Key: deleted.Key, BlogKey: blogRes.Key, Title: "deleted"},
+		ArticleDeleted{Key: deleted.Key},
+		ArticleCreated{Key: expiring.Key, BlogKey: blogRes.Key, Title: "expiring"},
+		ArticleDeleted{Key: expiring.Key, Cron: true},
+	}
+	var lastHeight int64
+	timeout := time.After(10 * time.Second)
+	for i := 0; i < len(want); {
+		select {
+		case e := <-events:
+			// other tests run concurrently on the same chain
+			if !sameEvent(want[i], e) {
+				continue
+			}
+			if e.EventHeight() < lastHeight {
+				t.Fatalf("event %d out of order", i)
+			}
+			lastHeight = e.EventHeight()
+			i++
+		case <-timeout:
+			t.Fatalf("event %d not received: %#v", i, want[i])
+		}
+	}
+
+	cancel()
+	for range events {
+		// drain until closed
+	}
+}
+
+// sameEvent compares events ignoring their metadata.
+func sameEvent(want, got BlogEvent) bool {
+	switch w := want.(type) {
+	case UserCreated:
+		g, ok := got.(UserCreated)
+		w.EventMeta = g.EventMeta
+		return ok && reflect.DeepEqual(w, g)
+	case BlogCreated:
+		g, ok := got.(BlogCreated)
+		w.EventMeta = g.EventMeta
+		return ok && reflect.DeepEqual(w, g)
+	case ArticleCreated:
+		g, ok := got.(ArticleCreated)
+		w.EventMeta = g.EventMeta
+		return ok && reflect.DeepEqual(w, g)
+	case ArticleDeleted:
+		g, ok := got.(ArticleDeleted)
+		w.EventMeta = g.EventMeta
+		// only transaction deletes have a hash
+		return ok && reflect.DeepEqual(w, g) && (g.TxHash == nil) == g.Cron
+	case EntityUpdated:
+		g, ok := got.(EntityUpdated)
+		w.EventMeta = g.EventMeta
+		return ok && reflect.DeepEqual(w, g)
+	}
+	return false
+}
+
+func TestSubscriptionManagerHeaders(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sm := NewSubscriptionManager(bc)
+	// start from the first block to check that past blocks are delivered
+	headers, err := sm.SubscribeHeaders(ctx, 1)
+	assert.Nil(t, err)
+
+	for want := int64(2); want < 5; want++ {
+		h := <-headers
+		assert.Equal(t, want, h.Height)
+		assert.Equal(t, clienttest.ChainID(), h.ChainID)
+	}
+	cancel()
+	for range headers {
+		// drain until closed
+	}
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sm := NewSubscriptionManager(bc)
+	blocks, err := sm.SubscribeBlocks(ctx, 1)
+	assert.Nil(t, err)
+
+	for want := int64(2); want < 5; want++ {
+		b := <-blocks
+		assert.Equal(t, want, b.Height)
+		assert.Equal(t, false, b.Time.IsZero())
+		assert.Equal(t, len(b.Txs), len(b.DeliverTx))
+	}
+	cancel()
+	for range blocks {
+		// drain until closed
+	}
+}
+
+func TestSubscriptionManagerReconnect(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bc := NewClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var failures int32
+	sm := NewSubscriptionManager(bc)
+	sm.MinBackoff = time.Millisecond
+	sm.OnError = func(error) { atomic.AddInt32(&failures, 1) }
+	after, err := bc.Height()
+	assert.Nil(t, err)
+
+	// fail the first attempts of every block to check that delivery
+	// resumes from the last height without gaps
+	attempts := make(map[int64]int)
+	heights := make(chan int64)
+	go sm.follow(ctx, after, func(ctx context.Context, height int64) error {
+		if attempts[height]++; attempts[height] < 3 {
+			return errors.Wrap(errors.ErrNetwork, "connection lost")
+		}
+		select {
+		case heights <- height:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+
+	for want := after + 1; want < after+4; want++ {
+		assert.Equal(t, want, <-heights)
+	}
+	cancel()
+	assert.Equal(t, true, atomic.LoadInt32(&failures) >= 6)
+}
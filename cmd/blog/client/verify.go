@@ -0,0 +1,190 @@
+package client
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	tmiavl "github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/lite"
+	lclient "github.com/tendermint/tendermint/lite/client"
+	"github.com/tendermint/tendermint/lite/proxy"
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// verifierCacheSize is the number of trusted headers kept in memory.
+const verifierCacheSize = 10
+
+// NewVerifier returns a light client verifier of the chain signed headers.
+// Trusted headers are stored in rootDir and every later header is verified
+// against the validator sets of the trusted ones.
+//
+// If no header is trusted yet, the header at trustedHeight is loaded from the
+// node and trusted only if its hash is trustedHash. The hash must come from a
+// source other than the node, i.e. a block explorer or the chain operators,
+// otherwise the node can make the client trust a forged chain.
+func NewVerifier(cc *BlogClient, chainID, rootDir string, trustedHeight int64, trustedHash []byte) (*lite.DynamicVerifier, error) {
+	db := dbm.NewDB("trust-base", dbm.GoLevelDBBackend, rootDir)
+	memProvider := lite.NewDBProvider("trusted.mem", dbm.NewMemDB()).SetLimit(verifierCacheSize)
+	lvlProvider := lite.NewDBProvider("trusted.lvl", db)
+	trust := lite.NewMultiProvider(memProvider, lvlProvider)
+	source := lclient.NewProvider(chainID, cc.conn)
+
+	if _, err := trust.LatestFullCommit(chainID, 1, 1<<63-1); err != nil {
+		if err := trustHeader(trust, source, chainID, trustedHeight, trustedHash); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	return lite.NewDynamicVerifier(chainID, trust, source), nil
+}
+
+// trustHeader loads the header at given height from the source and saves it
+// as trusted if its hash is the expected one.
+func trustHeader(trust lite.PersistentProvider, source lite.Provider, chainID string, height int64, hash []byte) error {
+	if height <= 0 || len(hash) == 0 {
+		return errors.Wrap(ErrInvalid, "trusted height and hash are required")
+	}
+	fc, err := source.LatestFullCommit(chainID, height, height)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidProof, "cannot load trusted header: %s", err)
+	}
+	if fc.Height() != height || !bytes.Equal(fc.SignedHeader.Hash(), hash) {
+		return errors.Wrapf(ErrInvalidProof, "header %d is %X, want %X", fc.Height(), fc.SignedHeader.Hash(), hash)
+	}
+	if err := trust.SaveFullCommit(fc); err != nil {
+		return errors.Wrap(err, "cannot save trusted header")
+	}
+	return nil
+}
+
+// WithVerifier sets the verifier used to verify signed headers in verified
+// queries. It returns the same client for chaining.
+func (cc *BlogClient) WithVerifier(v lite.Verifier) *BlogClient {
+	cc.verifier = v
+	return cc
+}
+
+// QueryOption configures a single query.
+type QueryOption func(*queryOptions)
+
+type queryOptions struct {
+	verified bool
+}
+
+// Verified makes the query verify the merkle proofs of the result, see
+// AbciQueryVerified.
+func Verified() QueryOption {
+	return func(o *queryOptions) {
+		o.verified = true
+	}
+}
+
+// parseQueryOptions returns the configuration set by given options.
+func parseQueryOptions(opts []QueryOption) queryOptions {
+	var o queryOptions
+	for _, fn := range opts {
+		fn(&o)
+	}
+	return o
+}
+
+// query executes an abci query configured with given options.
+func (cc *BlogClient) query(path string, data []byte, opts []QueryOption) (AbciResponse, error) {
+	if parseQueryOptions(opts).verified {
+		return cc.AbciQueryVerified(path, data)
+	}
+	return cc.AbciQuery(path, data)
+}
+
+// AbciQueryVerified calls abci query on tendermint rpc requesting merkle
+// proofs of the result. Each returned model is verified to be stored in the
+// state with the app hash of a header signed by the validators, as checked
+// by the client verifier.
+//
+// Proofs show that every returned model is part of the state. They cannot
+// show that the result is complete. Only the absence of a single key can be
+// proven: an empty result of a raw key lookup, the "/" path, is verified
+// with an absence proof. For any other query an empty result fails with
+// ErrIncomplete.
+func (cc *BlogClient) AbciQueryVerified(path string, data []byte) (AbciResponse, error) {
+	var out AbciResponse
+	if cc.verifier == nil {
+		return out, errors.Wrap(ErrInvalid, "verifier not set")
+	}
+
+	q, err := cc.conn.ABCIQueryWithOptions(path, data, client.ABCIQueryOptions{Prove: true})
+	if err != nil {
+		return out, err
+	}
+	out, err = parseQueryResponse(q.Response)
+	if err != nil {
+		return out, err
+	}
+	if len(out.Models) == 0 && path != "/" {
+		return out, errors.Wrapf(ErrIncomplete, "empty result of %s", path)
+	}
+
+	// The app hash of a state is part of the next block header.
+	header, err := proxy.GetCertifiedCommit(out.Height+1, cc.conn, cc.verifier)
+	if err != nil {
+		return out, errors.Wrap(ErrInvalidProof, err.Error())
+	}
+	if len(out.Models) == 0 {
+		return out, verifyAbsence(data, q.Response.Proof, header.AppHash)
+	}
+	if err := verifyModels(out.Models, q.Response.Proof, header.AppHash); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+// verifyAbsence checks that the IAVL absence operation proves that given key
+// is not stored in the state with given app hash.
+func verifyAbsence(key []byte, proof *merkle.Proof, appHash []byte) error {
+	if proof == nil || len(proof.Ops) != 1 {
+		return errors.Wrap(ErrInvalidProof, "missing absence proof")
+	}
+	op, err := tmiavl.IAVLAbsenceOpDecoder(proof.Ops[0])
+	if err != nil {
+		return errors.Wrap(ErrInvalidProof, err.Error())
+	}
+	if !bytes.Equal(op.GetKey(), key) {
+		return errors.Wrapf(ErrInvalidProof, "absence proof of %X for %X", op.GetKey(), key)
+	}
+	root, err := op.Run(nil)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidProof, "key %X: %s", key, err)
+	}
+	if !bytes.Equal(root[0], appHash) {
+		return errors.Wrapf(ErrInvalidProof, "absence of %X does not match app hash", key)
+	}
+	return nil
+}
+
+// verifyModels checks that every model is proven by the corresponding IAVL
+// value operation to be stored in the state with given app hash.
+func verifyModels(models []weave.Model, proof *merkle.Proof, appHash []byte) error {
+	if proof == nil || len(proof.Ops) != len(models) {
+		return errors.Wrap(ErrInvalidProof, "missing proof")
+	}
+	for i, m := range models {
+		op, err := tmiavl.IAVLValueOpDecoder(proof.Ops[i])
+		if err != nil {
+			return errors.Wrap(ErrInvalidProof, err.Error())
+		}
+		if !bytes.Equal(op.GetKey(), m.Key) {
+			return errors.Wrapf(ErrInvalidProof, "proof of %X for %X model", op.GetKey(), m.Key)
+		}
+		root, err := op.Run([][]byte{m.Value})
+		if err != nil {
+			return errors.Wrapf(ErrInvalidProof, "model %X: %s", m.Key, err)
+		}
+		if !bytes.Equal(root[0], appHash) {
+			return errors.Wrapf(ErrInvalidProof, "model %X does not match app hash", m.Key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/weavetest/assert"
+	tmiavl "github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestVerifyModels(t *testing.T) {
+	tree := tmiavl.NewMutableTree(dbm.NewMemDB(), 100)
+	tree.Set([]byte("blog:1"), []byte("first"))
+	tree.Set([]byte("blog:2"), []byte("second"))
+	tree.Set([]byte("blog:3"), []byte("third"))
+	appHash, version, err := tree.SaveVersion()
+	assert.Nil(t, err)
+
+	prove := func(keys ...string) *merkle.Proof {
+		var proof merkle.Proof
+		for _, k := range keys {
+			_, rp, err := tree.GetVersionedWithProof([]byte(k), version)
+			assert.Nil(t, err)
+			proof.Ops = append(proof.Ops, tmiavl.NewIAVLValueOp([]byte(k), rp).ProofOp())
+		}
+		return &proof
+	}
+
+	models := []weave.Model{
+		weave.Pair([]byte("blog:1"), []byte("first")),
+		weave.Pair([]byte("blog:3"), []byte("third")),
+	}
+
+	cases := map[string]struct {
+		models  []weave.Model
+		proof   *merkle.Proof
+		appHash []byte
+		wantErr bool
+	}{
+		"valid proof": {
+			models:  models,
+			proof:   prove("blog:1", "blog:3"),
+			appHash: appHash,
+		},
+		"missing proof": {
+			models:  models,
+			proof:   nil,
+			appHash: appHash,
+			wantErr: true,
+		},
+		"proof of another key": {
+			models:  models,
+			proof:   prove("blog:1", "blog:2"),
+			appHash: appHash,
+			wantErr: true,
+		},
+		"tampered value": {
+			models: []weave.Model{
+				weave.Pair([]byte("blog:1"), []byte("first")),
+				weave.Pair([]byte("blog:3"), []byte("forged")),
+			},
+			proof:   prove("blog:1", "blog:3"),
+			appHash: appHash,
+			wantErr: true,
+		},
+		"another app hash": {
+			models:  models,
+			proof:   prove("blog:1", "blog:3"),
+			appHash: []byte("not the app hash"),
+			wantErr: true,
+		},
+		"absent model": {
+			models:  []weave.Model{weave.Pair([]byte("blog:4"), []byte("fourth"))},
+			proof:   prove("blog:4"),
+			appHash: appHash,
+			wantErr: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := verifyModels(tc.models, tc.proof, tc.appHash)
+			if tc.wantErr {
+				assert.IsErr(t, ErrInvalidProof, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyAbsence(t *testing.T) {
+	tree := tmiavl.NewMutableTree(dbm.NewMemDB(), 100)
+	tree.Set([]byte("blog:1"), []byte("first"))
+	tree.Set([]byte("blog:3"), []byte("third"))
+	appHash, version, err := tree.SaveVersion()
+	assert.Nil(t, err)
+
+	prove := func(key string) *merkle.Proof {
+		_, rp, err := tree.GetVersionedWithProof([]byte(key), version)
+		assert.Nil(t, err)
+		return &merkle.Proof{
+			Ops: []merkle.ProofOp{tmiavl.NewIAVLAbsenceOp([]byte(key), rp).ProofOp()},
+		}
+	}
+
+	cases := map[string]struct {
+		key     string
+		proof   *merkle.Proof
+		appHash []byte
+		wantErr bool
+	}{
+		"valid proof": {
+			key:     "blog:2",
+			proof:   prove("blog:2"),
+			appHash: appHash,
+		},
+		"missing proof": {
+			key:     "blog:2",
+			appHash: appHash,
+			wantErr: true,
+		},
+		"proof of another key": {
+			key:     "blog:2",
+			proof:   prove("blog:4"),
+			appHash: appHash,
+			wantErr: true,
+		},
+		"stored key": {
+			key:     "blog:3",
+			proof:   prove("blog:3"),
+			appHash: appHash,
+			wantErr: true,
+		},
+		"another app hash": {
+			key:     "blog:2",
+			proof:   prove("blog:2"),
+			appHash: []byte("not the app hash"),
+			wantErr: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := verifyAbsence([]byte(tc.key), tc.proof, tc.appHash)
+			if tc.wantErr {
+				assert.IsErr(t, ErrInvalidProof, err)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
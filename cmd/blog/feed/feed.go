@@ -0,0 +1,251 @@
+/*
+Package feed generates RSS 2.0 and Atom documents of a blog, so that readers
+can follow blogs in standard feed readers.
+*/
+package feed
+
+import (
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+)
+
+// Feed is the content of a blog feed.
+//
+// Hidden articles and articles that reached their deletion time are not
+// published, even if the scheduled task did not delete them yet. Articles
+// scheduled for deletion are published until then, and the RSS time to live
+// makes readers refresh the feed before the first of them is deleted.
+// Articles already deleted cannot be listed, but are reported as Atom
+// tombstones, see RFC 6721.
+type Feed struct {
+	// ChainID is used to build unique identifiers of the feed entries.
+	ChainID string
+	Blog    *blog.Blog
+	// Articles of the blog, the most recent first.
+	Articles []*blog.Article
+	// Deleted are the recently deleted articles of the blog.
+	Deleted []Tombstone
+	// Link is the optional URL of the blog website. Article links are
+	// built by appending /articles/{id} to it.
+	Link string
+	// Updated is the time the feed content was read. It is compared with
+	// the deletion time of the articles.
+	Updated time.Time
+}
+
+// Tombstone describes a deleted article.
+type Tombstone struct {
+	ArticleKey []byte
+	DeletedAt  time.Time
+}
+
+// Published returns the articles that can be published at the feed update
+// time.
+func (f *Feed) Published() []*blog.Article {
+	var published []*blog.Article
+	now := weave.AsUnixTime(f.Updated)
+	for _, a := range f.Articles {
+		if a.Published(now) {
+			published = append(published, a)
+		}
+	}
+	return published
+}
+
+// TTL returns for how long the feed can be cached, or zero if there is no
+// limit. It is the time left until the first published article is deleted.
+func (f *Feed) TTL() time.Duration {
+	var ttl time.Duration
+	for _, a := range f.Published() {
+		if a.DeleteAt == 0 {
+			continue
+		}
+		if left := a.DeleteAt.Time().Sub(f.Updated); ttl == 0 || left < ttl {
+			ttl = left
+		}
+	}
+	return ttl
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	TTL           int       `xml:"ttl,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link,omitempty"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// WriteRSS writes the RSS 2.0 document of the feed.
+func (f *Feed) WriteRSS(w io.Writer) error {
+	doc := rss{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         f.Blog.Title,
+			Link:          f.Link,
+			Description:   f.Blog.Description,
+			LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+		},
+	}
+	if ttl := f.TTL(); ttl > 0 {
+		// Time to live is in minutes, round up to refresh after the
+		// deletion rather than before it.
+		doc.Channel.TTL = int(math.Ceil(ttl.Minutes()))
+	}
+	for _, a := range f.Published() {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       a.Title,
+			Link:        f.articleLink(a.PrimaryKey),
+			Description: a.Content,
+			GUID:        rssGUID{Value: f.entryID(a.PrimaryKey)},
+			PubDate:     a.CreatedAt.Time().UTC().Format(time.RFC1123Z),
+		})
+	}
+	return writeXML(w, doc)
+}
+
+type atom struct {
+	XMLName      xml.Name        `xml:"http://www.w3.org/2005/Atom feed"`
+	Tombstones   string          `xml:"xmlns:at,attr,omitempty"`
+	ID           string          `xml:"id"`
+	Title        string          `xml:"title"`
+	Subtitle     string          `xml:"subtitle,omitempty"`
+	Updated      string          `xml:"updated"`
+	Links        []atomLink      `xml:"link"`
+	Author       atomAuthor      `xml:"author"`
+	Entries      []atomEntry     `xml:"entry"`
+	DeletedEntry []atomTombstone `xml:"at:deleted-entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Links     []atomLink  `xml:"link"`
+	Author    atomAuthor  `xml:"author"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomTombstone struct {
+	Ref  string `xml:"ref,attr"`
+	When string `xml:"when,attr"`
+}
+
+// WriteAtom writes the Atom document of the feed.
+func (f *Feed) WriteAtom(w io.Writer) error {
+	doc := atom{
+		ID:       f.blogID(),
+		Title:    f.Blog.Title,
+		Subtitle: f.Blog.Description,
+		Updated:  f.Updated.UTC().Format(time.RFC3339),
+		Author:   atomAuthor{Name: f.Blog.Owner.String()},
+	}
+	if f.Link != "" {
+		doc.Links = []atomLink{{Href: f.Link}}
+	}
+	for _, a := range f.Published() {
+		created := a.CreatedAt.Time().UTC().Format(time.RFC3339)
+		entry := atomEntry{
+			ID:        f.entryID(a.PrimaryKey),
+			Title:     a.Title,
+			Published: created,
+			Updated:   created,
+			Author:    atomAuthor{Name: a.Owner.String()},
+			Content:   atomContent{Type: "text", Value: a.Content},
+		}
+		if link := f.articleLink(a.PrimaryKey); link != "" {
+			entry.Links = []atomLink{{Href: link}}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	for _, t := range f.Deleted {
+		doc.DeletedEntry = append(doc.DeletedEntry, atomTombstone{
+			Ref:  f.entryID(t.ArticleKey),
+			When: t.DeletedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	if len(doc.DeletedEntry) != 0 {
+		doc.Tombstones = "http://purl.org/atompub/tombstones/1.0"
+	}
+	return writeXML(w, doc)
+}
+
+// blogID returns the unique identifier of the blog.
+func (f *Feed) blogID() string {
+	return fmt.Sprintf("urn:blog:%s:blog:%s", f.ChainID, seqID(f.Blog.PrimaryKey))
+}
+
+// entryID returns the unique identifier of an article, the same for both
+// feed formats.
+func (f *Feed) entryID(articleKey []byte) string {
+	return fmt.Sprintf("urn:blog:%s:article:%s", f.ChainID, seqID(articleKey))
+}
+
+func (f *Feed) articleLink(articleKey []byte) string {
+	if f.Link == "" {
+		return ""
+	}
+	return f.Link + "/articles/" + seqID(articleKey)
+}
+
+// seqID returns the decimal ID of a sequence key.
+func seqID(key []byte) string {
+	if len(key) != 8 {
+		return fmt.Sprintf("%X", key)
+	}
+	return strconv.FormatUint(binary.BigEndian.Uint64(key), 10)
+}
+
+func writeXML(w io.Writer, doc interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,201 @@
+package feed
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+var now = time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC)
+
+func seqKey(n byte) []byte {
+	return []byte{0, 0, 0, 0, 0, 0, 0, n}
+}
+
+func TestPublished(t *testing.T) {
+	article := func(n byte, hidden bool, deleteAt time.Duration) *blog.Article {
+		a := &blog.Article{
+			PrimaryKey: seqKey(n),
+			Hidden:     hidden,
+			CreatedAt:  weave.AsUnixTime(now.Add(-time.Hour)),
+		}
+		if deleteAt != 0 {
+			a.DeleteAt = weave.AsUnixTime(now.Add(deleteAt))
+		}
+		return a
+	}
+
+	cases := map[string]struct {
+		articles []*blog.Article
+		want     []byte
+		wantTTL  time.Duration
+	}{
+		"no articles": {},
+		"published": {
+			articles: []*blog.Article{article(1, false, 0)},
+			want:     []byte{1},
+		},
+		"hidden": {
+			articles: []*blog.Article{article(1, true, 0), article(2, false, 0)},
+			want:     []byte{2},
+		},
+		"deletion time passed": {
+			articles: []*blog.Article{article(1, false, -time.Second), article(2, false, 0)},
+			want:     []byte{2},
+		},
+		"deletion time is now": {
+			articles: []*blog.Article{article(1, false, 0)},
+			want:     []byte{1},
+		},
+		"scheduled for deletion": {
+			articles: []*blog.Article{article(1, false, time.Hour), article(2, false, 2*time.Minute), article(3, false, 0)},
+			want:     []byte{1, 2, 3},
+			wantTTL:  2 * time.Minute,
+		},
+		"hidden scheduled for deletion": {
+			articles: []*blog.Article{article(1, true, 2*time.Minute), article(2, false, time.Hour)},
+			want:     []byte{2},
+			wantTTL:  time.Hour,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f := Feed{Articles: tc.articles, Updated: now}
+			var got []byte
+			for _, a := range f.Published() {
+				got = append(got, a.PrimaryKey[7])
+			}
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantTTL, f.TTL())
+		})
+	}
+}
+
+func testFeed() *Feed {
+	owner := weave.NewAddress([]byte("owner"))
+	return &Feed{
+		ChainID: "test-chain",
+		Blog: &blog.Blog{
+			PrimaryKey:  seqKey(1),
+			Owner:       owner,
+			Title:       "News & notes",
+			Description: "Daily notes",
+		},
+		Articles: []*blog.Article{
+			{
+				PrimaryKey: seqKey(4),
+				Owner:      owner,
+				Title:      "Expiring",
+				Content:    "Read it <now>",
+				CreatedAt:  weave.AsUnixTime(now.Add(-time.Minute)),
+				DeleteAt:   weave.AsUnixTime(now.Add(90 * time.Second)),
+			},
+			{
+				PrimaryKey: seqKey(3),
+				Owner:      owner,
+				Title:      "Hidden",
+				Content:    "Nothing to see",
+				CreatedAt:  weave.AsUnixTime(now.Add(-time.Hour)),
+				Hidden:     true,
+			},
+			{
+				PrimaryKey: seqKey(1),
+				Owner:      owner,
+				Title:      "First",
+				Content:    "Hello",
+				CreatedAt:  weave.AsUnixTime(now.Add(-24 * time.Hour)),
+			},
+		},
+		Deleted: []Tombstone{
+			{ArticleKey: seqKey(2), DeletedAt: now.Add(-2 * time.Hour)},
+		},
+		Link:    "https://blog.example.com/blogs/1",
+		Updated: now,
+	}
+}
+
+func TestWriteRSS(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, testFeed().WriteRSS(&buf))
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0">
+  <channel>
+    <title>News &amp; notes</title>
+    <link>https://blog.example.com/blogs/1</link>
+    <description>Daily notes</description>
+    <lastBuildDate>Mon, 01 Jul 2019 12:00:00 +0000</lastBuildDate>
+    <ttl>2</ttl>
+    <item>
+      <title>Expiring</title>
+      <link>https://blog.example.com/blogs/1/articles/4</link>
+      <description>Read it &lt;now&gt;</description>
+      <guid isPermaLink="false">urn:blog:test-chain:article:4</guid>
+      <pubDate>Mon, 01 Jul 2019 11:59:00 +0000</pubDate>
+    </item>
+    <item>
+      <title>First</title>
+      <link>https://blog.example.com/blogs/1/articles/1</link>
+      <description>Hello</description>
+      <guid isPermaLink="false">urn:blog:test-chain:article:1</guid>
+      <pubDate>Sun, 30 Jun 2019 12:00:00 +0000</pubDate>
+    </item>
+  </channel>
+</rss>
+`
+	assert.Equal(t, want, buf.String())
+}
+
+func TestWriteAtom(t *testing.T) {
+	f := testFeed()
+	owner := f.Blog.Owner.String()
+	var buf bytes.Buffer
+	assert.Nil(t, f.WriteAtom(&buf))
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:at="http://purl.org/atompub/tombstones/1.0">
+  <id>urn:blog:test-chain:blog:1</id>
+  <title>News &amp; notes</title>
+  <subtitle>Daily notes</subtitle>
+  <updated>2019-07-01T12:00:00Z</updated>
+  <link href="https://blog.example.com/blogs/1"></link>
+  <author>
+    <name>` + owner + `</name>
+  </author>
+  <entry>
+    <id>urn:blog:test-chain:article:4</id>
+    <title>Expiring</title>
+    <published>2019-07-01T11:59:00Z</published>
+    <updated>2019-07-01T11:59:00Z</updated>
+    <link href="https://blog.example.com/blogs/1/articles/4"></link>
+    <author>
+      <name>` + owner + `</name>
+    </author>
+    <content type="text">Read it &lt;now&gt;</content>
+  </entry>
+  <entry>
+    <id>urn:blog:test-chain:article:1</id>
+    <title>First</title>
+    <published>2019-06-30T12:00:00Z</published>
+    <updated>2019-06-30T12:00:00Z</updated>
+    <link href="https://blog.example.com/blogs/1/articles/1"></link>
+    <author>
+      <name>` + owner + `</name>
+    </author>
+    <content type="text">Hello</content>
+  </entry>
+  <at:deleted-entry ref="urn:blog:test-chain:article:2" when="2019-07-01T10:00:00Z"></at:deleted-entry>
+</feed>
+`
+	assert.Equal(t, want, buf.String())
+
+	// the tombstones namespace is declared only when used
+	f.Deleted = nil
+	buf.Reset()
+	assert.Nil(t, f.WriteAtom(&buf))
+	assert.Equal(t, false, bytes.Contains(buf.Bytes(), []byte("tombstones")))
+}
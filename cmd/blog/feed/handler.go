@@ -0,0 +1,86 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net/http"
+	"strconv"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave/errors"
+)
+
+// Handler serves blog feeds over HTTP, so that any HTTP server can mount
+// it on a path of its choice. The blog ID is given by the blog query
+// parameter and the document format by the format parameter, either rss
+// (the default) or atom, for example /feed?blog=3&format=atom.
+type Handler struct {
+	client *client.BlogClient
+
+	// Articles is the maximum number of articles in a feed.
+	Articles int
+	// Link is the optional URL of the blog websites. The blog ID is
+	// appended to it, so that the link of a blog is Link/{id}.
+	Link string
+}
+
+// NewHandler returns a feed handler with the default number of articles.
+func NewHandler(bc *client.BlogClient) *Handler {
+	return &Handler{
+		client:   bc,
+		Articles: blog.DefaultPageLimit,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.ParseUint(r.URL.Query().Get("blog"), 10, 64)
+	if err != nil || id == 0 {
+		http.Error(w, "invalid blog ID", http.StatusBadRequest)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "rss"
+	}
+	if format != "rss" && format != "atom" {
+		http.Error(w, "format must be rss or atom", http.StatusBadRequest)
+		return
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	f, err := Load(h.client, key, h.Articles)
+	if err != nil {
+		status := http.StatusBadGateway
+		if errors.ErrNotFound.Is(err) {
+			status = http.StatusNotFound
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	if h.Link != "" {
+		f.Link = h.Link + "/" + strconv.FormatUint(id, 10)
+	}
+
+	// Render first, so that a failure can still be reported with a status.
+	var buf bytes.Buffer
+	contentType := "application/rss+xml; charset=utf-8"
+	if format == "atom" {
+		contentType = "application/atom+xml; charset=utf-8"
+		err = f.WriteAtom(&buf)
+	} else {
+		err = f.WriteRSS(&buf)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	_, _ = buf.WriteTo(w)
+}
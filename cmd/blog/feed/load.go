@@ -0,0 +1,113 @@
+package feed
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave/errors"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+// maxSearchPage is the maximum page size of the transaction search.
+const maxSearchPage = 100
+
+// Load queries the blog of given key together with at most n of its most
+// recent articles, ordered using the time index of the blog articles, and
+// at most n of its recent deletions.
+//
+// Deletions are found by searching delete article transactions, so the node
+// must index the blog transaction tags. Articles deleted by the scheduled
+// task are not reported, they are only unpublished once their deletion time
+// passes.
+func Load(bc *client.BlogClient, blogKey []byte, n int) (*Feed, error) {
+	chainID, err := bc.ChainID()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get chain ID")
+	}
+	status, err := bc.Status()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get status")
+	}
+	b, err := bc.GetBlog(blogKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get blog")
+	}
+	articles, err := bc.LatestArticles(blogKey, n)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get articles")
+	}
+	deleted, err := loadDeleted(bc, blogKey, n)
+	if err != nil {
+		return nil, err
+	}
+	return &Feed{
+		ChainID:  chainID,
+		Blog:     &b.Blog,
+		Articles: articles.Articles,
+		Deleted:  deleted,
+		// Deletion time is compared with the block time, so use the
+		// chain time rather than the local clock.
+		Updated: status.SyncInfo.LatestBlockTime,
+	}, nil
+}
+
+// loadDeleted returns at most n most recent deletions of the blog articles,
+// the most recent first.
+func loadDeleted(bc *client.BlogClient, blogKey []byte, n int) ([]Tombstone, error) {
+	q := client.BlogTxQuery{
+		Action: (&blog.DeleteArticleMsg{}).Path(),
+		Blog:   blogKey,
+	}
+	if n > maxSearchPage {
+		n = maxSearchPage
+	}
+	// Search results are ordered from the oldest, so the most recent
+	// deletions are on the last page.
+	res, err := bc.SearchBlogTxs(q, 1, n)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot search deletions")
+	}
+	if last := (res.TotalCount + n - 1) / n; last > 1 {
+		if res, err = bc.SearchBlogTxs(q, last, n); err != nil {
+			return nil, errors.Wrap(err, "cannot search deletions")
+		}
+	}
+
+	var deleted []Tombstone
+	for i := len(res.Txs) - 1; i >= 0; i-- {
+		tx := res.Txs[i]
+		key, err := taggedArticle(tx.TxResult.Tags)
+		if err != nil {
+			return nil, err
+		}
+		height := tx.Height
+		block, err := bc.TendermintClient().Block(&height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot get block %d", height)
+		}
+		deleted = append(deleted, Tombstone{
+			ArticleKey: key,
+			DeletedAt:  block.Block.Header.Time,
+		})
+	}
+	return deleted, nil
+}
+
+// taggedArticle returns the key of the article affected by a transaction.
+func taggedArticle(tags []cmn.KVPair) ([]byte, error) {
+	for _, t := range tags {
+		if string(t.Key) != blog.TagArticle {
+			continue
+		}
+		n, err := strconv.ParseUint(string(t.Value), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(errors.ErrInput, "invalid article tag %q", t.Value)
+		}
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, n)
+		return key, nil
+	}
+	return nil, errors.Wrap(errors.ErrNotFound, "article tag")
+}
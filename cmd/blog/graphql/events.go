@@ -0,0 +1,111 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+)
+
+// listenerBuffer is the number of articles a subscription can fall behind
+// before it is closed.
+const listenerBuffer = 64
+
+// articleFeed shares a single subscription to the blog events between all
+// GraphQL subscriptions, as a node limits the number of subscriptions of a
+// client. Blog events are followed only while there are listeners.
+type articleFeed struct {
+	subs *client.SubscriptionManager
+
+	mu      sync.Mutex
+	current *follower
+}
+
+// follower is a single subscription to the blog events.
+type follower struct {
+	cancel    context.CancelFunc
+	listeners map[chan client.ArticleCreated]struct{}
+}
+
+func newArticleFeed(sm *client.SubscriptionManager) *articleFeed {
+	return &articleFeed{subs: sm}
+}
+
+// subscribe returns a channel of articles created after the call until the
+// context is cancelled. The channel is closed when the subscription ends,
+// which also happens if the listener does not keep up with new articles.
+func (f *articleFeed) subscribe(ctx context.Context) (<-chan client.ArticleCreated, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.current == nil {
+		fctx, cancel := context.WithCancel(context.Background())
+		events, err := f.subs.SubscribeBlogEvents(fctx, 0)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		f.current = &follower{
+			cancel:    cancel,
+			listeners: make(map[chan client.ArticleCreated]struct{}),
+		}
+		go f.run(f.current, events)
+	}
+
+	fw := f.current
+	ch := make(chan client.ArticleCreated, listenerBuffer)
+	fw.listeners[ch] = struct{}{}
+	go func() {
+		<-ctx.Done()
+		f.remove(fw, ch)
+	}()
+	return ch, nil
+}
+
+// run delivers created articles to the listeners of a follower until its
+// subscription ends.
+func (f *articleFeed) run(fw *follower, events <-chan client.BlogEvent) {
+	for e := range events {
+		created, ok := e.(client.ArticleCreated)
+		if !ok {
+			continue
+		}
+		f.mu.Lock()
+		for ch := range fw.listeners {
+			select {
+			case ch <- created:
+			default:
+				f.removeLocked(fw, ch)
+			}
+		}
+		f.mu.Unlock()
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for ch := range fw.listeners {
+		f.removeLocked(fw, ch)
+	}
+}
+
+func (f *articleFeed) remove(fw *follower, ch chan client.ArticleCreated) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removeLocked(fw, ch)
+}
+
+// removeLocked closes a listener channel and stops following the blog events
+// once the last listener is gone. It must be called with the mutex held.
+func (f *articleFeed) removeLocked(fw *follower, ch chan client.ArticleCreated) {
+	if _, ok := fw.listeners[ch]; !ok {
+		return
+	}
+	delete(fw.listeners, ch)
+	close(ch)
+	if len(fw.listeners) == 0 {
+		fw.cancel()
+		if f.current == fw {
+			f.current = nil
+		}
+	}
+}
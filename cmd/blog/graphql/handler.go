@@ -0,0 +1,113 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+)
+
+// Handler serves the GraphQL API over HTTP, so that any HTTP server can mount
+// it on a path of its choice.
+//
+// Queries are accepted as a JSON encoded POST body or as GET query, operation
+// and variables parameters. Subscriptions are served as Server-Sent Events
+// when the request accepts text/event-stream: every result is sent as a next
+// event and the end of the subscription as a complete event.
+type Handler struct {
+	client *client.BlogClient
+	schema *gql.Schema
+}
+
+// NewHandler returns a GraphQL handler resolving queries using given client
+// and following new articles with given subscription manager.
+func NewHandler(bc *client.BlogClient, sm *client.SubscriptionManager) (*Handler, error) {
+	schema, err := NewSchema(bc, sm)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{client: bc, schema: schema}, nil
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				http.Error(w, "invalid variables", http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if req.Query == "" {
+		http.Error(w, "query is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := withLoaders(r.Context(), newLoaders(h.client))
+	if !strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		resp := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusNotImplemented)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	results, err := h.schema.Subscribe(ctx, req.Query, req.OperationName, req.Variables)
+	if err != nil {
+		cancel()
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// Results must be consumed until the subscription ends, even if the
+	// client is gone, or the resolving goroutines would block forever.
+	defer func() {
+		cancel()
+		for range results {
+		}
+	}()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+	for res := range results {
+		data, err := json.Marshal(res)
+		if err != nil {
+			return
+		}
+		if _, err := fmt.Fprintf(w, "event: next\ndata: %s\n\n", data); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+	fmt.Fprint(w, "event: complete\ndata:\n\n")
+	flusher.Flush()
+}
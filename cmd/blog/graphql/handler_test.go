@@ -0,0 +1,335 @@
+package graphql
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// countingConn records the paths of all ABCI queries.
+type countingConn struct {
+	tmclient.Client
+
+	mu    sync.Mutex
+	paths []string
+}
+
+func (c *countingConn) ABCIQuery(path string, data cmn.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	c.mu.Lock()
+	c.paths = append(c.paths, path)
+	c.mu.Unlock()
+	return c.Client.ABCIQuery(path, data)
+}
+
+// counts returns the number of queries of each path made since the last
+// call and resets the counters.
+func (c *countingConn) counts() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make(map[string]int)
+	for _, p := range c.paths {
+		out[strings.SplitN(p, "?", 2)[0]]++
+	}
+	c.paths = nil
+	return out
+}
+
+type response struct {
+	Data   json.RawMessage
+	Errors []struct{ Message string }
+}
+
+func TestHandler(t *testing.T) {
+	conn := &countingConn{Client: client.NewLocalConnection(node)}
+	bc := client.NewClient(conn)
+	author := client.GenPrivateKey()
+	poster := client.NewPoster(bc, author, coin.Coin{})
+
+	user, err := poster.Post(client.BuildCreateUserTx("graphql", "GraphQL user"))
+	assert.Nil(t, err)
+	var blogIDs []string
+	for _, title := range []string{"First blog", "Second blog"} {
+		res, err := poster.Post(client.BuildCreateBlogTx(title, "description"))
+		assert.Nil(t, err)
+		blogIDs = append(blogIDs, string(seqID(res.Key)))
+	}
+	var articleIDs []string
+	titles := []string{"First article", "Second article", "Third article", "Fourth article"}
+	for _, title := range titles {
+		res, err := poster.Post(client.BuildCreateArticleTx(seqKey(mustParse(t, blogIDs[0])), title, "Some content", 0))
+		assert.Nil(t, err)
+		articleIDs = append(articleIDs, string(seqID(res.Key)))
+	}
+	other, err := poster.Post(client.BuildCreateArticleTx(seqKey(mustParse(t, blogIDs[1])), "Other article", "Some content", 0))
+	assert.Nil(t, err)
+	hidden, err := poster.Post(client.BuildCreateArticleTx(seqKey(mustParse(t, blogIDs[0])), "Hidden article", "Some content", 0))
+	assert.Nil(t, err)
+	// The faucet is the moderator of the test chain.
+	_, err = client.NewPoster(bc, faucet, coin.Coin{}).Post(client.BuildHideArticleTx(hidden.Key, true))
+	assert.Nil(t, err)
+
+	h, err := NewHandler(bc, client.NewSubscriptionManager(bc))
+	assert.Nil(t, err)
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	owner := author.PublicKey().Address().String()
+
+	t.Run("user", func(t *testing.T) {
+		var data struct {
+			User struct{ ID, Username, Bio string }
+		}
+		query(t, srv, `query($id: ID!) { user(id: $id) { id username bio } }`,
+			map[string]interface{}{"id": seqID(user.Key)}, &data)
+		assert.Equal(t, "graphql", data.User.Username)
+		assert.Equal(t, "GraphQL user", data.User.Bio)
+	})
+
+	t.Run("articles of a blog", func(t *testing.T) {
+		const q = `query($blog: ID!, $after: String) {
+			blog(id: $blog) {
+				title
+				owner { address }
+				articles(first: 3, after: $after) {
+					nextCursor
+					articles { title owner { address } blog { title } }
+				}
+			}
+		}`
+		type page struct {
+			Blog struct {
+				Title    string
+				Owner    struct{ Address string }
+				Articles struct {
+					NextCursor *string
+					Articles   []struct {
+						Title string
+						Owner struct{ Address string }
+						Blog  struct{ Title string }
+					}
+				}
+			}
+		}
+
+		seen := make(map[string]bool)
+		vars := map[string]interface{}{"blog": blogIDs[0]}
+		conn.counts()
+		for i := 0; ; i++ {
+			var data page
+			query(t, srv, q, vars, &data)
+			assert.Equal(t, "First blog", data.Blog.Title)
+			assert.Equal(t, owner, data.Blog.Owner.Address)
+			for _, a := range data.Blog.Articles.Articles {
+				if seen[a.Title] {
+					t.Fatalf("%q returned twice", a.Title)
+				}
+				seen[a.Title] = true
+				assert.Equal(t, "First blog", a.Blog.Title)
+				assert.Equal(t, owner, a.Owner.Address)
+			}
+
+			// The blog and the owner wallet are queried once, no
+			// matter how many articles refer to them.
+			counts := conn.counts()
+			assert.Equal(t, 1, counts["/blogs"])
+			assert.Equal(t, 1, counts["/wallets"])
+
+			if data.Blog.Articles.NextCursor == nil {
+				break
+			}
+			if i > len(titles) {
+				t.Fatal("pagination does not end")
+			}
+			vars["after"] = *data.Blog.Articles.NextCursor
+		}
+		assert.Equal(t, len(titles), len(seen))
+	})
+
+	t.Run("batched lookups", func(t *testing.T) {
+		var data struct {
+			A, B, C struct {
+				Blog struct{ Title string }
+			}
+		}
+		conn.counts()
+		query(t, srv, `query($a: ID!, $b: ID!, $c: ID!) {
+			a: article(id: $a) { blog { title } }
+			b: article(id: $b) { blog { title } }
+			c: article(id: $c) { blog { title } }
+		}`, map[string]interface{}{"a": articleIDs[0], "b": articleIDs[3], "c": seqID(other.Key)}, &data)
+		assert.Equal(t, "First blog", data.A.Blog.Title)
+		assert.Equal(t, "First blog", data.B.Blog.Title)
+		assert.Equal(t, "Second blog", data.C.Blog.Title)
+		counts := conn.counts()
+		assert.Equal(t, 1, counts["/articles"])
+		assert.Equal(t, 1, counts["/blogs"])
+	})
+
+	t.Run("hidden article", func(t *testing.T) {
+		var data struct {
+			Article *struct{ Title string }
+		}
+		query(t, srv, `query($id: ID!) { article(id: $id) { title } }`,
+			map[string]interface{}{"id": seqID(hidden.Key)}, &data)
+		if data.Article != nil {
+			t.Fatalf("hidden article returned: %+v", data.Article)
+		}
+	})
+
+	t.Run("blogs of an owner", func(t *testing.T) {
+		var data struct {
+			Blogs []struct{ ID string }
+		}
+		query(t, srv, `query($owner: String!) { blogs(owner: $owner) { id } }`,
+			map[string]interface{}{"owner": owner}, &data)
+		assert.Equal(t, 2, len(data.Blogs))
+	})
+
+	t.Run("wallet", func(t *testing.T) {
+		var data struct {
+			Wallet struct {
+				Coins []struct{ Ticker, Amount string }
+			}
+		}
+		query(t, srv, `query($addr: String!) { wallet(address: $addr) { coins { ticker amount } } }`,
+			map[string]interface{}{"addr": faucet.PublicKey().Address().String()}, &data)
+		assert.Equal(t, 1, len(data.Wallet.Coins))
+		assert.Equal(t, "BLOG", data.Wallet.Coins[0].Ticker)
+		assert.Equal(t, "100200300", data.Wallet.Coins[0].Amount)
+
+		// an address without coins has an empty wallet
+		query(t, srv, `query($addr: String!) { wallet(address: $addr) { coins { ticker amount } } }`,
+			map[string]interface{}{"addr": owner}, &data)
+		assert.Equal(t, 0, len(data.Wallet.Coins))
+	})
+
+	t.Run("missing entity", func(t *testing.T) {
+		var data struct {
+			Blog *struct{ Title string }
+		}
+		query(t, srv, `{ blog(id: "999999") { title } }`, nil, &data)
+		if data.Blog != nil {
+			t.Fatalf("unexpected blog: %+v", data.Blog)
+		}
+	})
+
+	errCases := map[string]string{
+		"invalid id":     `{ blog(id: "first") { title } }`,
+		"invalid limit":  `{ blog(id: "` + blogIDs[0] + `") { articles(first: 1000) { nextCursor } } }`,
+		"invalid cursor": `{ blog(id: "` + blogIDs[0] + `") { articles(after: "yesterday") { nextCursor } } }`,
+		"invalid owner":  `{ blogs(owner: "nope") { id } }`,
+		"unknown field":  `{ blog(id: "1") { name } }`,
+	}
+	for name, q := range errCases {
+		t.Run(name, func(t *testing.T) {
+			resp := post(t, srv, q, nil)
+			if len(resp.Errors) == 0 {
+				t.Fatalf("want errors, got %s", resp.Data)
+			}
+		})
+	}
+
+	t.Run("article created subscription", func(t *testing.T) {
+		body, err := json.Marshal(request{
+			Query:     `subscription($blog: ID) { articleCreated(blog: $blog) { title blog { title } } }`,
+			Variables: map[string]interface{}{"blog": blogIDs[1]},
+		})
+		assert.Nil(t, err)
+		req, err := http.NewRequest(http.MethodPost, srv.URL, bytes.NewReader(body))
+		assert.Nil(t, err)
+		req.Header.Set("Accept", "text/event-stream")
+		resp, err := http.DefaultClient.Do(req)
+		assert.Nil(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		// an article of another blog is filtered out
+		for _, b := range []string{blogIDs[0], blogIDs[1]} {
+			_, err = poster.Post(client.BuildCreateArticleTx(seqKey(mustParse(t, b)), "Article of "+b, "Some content", 0))
+			assert.Nil(t, err)
+		}
+
+		events := make(chan string)
+		go func() {
+			s := bufio.NewScanner(resp.Body)
+			for s.Scan() {
+				if line := s.Text(); strings.HasPrefix(line, "data: ") {
+					events <- strings.TrimPrefix(line, "data: ")
+				}
+			}
+			close(events)
+		}()
+		select {
+		case data := <-events:
+			var got struct {
+				Data struct {
+					ArticleCreated struct {
+						Title string
+						Blog  struct{ Title string }
+					}
+				}
+			}
+			assert.Nil(t, json.Unmarshal([]byte(data), &got))
+			assert.Equal(t, "Article of "+blogIDs[1], got.Data.ArticleCreated.Title)
+			assert.Equal(t, "Second blog", got.Data.ArticleCreated.Blog.Title)
+		case <-time.After(10 * time.Second):
+			t.Fatal("no article created event")
+		}
+	})
+}
+
+func mustParse(t testing.TB, id string) uint64 {
+	t.Helper()
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		t.Fatalf("invalid ID %q: %s", id, err)
+	}
+	return n
+}
+
+// query executes a GraphQL query that must succeed and decodes its data
+// into out.
+func query(t testing.TB, srv *httptest.Server, q string, vars map[string]interface{}, out interface{}) {
+	t.Helper()
+	resp := post(t, srv, q, vars)
+	if len(resp.Errors) != 0 {
+		t.Fatalf("query failed: %+v", resp.Errors)
+	}
+	if err := json.Unmarshal(resp.Data, out); err != nil {
+		t.Fatalf("cannot decode data: %s", err)
+	}
+}
+
+func post(t testing.TB, srv *httptest.Server, q string, vars map[string]interface{}) response {
+	t.Helper()
+	body, err := json.Marshal(request{Query: q, Variables: vars})
+	if err != nil {
+		t.Fatalf("cannot encode request: %s", err)
+	}
+	resp, err := http.Post(srv.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("cannot post query: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("want 200 status, got %d", resp.StatusCode)
+	}
+	var out response
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatalf("cannot decode response: %s", err)
+	}
+	return out
+}
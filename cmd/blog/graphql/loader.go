@@ -0,0 +1,263 @@
+package graphql
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// batchWait is how long a loader collects keys before querying them. Fields
+// of a single request are resolved concurrently, so sibling lookups made
+// within this window are served by a single query.
+const batchWait = 2 * time.Millisecond
+
+// fetchFunc loads entities of given keys. Keys of entities that do not exist
+// are missing from the returned map.
+type fetchFunc func(keys [][]byte) (map[string]interface{}, error)
+
+// loader batches and caches lookups of entities made while resolving a single
+// request, so that resolving a list of entities does not result in a query per
+// entity. A loader must not be shared between requests, as it never refreshes
+// the cached entities.
+type loader struct {
+	fetch    fetchFunc
+	wait     time.Duration
+	maxBatch int
+
+	mu      sync.Mutex
+	results map[string]*result
+	batch   *batch
+}
+
+type result struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+type batch struct {
+	keys    [][]byte
+	results []*result
+	timer   *time.Timer
+}
+
+func newLoader(fetch fetchFunc, maxBatch int) *loader {
+	return &loader{
+		fetch:    fetch,
+		wait:     batchWait,
+		maxBatch: maxBatch,
+		results:  make(map[string]*result),
+	}
+}
+
+// load returns the entity of given key, waiting for the batch it was queried
+// with. ErrNotFound is returned if the entity does not exist.
+func (l *loader) load(ctx context.Context, key []byte) (interface{}, error) {
+	l.mu.Lock()
+	r, ok := l.results[string(key)]
+	if !ok {
+		r = &result{done: make(chan struct{})}
+		l.results[string(key)] = r
+		l.enqueue(key, r)
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-r.done:
+		return r.value, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// enqueue adds a key to the pending batch, starting a new one if needed. A
+// batch is queried once it is full or when its wait time passes. It must be
+// called with the mutex held.
+func (l *loader) enqueue(key []byte, r *result) {
+	if l.batch == nil {
+		b := &batch{}
+		l.batch = b
+		b.timer = time.AfterFunc(l.wait, func() {
+			l.mu.Lock()
+			pending := l.batch == b
+			if pending {
+				l.batch = nil
+			}
+			l.mu.Unlock()
+			if pending {
+				l.dispatch(b)
+			}
+		})
+	}
+	b := l.batch
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, r)
+	if len(b.keys) >= l.maxBatch {
+		b.timer.Stop()
+		l.batch = nil
+		go l.dispatch(b)
+	}
+}
+
+// dispatch queries all keys of a batch and delivers the results.
+func (l *loader) dispatch(b *batch) {
+	values, err := l.fetch(b.keys)
+	for i, r := range b.results {
+		switch v, ok := values[string(b.keys[i])]; {
+		case err != nil:
+			r.err = err
+		case !ok:
+			r.err = errors.Wrapf(errors.ErrNotFound, "key %X", b.keys[i])
+		default:
+			r.value = v
+		}
+		close(r.done)
+	}
+}
+
+// loaders holds the loaders of a single request.
+type loaders struct {
+	users    *loader
+	blogs    *loader
+	articles *loader
+	wallets  *loader
+}
+
+func newLoaders(bc *client.BlogClient) *loaders {
+	return &loaders{
+		users: newLoader(sequenceFetcher(bc, "/users", func(m weave.Model) (interface{}, []byte, error) {
+			var u blog.User
+			err := u.Unmarshal(m.Value)
+			return &u, u.PrimaryKey, err
+		}), blog.MaxPageLimit),
+		blogs: newLoader(sequenceFetcher(bc, "/blogs", func(m weave.Model) (interface{}, []byte, error) {
+			var b blog.Blog
+			err := b.Unmarshal(m.Value)
+			return &b, b.PrimaryKey, err
+		}), blog.MaxPageLimit),
+		articles: newLoader(sequenceFetcher(bc, "/articles", func(m weave.Model) (interface{}, []byte, error) {
+			var a blog.Article
+			err := a.Unmarshal(m.Value)
+			return &a, a.PrimaryKey, err
+		}), blog.MaxPageLimit),
+		wallets: newLoader(walletFetcher(bc), blog.MaxPageLimit),
+	}
+}
+
+// maxSpread limits how many entities that were not asked for a range query
+// can return. Keys further apart are queried separately.
+const maxSpread = 2
+
+// sequenceFetcher returns a fetch function of a paginated path whose entities
+// are stored under sequence keys. Keys are sorted and dense runs of them are
+// loaded with a single range query, so that a batch of keys is usually
+// served by one or a few queries.
+func sequenceFetcher(bc *client.BlogClient, path string, decode func(weave.Model) (interface{}, []byte, error)) fetchFunc {
+	return func(keys [][]byte) (map[string]interface{}, error) {
+		ids := make([]uint64, 0, len(keys))
+		for _, k := range keys {
+			if len(k) != 8 {
+				return nil, errors.Wrapf(client.ErrInvalid, "invalid key %X", k)
+			}
+			ids = append(ids, binary.BigEndian.Uint64(k))
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		out := make(map[string]interface{}, len(keys))
+		for _, run := range sequenceRuns(ids) {
+			var after []byte
+			if run.first > 0 {
+				after = seqKey(run.first - 1)
+			}
+			resp, err := bc.AbciQueryPage(path, after, int(run.last-run.first+1), false)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range resp.Models {
+				v, key, err := decode(m)
+				if err != nil {
+					return nil, errors.Wrapf(err, "cannot decode %s entity", path)
+				}
+				out[string(key)] = v
+			}
+		}
+		return out, nil
+	}
+}
+
+type sequenceRun struct {
+	first, last uint64
+}
+
+// sequenceRuns splits sorted IDs into ranges that can be queried at once.
+// A range holds at most blog.MaxPageLimit IDs and at most maxSpread times
+// more IDs than were asked for.
+func sequenceRuns(ids []uint64) []sequenceRun {
+	var runs []sequenceRun
+	var asked uint64
+	for i, id := range ids {
+		if i > 0 && id == ids[i-1] {
+			continue
+		}
+		if n := len(runs); n > 0 {
+			run := &runs[n-1]
+			size := id - run.first + 1
+			if size <= blog.MaxPageLimit && size <= maxSpread*(asked+1) {
+				run.last = id
+				asked++
+				continue
+			}
+		}
+		runs = append(runs, sequenceRun{first: id, last: id})
+		asked = 1
+	}
+	return runs
+}
+
+// walletFetcher returns a fetch function of wallets. Wallets are stored under
+// addresses that cannot be queried in ranges, so each distinct address is
+// queried separately, all at the same time.
+func walletFetcher(bc *client.BlogClient) fetchFunc {
+	return func(keys [][]byte) (map[string]interface{}, error) {
+		var (
+			wg    sync.WaitGroup
+			mu    sync.Mutex
+			out   = make(map[string]interface{}, len(keys))
+			first error
+		)
+		for _, k := range keys {
+			wg.Add(1)
+			go func(addr weave.Address) {
+				defer wg.Done()
+				w, err := bc.GetWallet(addr)
+				mu.Lock()
+				defer mu.Unlock()
+				switch {
+				case errors.ErrNotFound.Is(err):
+				case err != nil:
+					if first == nil {
+						first = err
+					}
+				default:
+					out[string(addr)] = w
+				}
+			}(k)
+		}
+		wg.Wait()
+		return out, first
+	}
+}
+
+// seqKey returns the key of given sequence ID.
+func seqKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
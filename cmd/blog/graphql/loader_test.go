@@ -0,0 +1,123 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSequenceRuns(t *testing.T) {
+	cases := map[string]struct {
+		ids  []uint64
+		want []sequenceRun
+	}{
+		"empty": {},
+		"single": {
+			ids:  []uint64{7},
+			want: []sequenceRun{{7, 7}},
+		},
+		"consecutive": {
+			ids:  []uint64{1, 2, 3, 4},
+			want: []sequenceRun{{1, 4}},
+		},
+		"duplicates": {
+			ids:  []uint64{3, 3, 4, 4},
+			want: []sequenceRun{{3, 4}},
+		},
+		"small gaps": {
+			ids:  []uint64{1, 3, 5, 7},
+			want: []sequenceRun{{1, 7}},
+		},
+		"far apart": {
+			ids:  []uint64{1, 2, 100, 1000},
+			want: []sequenceRun{{1, 2}, {100, 100}, {1000, 1000}},
+		},
+		"page limit": {
+			ids:  seqRange(1, 250),
+			want: []sequenceRun{{1, 200}, {201, 250}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, sequenceRuns(tc.ids))
+		})
+	}
+}
+
+func seqRange(first, last uint64) []uint64 {
+	var ids []uint64
+	for id := first; id <= last; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestLoader(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		batches [][]string
+	)
+	l := newLoader(func(keys [][]byte) (map[string]interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		var batch []string
+		out := make(map[string]interface{})
+		for _, k := range keys {
+			batch = append(batch, string(k))
+			if string(k) != "missing" {
+				out[string(k)] = "value of " + string(k)
+			}
+		}
+		batches = append(batches, batch)
+		return out, nil
+	}, 4)
+	l.wait = 20 * time.Millisecond
+	ctx := context.Background()
+
+	// concurrent lookups are batched and duplicates are queried once
+	keys := []string{"a", "b", "a", "missing"}
+	values := make([]interface{}, len(keys))
+	errs := make([]error, len(keys))
+	var wg sync.WaitGroup
+	for i, k := range keys {
+		wg.Add(1)
+		go func(i int, k string) {
+			defer wg.Done()
+			values[i], errs[i] = l.load(ctx, []byte(k))
+		}(i, k)
+	}
+	wg.Wait()
+	assert.Equal(t, 1, len(batches))
+	assert.Equal(t, 3, len(batches[0]))
+	assert.Equal(t, "value of a", values[0])
+	assert.Equal(t, "value of b", values[1])
+	assert.Equal(t, "value of a", values[2])
+	assert.Nil(t, errs[0])
+	assert.Equal(t, true, errors.ErrNotFound.Is(errs[3]))
+
+	// results are cached for the lifetime of the loader
+	v, err := l.load(ctx, []byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, "value of b", v)
+	assert.Equal(t, 1, len(batches))
+
+	// a full batch is queried without waiting
+	start := time.Now()
+	for _, k := range []string{"c", "d", "e", "f"} {
+		wg.Add(1)
+		go func(k string) {
+			defer wg.Done()
+			_, _ = l.load(ctx, []byte(k))
+		}(k)
+	}
+	wg.Wait()
+	assert.Equal(t, 2, len(batches))
+	assert.Equal(t, 4, len(batches[1]))
+	if d := time.Since(start); d >= l.wait {
+		t.Fatalf("full batch waited %s", d)
+	}
+}
@@ -0,0 +1,23 @@
+package graphql
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client/clienttest"
+	"github.com/iov-one/weave/crypto"
+	nm "github.com/tendermint/tendermint/node"
+)
+
+// useful values for test cases
+var node *nm.Node
+var faucet *crypto.PrivateKey
+
+func TestMain(m *testing.M) {
+	faucet = client.GenPrivateKey()
+	code := clienttest.RunWithNode(m, faucet.PublicKey().Address(), func(n *nm.Node) {
+		node = n
+	})
+	os.Exit(code)
+}
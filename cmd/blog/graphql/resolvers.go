@@ -0,0 +1,384 @@
+package graphql
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// Resolver is the root resolver of the schema.
+type Resolver struct {
+	client   *client.BlogClient
+	articles *articleFeed
+}
+
+type ctxKey int
+
+const loadersKey ctxKey = iota
+
+// withLoaders returns a context carrying the loaders of a request.
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey, l)
+}
+
+// requestLoaders returns the loaders of the request, or new ones if the
+// context does not carry any.
+func (r *Resolver) requestLoaders(ctx context.Context) *loaders {
+	if l, ok := ctx.Value(loadersKey).(*loaders); ok {
+		return l
+	}
+	return newLoaders(r.client)
+}
+
+// User resolves Query.user.
+func (r *Resolver) User(ctx context.Context, args struct{ ID gql.ID }) (*userResolver, error) {
+	key, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := r.requestLoaders(ctx).users.load(ctx, key)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	return &userResolver{user: v.(*blog.User)}, nil
+}
+
+// Blog resolves Query.blog.
+func (r *Resolver) Blog(ctx context.Context, args struct{ ID gql.ID }) (*blogResolver, error) {
+	key, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	l := r.requestLoaders(ctx)
+	v, err := l.blogs.load(ctx, key)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	return &blogResolver{blog: v.(*blog.Blog), client: r.client, loaders: l}, nil
+}
+
+// Article resolves Query.article. Articles that are not published, see
+// blog.Article.Published, resolve to null as if they did not exist.
+func (r *Resolver) Article(ctx context.Context, args struct{ ID gql.ID }) (*articleResolver, error) {
+	key, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	l := r.requestLoaders(ctx)
+	v, err := l.articles.load(ctx, key)
+	if err != nil {
+		return nil, ignoreNotFound(err)
+	}
+	a := v.(*blog.Article)
+	if !a.Published(weave.AsUnixTime(time.Now())) {
+		return nil, nil
+	}
+	return &articleResolver{article: a, client: r.client, loaders: l}, nil
+}
+
+// Blogs resolves Query.blogs.
+func (r *Resolver) Blogs(ctx context.Context, args struct{ Owner string }) ([]*blogResolver, error) {
+	owner, err := weave.ParseAddress(args.Owner)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "invalid owner address")
+	}
+	resp, err := r.client.ListBlogsByOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+	l := r.requestLoaders(ctx)
+	out := make([]*blogResolver, 0, len(resp.Blogs))
+	for _, b := range resp.Blogs {
+		out = append(out, &blogResolver{blog: b, client: r.client, loaders: l})
+	}
+	return out, nil
+}
+
+// Wallet resolves Query.wallet.
+func (r *Resolver) Wallet(ctx context.Context, args struct{ Address string }) (*walletResolver, error) {
+	addr, err := weave.ParseAddress(args.Address)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "invalid address")
+	}
+	return loadWallet(ctx, r.requestLoaders(ctx), addr)
+}
+
+// ArticleCreated resolves Subscription.articleCreated.
+func (r *Resolver) ArticleCreated(ctx context.Context, args struct{ Blog *gql.ID }) (<-chan *articleResolver, error) {
+	var blogKey []byte
+	if args.Blog != nil {
+		key, err := parseID(*args.Blog)
+		if err != nil {
+			return nil, err
+		}
+		blogKey = key
+	}
+	events, err := r.articles.subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *articleResolver)
+	go func() {
+		defer close(out)
+		for e := range events {
+			if blogKey != nil && string(e.BlogKey) != string(blogKey) {
+				continue
+			}
+			// Every event is resolved separately, so it must not
+			// be served from the cache of the previous one.
+			l := newLoaders(r.client)
+			v, err := l.articles.load(ctx, e.Key)
+			if err != nil {
+				// The article can be already deleted. There is
+				// no way to report other failures without
+				// ending the subscription, so skip it too.
+				continue
+			}
+			if !v.(*blog.Article).Published(weave.AsUnixTime(time.Now())) {
+				continue
+			}
+			a := &articleResolver{article: v.(*blog.Article), client: r.client, loaders: l}
+			select {
+			case out <- a:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+type userResolver struct {
+	user *blog.User
+}
+
+func (r *userResolver) ID() gql.ID {
+	return seqID(r.user.PrimaryKey)
+}
+
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+func (r *userResolver) Bio() string {
+	return r.user.Bio
+}
+
+func (r *userResolver) RegisteredAt() gql.Time {
+	return gql.Time{Time: r.user.RegisteredAt.Time()}
+}
+
+type blogResolver struct {
+	blog    *blog.Blog
+	client  *client.BlogClient
+	loaders *loaders
+}
+
+func (r *blogResolver) ID() gql.ID {
+	return seqID(r.blog.PrimaryKey)
+}
+
+func (r *blogResolver) Title() string {
+	return r.blog.Title
+}
+
+func (r *blogResolver) Description() string {
+	return r.blog.Description
+}
+
+func (r *blogResolver) CreatedAt() gql.Time {
+	return gql.Time{Time: r.blog.CreatedAt.Time()}
+}
+
+func (r *blogResolver) Owner(ctx context.Context) (*walletResolver, error) {
+	return loadWallet(ctx, r.loaders, r.blog.Owner)
+}
+
+type articlesArgs struct {
+	First       gql.NullInt
+	After       *string
+	From        *gql.Time
+	To          *gql.Time
+	NewestFirst gql.NullBool
+}
+
+// Articles returns a page of the blog articles. Articles that are not
+// published are left out, so a page can be shorter than requested. The
+// cursor is the hex encoded time range query cursor of the node.
+func (r *blogResolver) Articles(ctx context.Context, args articlesArgs) (*articleConnectionResolver, error) {
+	limit := blog.DefaultPageLimit
+	if args.First.Value != nil {
+		limit = int(*args.First.Value)
+	}
+	if limit <= 0 || limit > blog.MaxPageLimit {
+		return nil, errors.Wrapf(errors.ErrInput, "first must be between 1 and %d", blog.MaxPageLimit)
+	}
+	descending := args.NewestFirst.Value == nil || *args.NewestFirst.Value
+
+	var from, to weave.UnixTime
+	if args.From != nil {
+		from = weave.AsUnixTime(args.From.Time)
+	}
+	if args.To != nil {
+		to = weave.AsUnixTime(args.To.Time)
+	}
+	var after []byte
+	if args.After != nil {
+		var err error
+		if after, err = hex.DecodeString(*args.After); err != nil {
+			return nil, errors.Wrap(errors.ErrInput, "invalid cursor")
+		}
+	}
+
+	resp, err := r.client.AbciQueryTimeRange(r.blog.PrimaryKey, from, to, after, limit, descending)
+	if err != nil {
+		return nil, err
+	}
+	out := &articleConnectionResolver{articles: []*articleResolver{}}
+	now := weave.AsUnixTime(time.Now())
+	for _, m := range resp.Models {
+		var a blog.Article
+		if err := a.Unmarshal(m.Value); err != nil {
+			return nil, errors.Wrap(err, "cannot decode article")
+		}
+		if a.Published(now) {
+			out.articles = append(out.articles, &articleResolver{article: &a, client: r.client, loaders: r.loaders})
+		}
+	}
+	if resp.NextCursor != nil {
+		cursor := hex.EncodeToString(resp.NextCursor)
+		out.nextCursor = &cursor
+	}
+	return out, nil
+}
+
+type articleConnectionResolver struct {
+	articles   []*articleResolver
+	nextCursor *string
+}
+
+func (r *articleConnectionResolver) Articles() []*articleResolver {
+	return r.articles
+}
+
+func (r *articleConnectionResolver) NextCursor() *string {
+	return r.nextCursor
+}
+
+type articleResolver struct {
+	article *blog.Article
+	client  *client.BlogClient
+	loaders *loaders
+}
+
+func (r *articleResolver) ID() gql.ID {
+	return seqID(r.article.PrimaryKey)
+}
+
+func (r *articleResolver) Title() string {
+	return r.article.Title
+}
+
+func (r *articleResolver) Content() string {
+	return r.article.Content
+}
+
+func (r *articleResolver) CreatedAt() gql.Time {
+	return gql.Time{Time: r.article.CreatedAt.Time()}
+}
+
+func (r *articleResolver) DeleteAt() *gql.Time {
+	if r.article.DeleteAt == 0 {
+		return nil
+	}
+	return &gql.Time{Time: r.article.DeleteAt.Time()}
+}
+
+func (r *articleResolver) Owner(ctx context.Context) (*walletResolver, error) {
+	return loadWallet(ctx, r.loaders, r.article.Owner)
+}
+
+func (r *articleResolver) Blog(ctx context.Context) (*blogResolver, error) {
+	v, err := r.loaders.blogs.load(ctx, r.article.BlogKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot load blog")
+	}
+	return &blogResolver{blog: v.(*blog.Blog), client: r.client, loaders: r.loaders}, nil
+}
+
+type walletResolver struct {
+	address weave.Address
+	coins   []*coin.Coin
+}
+
+// loadWallet returns the wallet of given address, which is empty if the
+// address has no coins.
+func loadWallet(ctx context.Context, l *loaders, addr weave.Address) (*walletResolver, error) {
+	v, err := l.wallets.load(ctx, addr)
+	switch {
+	case errors.ErrNotFound.Is(err):
+		return &walletResolver{address: addr}, nil
+	case err != nil:
+		return nil, errors.Wrap(err, "cannot load wallet")
+	}
+	return &walletResolver{address: addr, coins: v.(*client.WalletResponse).Wallet.Coins}, nil
+}
+
+func (r *walletResolver) Address() string {
+	return r.address.String()
+}
+
+func (r *walletResolver) Coins() []*coinResolver {
+	out := make([]*coinResolver, 0, len(r.coins))
+	for _, c := range r.coins {
+		out = append(out, &coinResolver{coin: c})
+	}
+	return out
+}
+
+type coinResolver struct {
+	coin *coin.Coin
+}
+
+func (r *coinResolver) Ticker() string {
+	return r.coin.Ticker
+}
+
+func (r *coinResolver) Amount() string {
+	return coin.Coin{Whole: r.coin.Whole, Fractional: r.coin.Fractional}.String()
+}
+
+// parseID returns the key of an entity given its decimal ID.
+func parseID(id gql.ID) ([]byte, error) {
+	n, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil || n == 0 {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid ID %q", id)
+	}
+	return seqKey(n), nil
+}
+
+// seqID returns the decimal ID of an entity given its key.
+func seqID(key []byte) gql.ID {
+	if len(key) != 8 {
+		return ""
+	}
+	return gql.ID(strconv.FormatUint(binary.BigEndian.Uint64(key), 10))
+}
+
+// ignoreNotFound returns nil if the error is ErrNotFound, so that missing
+// entities resolve to null.
+func ignoreNotFound(err error) error {
+	if errors.ErrNotFound.Is(err) {
+		return nil
+	}
+	return err
+}
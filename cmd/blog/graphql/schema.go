@@ -0,0 +1,110 @@
+/*
+Package graphql serves the blog state through a GraphQL API, modelling the
+relations between users, blogs, articles and wallets of the blog application.
+
+Entities are read with BlogClient queries. Lookups made while resolving a
+single request are batched and cached, so that resolving the blog of every
+article in a list costs a single query. New articles can be followed with a
+subscription, delivered as Server-Sent Events.
+*/
+package graphql
+
+import (
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+)
+
+// Schema is the GraphQL schema of the API.
+const Schema = `
+schema {
+	query: Query
+	subscription: Subscription
+}
+
+# Time is an RFC 3339 encoded time.
+scalar Time
+
+type Query {
+	# User returns the blog user with given ID, if it exists.
+	user(id: ID!): User
+	# Blog returns the blog with given ID, if it exists.
+	blog(id: ID!): Blog
+	# Article returns the article with given ID, if it exists and is
+	# published. Hidden articles and articles that reached their deletion
+	# time are not published.
+	article(id: ID!): Article
+	# Blogs returns all blogs owned by given address.
+	blogs(owner: String!): [Blog!]!
+	# Wallet returns the wallet of given address.
+	wallet(address: String!): Wallet!
+}
+
+type Subscription {
+	# ArticleCreated delivers articles created after the subscription
+	# started, optionally only those of a single blog.
+	articleCreated(blog: ID): Article!
+}
+
+type User {
+	id: ID!
+	username: String!
+	bio: String!
+	registeredAt: Time!
+}
+
+type Blog {
+	id: ID!
+	title: String!
+	description: String!
+	createdAt: Time!
+	owner: Wallet!
+	# Articles returns a page of the published blog articles ordered by their
+	# creation time, created within the optional [from, to) range. A page can
+	# hold fewer articles than requested when some are not published. Use the
+	# nextCursor of a page as the after argument to get the next one.
+	articles(first: Int = 50, after: String, from: Time, to: Time, newestFirst: Boolean = true): ArticleConnection!
+}
+
+type ArticleConnection {
+	articles: [Article!]!
+	# NextCursor is set if there are more articles.
+	nextCursor: String
+}
+
+type Article {
+	id: ID!
+	title: String!
+	content: String!
+	createdAt: Time!
+	# DeleteAt is the time the article is scheduled to be deleted at.
+	deleteAt: Time
+	owner: Wallet!
+	blog: Blog!
+}
+
+# Wallet holds the coins of an address. An address that never received
+# coins has an empty wallet.
+type Wallet {
+	address: String!
+	coins: [Coin!]!
+}
+
+type Coin {
+	ticker: String!
+	# Amount is a decimal number, for example 1.5.
+	amount: String!
+}
+`
+
+// NewSchema returns the GraphQL schema resolved using given client. New
+// articles are followed with given subscription manager.
+func NewSchema(bc *client.BlogClient, sm *client.SubscriptionManager) (*gql.Schema, error) {
+	r := &Resolver{
+		client:   bc,
+		articles: newArticleFeed(sm),
+	}
+	// Lookups are batched only if they are made at the same time, so allow
+	// all entities of a full page to be resolved concurrently.
+	return gql.ParseSchema(Schema, r, gql.MaxParallelism(blog.MaxPageLimit))
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/iov-one/blog-tutorial/x/blog"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// frontMatterDelimiter is the line that opens and closes a front matter.
+const frontMatterDelimiter = "---"
+
+// articleFrontMatter declares article attributes at the top of an article
+// file. Tags are commonly declared by markdown front matters, but articles
+// cannot be tagged on chain, so they are recognized only to be rejected.
+type articleFrontMatter struct {
+	Title    string      `yaml:"title"`
+	Tags     []string    `yaml:"tags"`
+	DeleteAt interface{} `yaml:"delete_at"`
+}
+
+// articleFromFile returns a copy of given message with the content and the
+// front matter attributes read from the file at given path. Attributes
+// present in the flags set are not overwritten by the front matter.
+func articleFromFile(path string, msg blog.CreateArticleMsg, flags map[string]bool) (*blog.CreateArticleMsg, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read article file: %s", err)
+	}
+	text := string(raw)
+	header, start, end, err := splitFrontMatter(text)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+
+	var fm articleFrontMatter
+	if err := yaml.UnmarshalStrict([]byte(header), &fm); err != nil {
+		return nil, fmt.Errorf("%s: invalid front matter: %s", path, err)
+	}
+	if fm.Title != "" && !flags["title"] {
+		msg.Title = fm.Title
+	}
+	if len(fm.Tags) != 0 {
+		return nil, fmt.Errorf("%s: articles cannot be tagged", path)
+	}
+	if fm.DeleteAt != nil && !flags["delete_at"] {
+		t, err := parseUnixTime(fm.DeleteAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid delete_at: %s", path, err)
+		}
+		msg.DeleteAt = t
+	}
+
+	msg.Content = text[start:end]
+	if i := blog.InvalidTextIndex(msg.Content); i >= 0 {
+		line, column := textPosition(text, start+i)
+		r, _ := utf8.DecodeRuneInString(msg.Content[i:])
+		return nil, fmt.Errorf("%s:%d:%d: character %q is not allowed in the article content", path, line, column, r)
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: given data produce an invalid message: %s", path, err)
+	}
+	return &msg, nil
+}
+
+// splitFrontMatter returns the front matter of given article file, if any,
+// and the offsets of the article content within the text. Blank lines
+// surrounding the content are not part of it.
+func splitFrontMatter(text string) (header string, start, end int, err error) {
+	if firstLine(text) == frontMatterDelimiter {
+		headerStart := strings.IndexByte(text, '\n') + 1
+		closed := false
+		for pos := headerStart; pos > 0 && pos < len(text); {
+			next := strings.IndexByte(text[pos:], '\n')
+			if firstLine(text[pos:]) == frontMatterDelimiter {
+				header = text[headerStart:pos]
+				start = len(text)
+				if next >= 0 {
+					start = pos + next + 1
+				}
+				closed = true
+				break
+			}
+			if next < 0 {
+				break
+			}
+			pos += next + 1
+		}
+		if !closed {
+			return "", 0, 0, errors.New("front matter is not closed")
+		}
+	}
+	content := strings.TrimLeft(text[start:], "\r\n")
+	start = len(text) - len(content)
+	end = start + len(strings.TrimRight(content, "\r\n"))
+	return header, start, end, nil
+}
+
+// firstLine returns the first line of given text without the line ending.
+func firstLine(text string) string {
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		text = text[:i]
+	}
+	return strings.TrimSuffix(text, "\r")
+}
+
+// textPosition returns the line and the column of the character at given
+// byte offset. Both are counted from one.
+func textPosition(text string, offset int) (line, column int) {
+	before := text[:offset]
+	line = strings.Count(before, "\n") + 1
+	column = utf8.RuneCountInString(before[strings.LastIndexByte(before, '\n')+1:]) + 1
+	return line, column
+}
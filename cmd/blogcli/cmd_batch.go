@@ -7,6 +7,7 @@ import (
 	"io"
 
 	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	blogmodel "github.com/iov-one/blog-tutorial/x/blog"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/multisig"
 )
@@ -66,6 +67,12 @@ original transactions (ie signatures) are being dropped.
 					MultisigUpdateMsg: msg,
 				},
 			})
+		case *blogmodel.CreateArticleMsg:
+			batch.Messages = append(batch.Messages, blog.ExecuteBatchMsg_Union{
+				Sum: &blog.ExecuteBatchMsg_Union_BlogCreateArticleMsg{
+					BlogCreateArticleMsg: msg,
+				},
+			})
 		case nil:
 			return errors.New("transaction without a message")
 		default:
@@ -92,6 +99,7 @@ protobuf="
 cash.SendMsg cash_send_msg = 51;
 multisig.CreateMsg multisig_create_msg = 56;
 multisig.UpdateMsg multisig_update_msg = 57;
+blog.CreateArticleMsg blog_create_article_msg = 103;
 "
 
 while read -r m; do
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"path/filepath"
 
 	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
 	"github.com/iov-one/blog-tutorial/x/blog"
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 )
 
 func cmdCreateUser(input io.Reader, output io.Writer, args []string) error {
@@ -111,6 +114,25 @@ func cmdCreateArticle(input io.Reader, output io.Writer, args []string) error {
 	fl.Usage = func() {
 		fmt.Fprintln(flag.CommandLine.Output(), `
 Post an article under a blog.
+
+Instead of providing the content with the -content flag, it can be read from a
+file. The file may start with a YAML front matter, enclosed by lines
+containing only "---", that declares the title and the deletion time of the
+article:
+
+	---
+	title: My first article
+	delete_at: 2030-01-02 15:04
+	---
+	Article content
+
+The content must be a single line of the characters allowed by the chain.
+Disallowed characters are reported with their line and column.
+
+Values given with flags take precedence over those of the front matter.
+
+With -dir, one transaction is created for each *.md file of the directory, in
+file name order. The output can be used as an input of the as-batch command.
 		`)
 		fl.PrintDefaults()
 	}
@@ -119,9 +141,20 @@ Post an article under a blog.
 		titleFl    = fl.String("title", "", "Title of the article")
 		contentFl  = fl.String("content", "", "Content of the article")
 		deleteAtFl = flTime(fl, "delete_at", nil, "Deletion time of the article, format: 2006-01-02 15:04")
+		fileFl     = fl.String("file", "", "Path to a file with the article content and an optional front matter")
+		dirFl      = fl.String("dir", "", "Path to a directory with article files, one transaction is created for each")
 	)
 	fl.Parse(args)
 
+	flags := make(map[string]bool)
+	fl.Visit(func(f *flag.Flag) { flags[f.Name] = true })
+	if flags["file"] && flags["dir"] {
+		return errors.New("-file and -dir cannot be used together")
+	}
+	if flags["content"] && (flags["file"] || flags["dir"]) {
+		return errors.New("-content cannot be used together with -file or -dir")
+	}
+
 	msg := blog.CreateArticleMsg{
 		Metadata: &weave.Metadata{Schema: 1},
 		BlogKey:  *blogKeyFl,
@@ -129,13 +162,49 @@ Post an article under a blog.
 		Content:  *contentFl,
 		DeleteAt: deleteAtFl.UnixTime(),
 	}
-	if err := msg.Validate(); err != nil {
-		return fmt.Errorf("given data produce an invalid message: %s", err)
+
+	var paths []string
+	switch {
+	case *fileFl != "":
+		paths = []string{*fileFl}
+	case *dirFl != "":
+		matches, err := filepath.Glob(filepath.Join(*dirFl, "*.md"))
+		if err != nil {
+			return fmt.Errorf("cannot list article files: %s", err)
+		}
+		if len(matches) == 0 {
+			return fmt.Errorf("no article files found in %q", *dirFl)
+		}
+		paths = matches
+	default:
+		if err := msg.Validate(); err != nil {
+			return fmt.Errorf("given data produce an invalid message: %s", err)
+		}
+		return writeCreateArticleTx(output, &msg)
+	}
+
+	// Build all messages before writing anything, so that an invalid file
+	// does not result in a partial output.
+	msgs := make([]*blog.CreateArticleMsg, 0, len(paths))
+	for _, path := range paths {
+		m, err := articleFromFile(path, msg, flags)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, m)
 	}
+	for _, m := range msgs {
+		if err := writeCreateArticleTx(output, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+func writeCreateArticleTx(output io.Writer, msg *blog.CreateArticleMsg) error {
 	tx := &app.Tx{
 		Sum: &app.Tx_BlogCreateArticleMsg{
-			BlogCreateArticleMsg: &msg,
+			BlogCreateArticleMsg: msg,
 		},
 	}
 	_, err := writeTx(output, tx)
@@ -201,3 +270,338 @@ Cancel a delete article task.
 	_, err := writeTx(output, tx)
 	return err
 }
+
+func cmdHideArticle(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Hide or reveal an article. Only blog moderators can execute this message,
+usually as a governance proposal (see 'as-proposal' command).
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		articleKeyFl = flSeq(fl, "article_key", "", "Identifer of the article")
+		hiddenFl     = fl.Bool("hidden", true, "If false, previously hidden article is revealed")
+	)
+	fl.Parse(args)
+
+	msg := blog.HideArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ArticleKey: *articleKeyFl,
+		Hidden:     *hiddenFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogHideArticleMsg{
+			BlogHideArticleMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUpdateBlogConfiguration(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Update blog extension configuration. Owner of the configuration is the
+moderation authority.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		ownerFl     = flAddress(fl, "owner", "", "Address of the new moderation authority")
+		rateLimitFl = fl.Int("article_rate_limit", 0, "Maximum number of articles a single address can create within the rate limit window")
+		windowFl    = fl.Duration("rate_limit_window", 0, "Length of the article rate limit window, for example 1h")
+	)
+	fl.Parse(args)
+
+	msg := blog.UpdateConfigurationMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Patch: &blog.Configuration{
+			Metadata:         &weave.Metadata{Schema: 1},
+			Owner:            *ownerFl,
+			ArticleRateLimit: int32(*rateLimitFl),
+			RateLimitWindow:  weave.AsUnixDuration(*windowFl),
+		},
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogUpdateConfigurationMsg{
+			BlogUpdateConfigurationMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdCommissionArticle(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Commission an article from a blog owner. Given amount is taken from the signer
+and locked until the blog owner fulfills the commission. If the commission is
+not fulfilled before the timeout, the amount is returned to the signer.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		blogKeyFl     = flSeq(fl, "blog_key", "", "Identifier of the blog that article is commissioned from")
+		amountFl      = flCoin(fl, "amount", "1 IOV", "Price of the commissioned article")
+		descriptionFl = fl.String("description", "", "What the article should be about")
+		timeoutFl     = flTime(fl, "timeout", nil, "Time after which the commission is refunded, format: 2006-01-02 15:04")
+	)
+	fl.Parse(args)
+
+	msg := blog.CommissionArticleMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		BlogKey:     *blogKeyFl,
+		Amount:      []*coin.Coin{amountFl},
+		Description: *descriptionFl,
+		Timeout:     timeoutFl.UnixTime(),
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogCommissionArticleMsg{
+			BlogCommissionArticleMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdFulfillCommission(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Fulfill a commission with an article posted to the commissioned blog. The
+commissioned amount is released to the blog owner.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		commissionKeyFl = flSeq(fl, "commission_key", "", "Identifier of the commission")
+		articleKeyFl    = flSeq(fl, "article_key", "", "Identifier of the article that fulfills the commission")
+	)
+	fl.Parse(args)
+
+	msg := blog.FulfillCommissionMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		CommissionKey: *commissionKeyFl,
+		ArticleKey:    *articleKeyFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogFulfillCommissionMsg{
+			BlogFulfillCommissionMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdPinArticle(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Pin an article so that it is listed before any other article of its blog.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		articleKeyFl = flSeq(fl, "article_key", "", "Identifer of the article")
+	)
+	fl.Parse(args)
+
+	msg := blog.PinArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ArticleKey: *articleKeyFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogPinArticleMsg{
+			BlogPinArticleMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdUnpinArticle(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Unpin a previously pinned article.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		articleKeyFl = flSeq(fl, "article_key", "", "Identifer of the article")
+	)
+	fl.Parse(args)
+
+	msg := blog.UnpinArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ArticleKey: *articleKeyFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogUnpinArticleMsg{
+			BlogUnpinArticleMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdCreateSeries(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a series of articles on a blog.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		blogKeyFl     = flSeq(fl, "blog_key", "", "Identifier of the blog")
+		titleFl       = fl.String("title", "", "Title of the series")
+		descFl        = fl.String("desc", "", "Description of the series")
+		articleKeysFl = flSeqList(fl, "article_keys", "", "Optional, comma separated and ordered list of article identifiers")
+	)
+	fl.Parse(args)
+
+	msg := blog.CreateSeriesMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		BlogKey:     *blogKeyFl,
+		Title:       *titleFl,
+		Description: *descFl,
+		ArticleKeys: *articleKeysFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogCreateSeriesMsg{
+			BlogCreateSeriesMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdAppendSeriesArticle(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Add an article at the end of a series.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		seriesKeyFl  = flSeq(fl, "series_key", "", "Identifier of the series")
+		articleKeyFl = flSeq(fl, "article_key", "", "Identifer of the article")
+	)
+	fl.Parse(args)
+
+	msg := blog.AppendSeriesArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		SeriesKey:  *seriesKeyFl,
+		ArticleKey: *articleKeyFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogAppendSeriesArticleMsg{
+			BlogAppendSeriesArticleMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdReorderSeries(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Change the order of the series articles. All articles of the series must be
+provided.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		seriesKeyFl   = flSeq(fl, "series_key", "", "Identifier of the series")
+		articleKeysFl = flSeqList(fl, "article_keys", "", "Comma separated list of article identifiers in the new order")
+	)
+	fl.Parse(args)
+
+	msg := blog.ReorderSeriesMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		SeriesKey:   *seriesKeyFl,
+		ArticleKeys: *articleKeysFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogReorderSeriesMsg{
+			BlogReorderSeriesMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
+
+func cmdRemoveSeriesArticle(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Remove an article from a series. The article itself is not deleted.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		seriesKeyFl  = flSeq(fl, "series_key", "", "Identifier of the series")
+		articleKeyFl = flSeq(fl, "article_key", "", "Identifer of the article")
+	)
+	fl.Parse(args)
+
+	msg := blog.RemoveSeriesArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		SeriesKey:  *seriesKeyFl,
+		ArticleKey: *articleKeyFl,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &app.Tx{
+		Sum: &app.Tx_BlogRemoveSeriesArticleMsg{
+			BlogRemoveSeriesArticleMsg: &msg,
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
@@ -3,11 +3,16 @@ package main
 import (
 	"bytes"
 	"github.com/iov-one/weave/weavetest"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/iov-one/blog-tutorial/x/blog"
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/weavetest/assert"
 )
 
@@ -119,6 +124,124 @@ func TestCreateArticle(t *testing.T) {
 	assert.Equal(t, weave.AsUnixTime(testT), msg.DeleteAt)
 }
 
+func TestCreateArticleFromFile(t *testing.T) {
+	const post = `---
+title: From file
+delete_at: 2030-01-02 15:04
+---
+
+Content read from a file
+`
+	path := mustCreateFile(t, strings.NewReader(post))
+
+	var output bytes.Buffer
+	args := []string{"-blog_key", "5", "-file", path}
+	if err := cmdCreateArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new article transaction: %s", err)
+	}
+	tx, _, err := readTx(&output)
+	assert.Nil(t, err)
+	msg := tx.GetBlogCreateArticleMsg()
+	assert.Equal(t, weavetest.SequenceID(5), msg.BlogKey)
+	assert.Equal(t, "From file", msg.Title)
+	assert.Equal(t, "Content read from a file", msg.Content)
+	deleteAt := weave.AsUnixTime(time.Date(2030, 1, 2, 15, 4, 0, 0, time.UTC))
+	assert.Equal(t, deleteAt, msg.DeleteAt)
+
+	// Flags take precedence over the front matter.
+	output.Reset()
+	args = []string{"-blog_key", "5", "-file", path, "-title", "From flag"}
+	if err := cmdCreateArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new article transaction: %s", err)
+	}
+	tx, _, err = readTx(&output)
+	assert.Nil(t, err)
+	msg = tx.GetBlogCreateArticleMsg()
+	assert.Equal(t, "From flag", msg.Title)
+	assert.Equal(t, "Content read from a file", msg.Content)
+}
+
+func TestCreateArticleFromFileErrors(t *testing.T) {
+	cases := map[string]struct {
+		post    string
+		wantErr string
+	}{
+		"invalid character": {
+			post:    "---\ntitle: Invalid\n---\nAll good,\n",
+			wantErr: ":4:9: character ',' is not allowed",
+		},
+		"multi line content": {
+			post:    "\nfirst line\nsecond line\n",
+			wantErr: ":2:11: character '\\n' is not allowed",
+		},
+		"content too long": {
+			post:    strings.Repeat("a", 1001),
+			wantErr: "invalid message",
+		},
+		"unknown front matter attribute": {
+			post:    "---\ntitle: Invalid\nauthor: me\n---\nSome content\n",
+			wantErr: "invalid front matter",
+		},
+		"front matter not closed": {
+			post:    "---\ntitle: Invalid\nSome content\n",
+			wantErr: "front matter is not closed",
+		},
+		"tags": {
+			post:    "---\ntitle: Invalid\ntags: [news]\n---\nSome content\n",
+			wantErr: "articles cannot be tagged",
+		},
+	}
+	for testName, tc := range cases {
+		path := mustCreateFile(t, strings.NewReader(tc.post))
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			err := cmdCreateArticle(nil, &output, []string{"-blog_key", "5", "-file", path})
+			if err == nil {
+				t.Fatal("want error")
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("want %q error, got %q", tc.wantErr, err)
+			}
+			assert.Equal(t, 0, output.Len())
+		})
+	}
+}
+
+func TestCreateArticleFromDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "articles")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"b.md":      "---\ntitle: Second\n---\nSecond article\n",
+		"a.md":      "---\ntitle: First\n---\nFirst article\n",
+		"notes.txt": "not an article, file is ignored",
+	}
+	for name, content := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+		assert.Nil(t, err)
+	}
+
+	var output bytes.Buffer
+	if err := cmdCreateArticle(nil, &output, []string{"-blog_key", "5", "-dir", dir}); err != nil {
+		t.Fatalf("cannot create article transactions: %s", err)
+	}
+	for _, title := range []string{"First", "Second"} {
+		tx, _, err := readTx(&output)
+		assert.Nil(t, err)
+		assert.Equal(t, title, tx.GetBlogCreateArticleMsg().Title)
+	}
+	assert.Equal(t, 0, output.Len())
+
+	// Transactions can be combined into a single batch.
+	var batch bytes.Buffer
+	assert.Nil(t, cmdCreateArticle(nil, &output, []string{"-blog_key", "5", "-dir", dir}))
+	assert.Nil(t, cmdAsBatch(&output, &batch, nil))
+	tx, _, err := readTx(&batch)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(tx.GetExecuteBatchMsg().Messages))
+}
+
 func TestDeleteArticle(t *testing.T) {
 	var output bytes.Buffer
 	args := []string{
@@ -164,3 +287,283 @@ func TestCancelDeleteArticleTask(t *testing.T) {
 
 	assert.Equal(t, weavetest.SequenceID(122333), msg.ArticleKey)
 }
+
+func TestHideArticle(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-article_key", "122333",
+		"-hidden=false",
+	}
+	if err := cmdHideArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new hide article transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.HideArticleMsg)
+
+	assert.Equal(t, weavetest.SequenceID(122333), msg.ArticleKey)
+	assert.Equal(t, false, msg.Hidden)
+}
+
+func TestUpdateBlogConfiguration(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-owner", "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0",
+	}
+	if err := cmdUpdateBlogConfiguration(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new update configuration transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.UpdateConfigurationMsg)
+
+	assert.Equal(t, fromHex(t, "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"), []byte(msg.Patch.Owner))
+}
+
+func TestUpdateBlogConfigurationRateLimit(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-article_rate_limit", "5",
+		"-rate_limit_window", "1h",
+	}
+	if err := cmdUpdateBlogConfiguration(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new update configuration transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.UpdateConfigurationMsg)
+
+	assert.Equal(t, 0, len(msg.Patch.Owner))
+	assert.Equal(t, int32(5), msg.Patch.ArticleRateLimit)
+	assert.Equal(t, weave.UnixDuration(3600), msg.Patch.RateLimitWindow)
+}
+
+func TestCommissionArticle(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-blog_key", "122333",
+		"-amount", "5 IOV",
+		"-description", "test description",
+		"-timeout", "2030-01-01 10:00",
+	}
+	if err := cmdCommissionArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new commission article transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.CommissionArticleMsg)
+
+	assert.Equal(t, weavetest.SequenceID(122333), msg.BlogKey)
+	assert.Equal(t, []*coin.Coin{coin.NewCoinp(5, 0, "IOV")}, msg.Amount)
+	assert.Equal(t, "test description", msg.Description)
+
+	testT, _ := time.Parse(flagTimeFormat, "2030-01-01 10:00")
+	assert.Equal(t, weave.AsUnixTime(testT), msg.Timeout)
+}
+
+func TestFulfillCommission(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-commission_key", "3",
+		"-article_key", "122333",
+	}
+	if err := cmdFulfillCommission(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new fulfill commission transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.FulfillCommissionMsg)
+
+	assert.Equal(t, weavetest.SequenceID(3), msg.CommissionKey)
+	assert.Equal(t, weavetest.SequenceID(122333), msg.ArticleKey)
+}
+
+func TestPinArticle(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-article_key", "122333",
+	}
+	if err := cmdPinArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new pin article transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.PinArticleMsg)
+
+	assert.Equal(t, weavetest.SequenceID(122333), msg.ArticleKey)
+}
+
+func TestUnpinArticle(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-article_key", "122333",
+	}
+	if err := cmdUnpinArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new unpin article transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.UnpinArticleMsg)
+
+	assert.Equal(t, weavetest.SequenceID(122333), msg.ArticleKey)
+}
+
+func TestCreateSeries(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-blog_key", "5",
+		"-title", "Weave tutorial",
+		"-desc", "All you need to know",
+		"-article_keys", "3,1",
+	}
+	if err := cmdCreateSeries(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new create series transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.CreateSeriesMsg)
+
+	assert.Equal(t, weavetest.SequenceID(5), msg.BlogKey)
+	assert.Equal(t, "Weave tutorial", msg.Title)
+	assert.Equal(t, "All you need to know", msg.Description)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(3), weavetest.SequenceID(1)}, msg.ArticleKeys)
+}
+
+func TestAppendSeriesArticle(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-series_key", "2",
+		"-article_key", "122333",
+	}
+	if err := cmdAppendSeriesArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new append series article transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.AppendSeriesArticleMsg)
+
+	assert.Equal(t, weavetest.SequenceID(2), msg.SeriesKey)
+	assert.Equal(t, weavetest.SequenceID(122333), msg.ArticleKey)
+}
+
+func TestReorderSeries(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-series_key", "2",
+		"-article_keys", "4,1,7",
+	}
+	if err := cmdReorderSeries(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new reorder series transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.ReorderSeriesMsg)
+
+	assert.Equal(t, weavetest.SequenceID(2), msg.SeriesKey)
+	assert.Equal(t, [][]byte{weavetest.SequenceID(4), weavetest.SequenceID(1), weavetest.SequenceID(7)}, msg.ArticleKeys)
+}
+
+func TestRemoveSeriesArticle(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-series_key", "2",
+		"-article_key", "122333",
+	}
+	if err := cmdRemoveSeriesArticle(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new remove series article transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*blog.RemoveSeriesArticleMsg)
+
+	assert.Equal(t, weavetest.SequenceID(2), msg.SeriesKey)
+	assert.Equal(t, weavetest.SequenceID(122333), msg.ArticleKey)
+}
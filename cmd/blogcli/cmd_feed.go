@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/cmd/blog/feed"
+	"github.com/iov-one/blog-tutorial/x/blog"
+)
+
+func cmdFeed(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Print the RSS 2.0 or Atom feed of a blog. Hidden articles and articles past
+their deletion time are not published. Articles deleted with a transaction are
+reported as Atom tombstones.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BLOGCLI_TM_ADDR", "https://blog.NETWORK:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
+		blogFl   = flSeq(fl, "blog", "", "ID of the blog.")
+		formatFl = fl.String("format", "rss", "Feed format, either rss or atom.")
+		nFl      = fl.Int("n", blog.DefaultPageLimit, fmt.Sprintf("Maximum number of articles in the feed, at most %d.", blog.MaxPageLimit))
+		linkFl   = fl.String("link", "", "Optional URL of the blog website. Article links are built by appending /articles/{id} to it.")
+	)
+	fl.Parse(args)
+
+	if len(*blogFl) == 0 {
+		return errors.New("blog ID is required")
+	}
+	if *formatFl != "rss" && *formatFl != "atom" {
+		return fmt.Errorf("format must be rss or atom, got %q", *formatFl)
+	}
+	if *nFl <= 0 || *nFl > blog.MaxPageLimit {
+		return fmt.Errorf("n must be between 1 and %d", blog.MaxPageLimit)
+	}
+
+	bc := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
+	f, err := feed.Load(bc, *blogFl, *nFl)
+	if err != nil {
+		return fmt.Errorf("cannot load feed: %s", err)
+	}
+	f.Link = strings.TrimSuffix(*linkFl, "/")
+	if *formatFl == "atom" {
+		return f.WriteAtom(output)
+	}
+	return f.WriteRSS(output)
+}
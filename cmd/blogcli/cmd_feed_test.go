@@ -0,0 +1,28 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCmdFeedInvalidArguments(t *testing.T) {
+	cases := map[string][]string{
+		"missing blog":   {},
+		"unknown format": {"-blog", "1", "-format", "json"},
+		"zero articles":  {"-blog", "1", "-n", "0"},
+		"too many":       {"-blog", "1", "-n", "1000"},
+	}
+	for testName, args := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			err := cmdFeed(strings.NewReader(""), &output, append([]string{"-tm", "http://localhost:1"}, args...))
+			if err == nil {
+				t.Fatal("want error")
+			}
+			if output.Len() != 0 {
+				t.Fatalf("unexpected output: %s", output.String())
+			}
+		})
+	}
+}
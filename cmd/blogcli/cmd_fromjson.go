@@ -0,0 +1,406 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto/bech32"
+	yaml "gopkg.in/yaml.v2"
+)
+
+func cmdFromJSON(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read JSON or YAML representation of transactions from standard input and write
+them out in binary format. This command is the opposite of the view command
+and accepts its output.
+
+Input that starts with an opening brace is decoded as a stream of JSON
+documents. Otherwise it is decoded as a stream of YAML documents, separated by
+a "---" line. Both formats use the same attribute names as the view command
+output. In addition to the formats used by the view command:
+  - an address can be provided in bech32 format,
+  - time can be provided as "2006-01-02 15:04" or in RFC3339 format,
+  - a sequence ID, i.e. a blog key, can be provided as a decimal number,
+  - a coin can be provided in "<whole>[.<fractional>] <ticker>" format.
+
+Message of every transaction is validated. Attribute names are checked, so
+that a typo results in an error instead of an ignored value.
+
+For example:
+
+  Sum:
+    CashSendMsg:
+      metadata: {schema: 1}
+      source: blog1h7rpratsyt7mylq79pakjfdzg839zzqdseggqq
+      destination: E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0
+      amount: 10 BLOG
+      memo: Thanks for the article
+`)
+		fl.PrintDefaults()
+	}
+	fl.Parse(args)
+
+	br := bufio.NewReader(input)
+	next, err := documentDecoder(br)
+	if err != nil {
+		return err
+	}
+	for i := 0; ; i++ {
+		doc, err := next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("cannot decode document #%d: %s", i, err)
+		}
+		var tx blog.Tx
+		if err := decodeDocument(doc, reflect.ValueOf(&tx).Elem(), ""); err != nil {
+			return fmt.Errorf("invalid transaction #%d: %s", i, err)
+		}
+		msg, err := tx.GetMsg()
+		if err != nil {
+			return fmt.Errorf("invalid transaction #%d: cannot extract message: %s", i, err)
+		}
+		if err := msg.Validate(); err != nil {
+			return fmt.Errorf("invalid transaction #%d: %s", i, err)
+		}
+		if _, err := writeTx(output, &tx); err != nil {
+			return fmt.Errorf("cannot write transaction #%d: %s", i, err)
+		}
+	}
+}
+
+// documentDecoder returns a function that decodes the next JSON or YAML
+// document into a generic representation, depending on the input format.
+// io.EOF is returned when there are no more documents.
+func documentDecoder(br *bufio.Reader) (func() (interface{}, error), error) {
+	// Skip leading whitespaces in order to recognize the format.
+	for {
+		r, _, err := br.ReadRune()
+		if err == io.EOF {
+			return func() (interface{}, error) { return nil, io.EOF }, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read input: %s", err)
+		}
+		if !strings.ContainsRune(" \t\r\n", r) {
+			_ = br.UnreadRune()
+			break
+		}
+	}
+	head, err := br.Peek(1)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read input: %s", err)
+	}
+
+	if head[0] == '{' {
+		dec := json.NewDecoder(br)
+		dec.UseNumber()
+		return func() (interface{}, error) {
+			var doc interface{}
+			err := dec.Decode(&doc)
+			return doc, err
+		}, nil
+	}
+
+	dec := yaml.NewDecoder(br)
+	return func() (interface{}, error) {
+		for {
+			var doc interface{}
+			if err := dec.Decode(&doc); err != nil {
+				return nil, err
+			}
+			// An empty document, i.e. a trailing separator, is skipped.
+			if doc != nil {
+				return normalizeYAML(doc), nil
+			}
+		}
+	}, nil
+}
+
+// normalizeYAML converts maps returned by the YAML decoder into string keyed
+// maps, as returned by the JSON decoder.
+func normalizeYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			m[fmt.Sprint(key)] = normalizeYAML(val)
+		}
+		return m
+	case []interface{}:
+		for i, val := range v {
+			v[i] = normalizeYAML(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+var (
+	addressType     = reflect.TypeOf(weave.Address(nil))
+	unixTimeType    = reflect.TypeOf(weave.UnixTime(0))
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// decodeDocument sets dst to the value of the generic representation of a
+// JSON or YAML document. JSON attribute names of struct fields are used. A
+// protobuf oneof field is represented as an object with a single attribute
+// named after the chosen wrapper field, as serialized by the encoding/json
+// package.
+//
+// Path is the location of the value within the document, used to describe
+// errors.
+func decodeDocument(doc interface{}, dst reflect.Value, path string) error {
+	if doc == nil {
+		return nil
+	}
+
+	switch dst.Type() {
+	case addressType:
+		s, ok := doc.(string)
+		if !ok {
+			return fmt.Errorf("%s: address must be a string", pathName(path))
+		}
+		a, err := parseAddress(s)
+		if err != nil {
+			return fmt.Errorf("%s: %s", pathName(path), err)
+		}
+		dst.Set(reflect.ValueOf(a))
+		return nil
+	case unixTimeType:
+		t, err := parseUnixTime(doc)
+		if err != nil {
+			return fmt.Errorf("%s: %s", pathName(path), err)
+		}
+		dst.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	if dst.CanAddr() && dst.Addr().Type().Implements(unmarshalerType) {
+		raw, err := json.Marshal(doc)
+		if err != nil {
+			return fmt.Errorf("%s: %s", pathName(path), err)
+		}
+		if err := dst.Addr().Interface().(json.Unmarshaler).UnmarshalJSON(raw); err != nil {
+			return fmt.Errorf("%s: %s", pathName(path), err)
+		}
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Ptr:
+		val := reflect.New(dst.Type().Elem())
+		if err := decodeDocument(doc, val.Elem(), path); err != nil {
+			return err
+		}
+		dst.Set(val)
+		return nil
+	case reflect.Struct:
+		obj, ok := doc.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: must be an object", pathName(path))
+		}
+		return decodeStruct(obj, dst, path)
+	case reflect.Slice:
+		if dst.Type().Elem().Kind() == reflect.Uint8 {
+			b, err := parseBytes(doc)
+			if err != nil {
+				return fmt.Errorf("%s: %s", pathName(path), err)
+			}
+			dst.SetBytes(b)
+			return nil
+		}
+		list, ok := doc.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: must be a list", pathName(path))
+		}
+		val := reflect.MakeSlice(dst.Type(), len(list), len(list))
+		for i, item := range list {
+			if err := decodeDocument(item, val.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+		dst.Set(val)
+		return nil
+	}
+
+	// Any other value has the same representation as used by the
+	// encoding/json package.
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("%s: %s", pathName(path), err)
+	}
+	if err := json.Unmarshal(raw, dst.Addr().Interface()); err != nil {
+		return fmt.Errorf("%s: %s", pathName(path), err)
+	}
+	return nil
+}
+
+func decodeStruct(obj map[string]interface{}, dst reflect.Value, path string) error {
+	t := dst.Type()
+	fields := make(map[string]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if strings.HasPrefix(f.Name, "XXX_") || f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag := strings.Split(f.Tag.Get("json"), ",")[0]; tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+		fields[name] = i
+	}
+
+	for name, val := range obj {
+		i, ok := fields[name]
+		if !ok {
+			return fmt.Errorf("%s: unknown attribute %q", pathName(path), name)
+		}
+		fieldPath := strings.TrimPrefix(path+"."+name, ".")
+		if _, ok := t.Field(i).Tag.Lookup("protobuf_oneof"); ok {
+			if err := decodeOneof(val, dst, i, fieldPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := decodeDocument(val, dst.Field(i), fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeOneof sets the oneof field of given struct. The struct must be a
+// protobuf message that declares the oneof wrapper types.
+func decodeOneof(doc interface{}, dst reflect.Value, field int, path string) error {
+	if doc == nil {
+		return nil
+	}
+	obj, ok := doc.(map[string]interface{})
+	if !ok || len(obj) != 1 {
+		return fmt.Errorf("%s: must be an object with a single attribute", pathName(path))
+	}
+	var (
+		name string
+		val  interface{}
+	)
+	for k, v := range obj {
+		name, val = k, v
+	}
+
+	oneof, ok := dst.Addr().Interface().(interface {
+		XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{})
+	})
+	if !ok {
+		return fmt.Errorf("%s: %s does not declare oneof types", pathName(path), dst.Type())
+	}
+	_, _, _, wrappers := oneof.XXX_OneofFuncs()
+
+	fieldType := dst.Type().Field(field).Type
+	for _, w := range wrappers {
+		wt := reflect.TypeOf(w)
+		if !wt.Implements(fieldType) || wt.Kind() != reflect.Ptr || wt.Elem().NumField() != 1 {
+			continue
+		}
+		if wt.Elem().Field(0).Name != name {
+			continue
+		}
+		wrapper := reflect.New(wt.Elem())
+		if err := decodeDocument(val, wrapper.Elem().Field(0), path+"."+name); err != nil {
+			return err
+		}
+		dst.Field(field).Set(wrapper)
+		return nil
+	}
+	return fmt.Errorf("%s: unknown type %q", pathName(path), name)
+}
+
+// parseAddress accepts any address format supported by weave.ParseAddress
+// and additionally the bech32 format, for example as printed by the keyaddr
+// command.
+func parseAddress(s string) (weave.Address, error) {
+	a, err := weave.ParseAddress(s)
+	if err == nil {
+		return a, nil
+	}
+	if _, payload, berr := bech32.Decode(s); berr == nil {
+		a := weave.Address(payload)
+		if err := a.Validate(); err != nil {
+			return nil, err
+		}
+		return a, nil
+	}
+	return nil, err
+}
+
+// parseUnixTime accepts a unix timestamp or a time in one of the supported
+// human readable formats.
+func parseUnixTime(doc interface{}) (weave.UnixTime, error) {
+	var t time.Time
+	switch v := doc.(type) {
+	case time.Time:
+		t = v
+	case string:
+		var err error
+		if t, err = time.Parse(flagTimeFormat, v); err != nil {
+			if t, err = time.Parse(time.RFC3339, v); err != nil {
+				return 0, fmt.Errorf("invalid time %q, use %q or RFC3339 format", v, flagTimeFormat)
+			}
+		}
+	default:
+		raw, err := json.Marshal(doc)
+		if err != nil {
+			return 0, err
+		}
+		var ut weave.UnixTime
+		if err := json.Unmarshal(raw, &ut); err != nil {
+			return 0, err
+		}
+		return ut, nil
+	}
+	ut := weave.AsUnixTime(t)
+	if err := ut.Validate(); err != nil {
+		return 0, err
+	}
+	return ut, nil
+}
+
+// parseBytes accepts a base64 encoded string, as serialized by the
+// encoding/json package, or a number that is a sequence ID.
+func parseBytes(doc interface{}) ([]byte, error) {
+	switch v := doc.(type) {
+	case string:
+		b, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 value: %s", err)
+		}
+		return b, nil
+	case json.Number, int, int64, uint64:
+		return unpackSequence(fmt.Sprint(v))
+	default:
+		return nil, fmt.Errorf("must be a base64 encoded string or a sequence number")
+	}
+}
+
+func pathName(path string) string {
+	if path == "" {
+		return "document"
+	}
+	return path
+}
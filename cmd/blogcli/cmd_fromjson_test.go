@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCmdFromJSONRoundTrip(t *testing.T) {
+	fixtures := []string{
+		"../../testdata/unsigned_tx.json",
+		"../../testdata/signed_tx.json",
+	}
+	for _, path := range fixtures {
+		t.Run(path, func(t *testing.T) {
+			fixture, err := ioutil.ReadFile(path)
+			assert.Nil(t, err)
+
+			var binary bytes.Buffer
+			assert.Nil(t, cmdFromJSON(bytes.NewReader(fixture), &binary, nil))
+			raw := append([]byte(nil), binary.Bytes()...)
+
+			var view bytes.Buffer
+			assert.Nil(t, cmdTransactionView(&binary, &view, nil))
+			assertSameJSON(t, fixture, view.Bytes())
+
+			// The view output is accepted as well and results in
+			// the same transaction.
+			var again bytes.Buffer
+			assert.Nil(t, cmdFromJSON(&view, &again, nil))
+			assert.Equal(t, raw, again.Bytes())
+		})
+	}
+}
+
+func assertSameJSON(t testing.TB, want, got []byte) {
+	t.Helper()
+	var w, g interface{}
+	assert.Nil(t, json.Unmarshal(want, &w))
+	assert.Nil(t, json.Unmarshal(got, &g))
+	assert.Equal(t, w, g)
+}
+
+func TestCmdFromJSONYAML(t *testing.T) {
+	const input = `
+Sum:
+  CashSendMsg:
+    metadata: {schema: 1}
+    source: blog1h7rpratsyt7mylq79pakjfdzg839zzqdseggqq
+    destination: E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0
+    amount: 10.5 BLOG
+    memo: Thanks for the article
+---
+multisig: [1, 2]
+Sum:
+  BlogCreateArticleMsg:
+    metadata:
+      schema: 1
+    blog_key: 5
+    title: Hello
+    content: Hello world
+    delete_at: 2030-01-02 15:04
+---
+`
+	var output bytes.Buffer
+	assert.Nil(t, cmdFromJSON(strings.NewReader(input), &output, nil))
+
+	send, _, err := readTx(&output)
+	assert.Nil(t, err)
+	msg := send.GetCashSendMsg()
+	assert.Equal(t, "BF8611F57022FDB27C1E287B6925A241E251080D", msg.Source.String())
+	assert.Equal(t, addr, msg.Destination.String())
+	assert.Equal(t, coin.Coin{Whole: 10, Fractional: 500000000, Ticker: "BLOG"}, *msg.Amount)
+	assert.Equal(t, "Thanks for the article", msg.Memo)
+
+	article, _, err := readTx(&output)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{sequenceID(1), sequenceID(2)}, article.Multisig)
+	create := article.GetBlogCreateArticleMsg()
+	assert.Equal(t, sequenceID(5), create.BlogKey)
+	assert.Equal(t, "Hello", create.Title)
+	deleteAt := weave.AsUnixTime(time.Date(2030, 1, 2, 15, 4, 0, 0, time.UTC))
+	assert.Equal(t, deleteAt, create.DeleteAt)
+
+	assert.Equal(t, 0, output.Len())
+}
+
+func TestCmdFromJSONInvalidInput(t *testing.T) {
+	cases := map[string]string{
+		"unknown attribute": `{"Sum": {"CashSendMsg": {"metadata": {"schema": 1}, "sender": "E28AE9A6EB94FC88B73EB7CBD6B87BF93EB9BEF0"}}}`,
+		"unknown message":   `{"Sum": {"UnknownMsg": {}}}`,
+		"two messages":      `{"Sum": {"BlogCreateUserMsg": {}, "BlogCreateBlogMsg": {}}}`,
+		"invalid address":   "Sum:\n  CashSendMsg:\n    source: blog1invalid\n",
+		"invalid time":      "Sum:\n  BlogCreateArticleMsg:\n    delete_at: tomorrow\n",
+		"invalid message":   `{"Sum": {"BlogCreateUserMsg": {"metadata": {"schema": 1}}}}`,
+		"no message":        `{"multisig": ["AAAAAAAAAAE="]}`,
+		"not a document":    `{"Sum": `,
+	}
+	for testName, input := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			if err := cmdFromJSON(strings.NewReader(input), &output, nil); err == nil {
+				t.Fatal("want error")
+			}
+			if _, _, err := readTx(&output); err == nil {
+				t.Fatal("want no transaction written")
+			}
+		})
+	}
+}
+
+// Ensure the decoder can represent every message that a transaction can
+// contain, so that any output of the view command is accepted.
+func TestCmdFromJSONAllMessages(t *testing.T) {
+	_, _, _, wrappers := (&blog.Tx{}).XXX_OneofFuncs()
+	for _, w := range wrappers {
+		// Wrappers are nil pointers, create one with an empty message.
+		wrapper := reflect.New(reflect.TypeOf(w).Elem())
+		msg := wrapper.Elem().Field(0)
+		msg.Set(reflect.New(msg.Type().Elem()))
+
+		var tx blog.Tx
+		reflect.ValueOf(&tx).Elem().FieldByName("Sum").Set(wrapper)
+		raw, err := json.Marshal(&tx)
+		assert.Nil(t, err)
+		var doc interface{}
+		assert.Nil(t, json.Unmarshal(raw, &doc))
+
+		var decoded blog.Tx
+		assert.Nil(t, decodeDocument(doc, reflect.ValueOf(&decoded).Elem(), ""))
+		assert.Equal(t, tx, decoded)
+	}
+}
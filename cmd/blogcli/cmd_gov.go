@@ -0,0 +1,398 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/x/gov"
+)
+
+func cmdAsProposal(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Read a transaction from the stdin and extract message from it. create a
+proposal transaction for that message. All attributes of the original
+transaction (ie signatures) are being dropped.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		titleFl = fl.String("title", "Blog moderation", "The proposal title.")
+		descFl  = fl.String("description", "Blog moderation", "The proposal description.")
+		startFl = flTime(fl, "start", inOneHour, "Start time as 'YYYY-MM-DD HH:MM' in UTC. If not provided, an arbitrary time in the future is used.")
+		eRuleFl = flSeq(fl, "electionrule", "", "The ID of the election rule to be used.")
+	)
+	fl.Parse(args)
+
+	msg, err := readProposalPayloadMsg(input)
+	if err != nil {
+		return err
+	}
+
+	// We must manually assign the message to the right attribute according
+	// to it's type.
+	//
+	// List of all supported message types can be found in the
+	// cmd/blog/app/codec.proto file.
+	var option app.ProposalOptions
+	switch msg := msg.(type) {
+	case nil:
+		return errors.New("transaction without a message")
+	default:
+		return fmt.Errorf("message type not supported: %T", msg)
+
+	case *app.ExecuteBatchMsg:
+		msgs, err := msg.MsgList()
+		if err != nil {
+			return fmt.Errorf("cannot extract messages: %s", err)
+		}
+		var messages []app.ExecuteProposalBatchMsg_Union
+		for _, m := range msgs {
+			switch m := m.(type) {
+			case *gov.UpdateElectorateMsg:
+				messages = append(messages, app.ExecuteProposalBatchMsg_Union{
+					Sum: &app.ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg{
+						GovUpdateElectorateMsg: m,
+					},
+				})
+			case *gov.UpdateElectionRuleMsg:
+				messages = append(messages, app.ExecuteProposalBatchMsg_Union{
+					Sum: &app.ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg{
+						GovUpdateElectionRuleMsg: m,
+					},
+				})
+			case *gov.CreateTextResolutionMsg:
+				messages = append(messages, app.ExecuteProposalBatchMsg_Union{
+					Sum: &app.ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg{
+						GovCreateTextResolutionMsg: m,
+					},
+				})
+			case *blog.ChangeBlogOwnerMsg:
+				messages = append(messages, app.ExecuteProposalBatchMsg_Union{
+					Sum: &app.ExecuteProposalBatchMsg_Union_BlogChangeBlogOwnerMsg{
+						BlogChangeBlogOwnerMsg: m,
+					},
+				})
+			case *blog.HideArticleMsg:
+				messages = append(messages, app.ExecuteProposalBatchMsg_Union{
+					Sum: &app.ExecuteProposalBatchMsg_Union_BlogHideArticleMsg{
+						BlogHideArticleMsg: m,
+					},
+				})
+			case *blog.UpdateConfigurationMsg:
+				messages = append(messages, app.ExecuteProposalBatchMsg_Union{
+					Sum: &app.ExecuteProposalBatchMsg_Union_BlogUpdateConfigurationMsg{
+						BlogUpdateConfigurationMsg: m,
+					},
+				})
+			default:
+				return fmt.Errorf("batch message type not supported: %T", m)
+			}
+		}
+		option.Option = &app.ProposalOptions_ExecuteProposalBatchMsg{
+			ExecuteProposalBatchMsg: &app.ExecuteProposalBatchMsg{
+				Messages: messages,
+			},
+		}
+	case *gov.UpdateElectorateMsg:
+		option.Option = &app.ProposalOptions_GovUpdateElectorateMsg{
+			GovUpdateElectorateMsg: msg,
+		}
+	case *gov.UpdateElectionRuleMsg:
+		option.Option = &app.ProposalOptions_GovUpdateElectionRuleMsg{
+			GovUpdateElectionRuleMsg: msg,
+		}
+	case *gov.CreateTextResolutionMsg:
+		option.Option = &app.ProposalOptions_GovCreateTextResolutionMsg{
+			GovCreateTextResolutionMsg: msg,
+		}
+	case *blog.ChangeBlogOwnerMsg:
+		option.Option = &app.ProposalOptions_BlogChangeBlogOwnerMsg{
+			BlogChangeBlogOwnerMsg: msg,
+		}
+	case *blog.HideArticleMsg:
+		option.Option = &app.ProposalOptions_BlogHideArticleMsg{
+			BlogHideArticleMsg: msg,
+		}
+	case *blog.UpdateConfigurationMsg:
+		option.Option = &app.ProposalOptions_BlogUpdateConfigurationMsg{
+			BlogUpdateConfigurationMsg: msg,
+		}
+	}
+
+	rawOption, err := option.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize %T option: %s", option, err)
+	}
+
+	propTx := &app.Tx{
+		Sum: &app.Tx_GovCreateProposalMsg{
+			GovCreateProposalMsg: &gov.CreateProposalMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          *titleFl,
+				Description:    *descFl,
+				StartTime:      startFl.UnixTime(),
+				ElectionRuleID: *eRuleFl,
+				RawOption:      rawOption,
+			},
+		},
+	}
+
+	_, err = writeTx(output, propTx)
+	return err
+}
+
+func readProposalPayloadMsg(input io.Reader) (weave.Msg, error) {
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, input); err != nil {
+		return nil, fmt.Errorf("cannot read input data: %s", err)
+	}
+
+	tx, _, err := readTx(bytes.NewReader(buf.Bytes()))
+	if err == nil {
+		return tx.GetMsg()
+	}
+	//  ignore error as this may be due to a non Tx proposal option
+	var msg gov.CreateTextResolutionMsg
+	if err := msg.Unmarshal(buf.Bytes()); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal proposal payload: %s", err)
+	}
+	return &msg, nil
+}
+
+func inOneHour() time.Time {
+	return time.Now().Add(time.Hour)
+}
+
+// cmdDelProposal is the cli command to delete an existing proposal.
+func cmdDelProposal(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Delete an existing proposal before the voting period has started.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		id = flSeq(fl, "proposal-id", "", "The ID of the proposal that is to be deleted.")
+	)
+	fl.Parse(args)
+	if len(*id) == 0 {
+		flagDie("the id must not be empty")
+	}
+	govTx := &app.Tx{
+		Sum: &app.Tx_GovDeleteProposalMsg{
+			GovDeleteProposalMsg: &gov.DeleteProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: []byte(*id),
+			},
+		},
+	}
+
+	_, err := writeTx(output, govTx)
+	return err
+}
+
+var supportedVoteOptions = map[string]gov.VoteOption{
+	"yes":     gov.VoteOption_Yes,
+	"no":      gov.VoteOption_No,
+	"abstain": gov.VoteOption_Abstain,
+}
+
+// cmdVote is the cli command create a vote for a proposal
+func cmdVote(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Vote on a governance proposal.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		id         = flSeq(fl, "proposal-id", "", "The ID of the proposal to vote for.")
+		voterFl    = flHex(fl, "voter", "", "Optional address of a voter. If not provided the main signer will be used.")
+		selectedFl = fl.String("select", "", "Supported options are: yes, no, abstain")
+	)
+	fl.Parse(args)
+	if len(*id) == 0 {
+		flagDie("the proposal id  must not be empty")
+	}
+	if len(*voterFl) != 0 {
+		if err := weave.Address(*voterFl).Validate(); err != nil {
+			flagDie("invalid voter address: %q", err)
+		}
+	}
+
+	selected, ok := supportedVoteOptions[*selectedFl]
+	if !ok {
+		flagDie("unsupported vote option: %q", *selectedFl)
+	}
+	govTx := &app.Tx{
+		Sum: &app.Tx_GovVoteMsg{
+			GovVoteMsg: &gov.VoteMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: []byte(*id),
+				Voter:      weave.Address(*voterFl),
+				Selected:   selected,
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
+
+func cmdTextResolution(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Text resolution creates a human readable gov proposal payload. To be used with 'as-proposal' command.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		textFl = fl.String("text", "", "Human readable resolution text")
+	)
+	fl.Parse(args)
+	if len(*textFl) == 0 {
+		flagDie("the text must not be empty")
+	}
+	msg := &gov.CreateTextResolutionMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Resolution: *textFl,
+	}
+	data, err := msg.Marshal()
+	if err != nil {
+		return fmt.Errorf("can not serialize msg: %s", err)
+	}
+
+	_, err = output.Write(data)
+	return err
+}
+
+func cmdUpdateElectorate(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Electorate creates a new version for an existing electorate. - new version is used for new proposals.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		id = flSeq(fl, "id", "", "The ID of the electorate")
+	)
+	fl.Parse(args)
+	if len(*id) == 0 {
+		flagDie("the electorate id  must not be empty")
+	}
+
+	govTx := &app.Tx{
+		Sum: &app.Tx_GovUpdateElectorateMsg{
+			GovUpdateElectorateMsg: &gov.UpdateElectorateMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: []byte(*id),
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
+
+func cmdWithElector(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Reads a transaction from the input and attaches the provided elector address, weight pair.
+		`)
+		fl.PrintDefaults()
+	}
+
+	var (
+		addressFl = flAddress(fl, "address", "", "Electors address")
+		weightFl  = fl.Uint("weight", 1, "Electors weight")
+	)
+	fl.Parse(args)
+
+	if len(*addressFl) == 0 {
+		flagDie("address must not be empty")
+	}
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read input transaction: %s", err)
+	}
+
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return fmt.Errorf("cannot extract transaction message: %s", err)
+	}
+
+	switch msg := msg.(type) {
+	case *gov.UpdateElectorateMsg:
+		msg.DiffElectors = append(msg.DiffElectors, gov.Elector{
+			Address: *addressFl,
+			Weight:  uint32(*weightFl),
+		})
+	default:
+		return fmt.Errorf("message %T cannot be modified to contain an elector", msg)
+	}
+
+	_, err = writeTx(output, tx)
+	return err
+}
+
+func cmdUpdateElectionRule(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Creates a new version for an existing election rule. The new version is used for new proposals.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		id            = flSeq(fl, "id", "", "The ID of the election rule")
+		durationFl    = fl.Int("voting-period", 0, "Duration in seconds how long the voting period will take place")
+		numeratorFl   = fl.Int("threshold-numerator", 0, "The top number of the fraction.")
+		denominatorFl = fl.Uint("threshold-denominator", 0, "The bottom number of the fraction")
+		quorumFl      = flFraction(fl, "quorum", "", "New quorum fraction in format <numerator>/<denominator>. Zero quorum deletes the value.")
+	)
+	fl.Parse(args)
+	if len(*id) == 0 {
+		flagDie("the electorate id  must not be empty")
+	}
+	if *durationFl == 0 {
+		flagDie("the duration must not be empty")
+	}
+
+	fraction := gov.Fraction{Numerator: uint32(*numeratorFl), Denominator: uint32(*denominatorFl)}
+	if err := fraction.Validate(); err != nil {
+		flagDie("invalid threshold: %s", err)
+	}
+
+	var quorum *gov.Fraction
+	if frac := quorumFl.Fraction(); frac != nil {
+		// If fraction value was provided, set it.
+		quorum = frac
+	}
+
+	govTx := &app.Tx{
+		Sum: &app.Tx_GovUpdateElectionRuleMsg{
+			GovUpdateElectionRuleMsg: &gov.UpdateElectionRuleMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				ElectionRuleID: []byte(*id),
+				VotingPeriod:   weave.AsUnixDuration(time.Duration(*durationFl) * time.Second),
+				Threshold:      fraction,
+				Quorum:         quorum,
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
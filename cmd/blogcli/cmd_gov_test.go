@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/gov"
+)
+
+func TestAsProposal(t *testing.T) {
+	var hideArticle bytes.Buffer
+	args := []string{
+		"-article_key", "5",
+	}
+	if err := cmdHideArticle(nil, &hideArticle, args); err != nil {
+		t.Fatalf("cannot create a hide article transaction: %s", err)
+	}
+
+	var output bytes.Buffer
+	args = []string{
+		"-title", "hide spam",
+		"-electionrule", "1",
+	}
+	if err := cmdAsProposal(&hideArticle, &output, args); err != nil {
+		t.Fatalf("cannot create a proposal transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.CreateProposalMsg)
+
+	assert.Equal(t, "hide spam", msg.Title)
+	assert.Equal(t, weavetest.SequenceID(1), msg.ElectionRuleID)
+
+	var options app.ProposalOptions
+	if err := options.Unmarshal(msg.RawOption); err != nil {
+		t.Fatalf("cannot unmarshal proposal options: %s", err)
+	}
+	opt, ok := options.Option.(*app.ProposalOptions_BlogHideArticleMsg)
+	if !ok {
+		t.Fatalf("unexpected proposal option: %T", options.Option)
+	}
+	assert.Equal(t, weavetest.SequenceID(5), opt.BlogHideArticleMsg.ArticleKey)
+	assert.Equal(t, true, opt.BlogHideArticleMsg.Hidden)
+}
+
+func TestVote(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-proposal-id", "3",
+		"-select", "yes",
+	}
+	if err := cmdVote(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a vote transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot unmarshal created transaction: %s", err)
+	}
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.VoteMsg)
+
+	assert.Equal(t, weavetest.SequenceID(3), msg.ProposalID)
+	assert.Equal(t, gov.VoteOption_Yes, msg.Selected)
+}
@@ -1,19 +1,16 @@
 package main
 
 import (
-	"bytes"
 	"crypto/sha256"
-	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
-	"strings"
 
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/crypto/bech32"
-	"github.com/stellar/go/exp/crypto/derivation"
 	"github.com/tyler-smith/go-bip39"
 	"golang.org/x/crypto/ed25519"
 )
@@ -26,13 +23,18 @@ Read mnemonic and generate a new private key.
 
 When successful a new file with binary content containing private key is
 created. This command fails if the private key file already exists.
+
+An optional BIP-39 passphrase can be provided. The same mnemonic used with a
+different passphrase generates a different key, so the passphrase must be
+remembered together with the mnemonic.
 `)
 		fl.PrintDefaults()
 	}
 	var (
 		keyPathFl = fl.String("key", env("BLOGCLI_PRIV_KEY", os.Getenv("HOME")+"/.blog.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BLOGCLI_PRIV_KEY environment variable to set it.")
-		pathFl = fl.String("path", "m/44'/988'/0'", "Derivation path as described in BIP-44.")
+		pathFl       = fl.String("path", client.AccountPath(0), "Derivation path as described in BIP-44.")
+		passphraseFl = flBIP39Passphrase(fl)
 	)
 	fl.Parse(args)
 
@@ -48,7 +50,7 @@ created. This command fails if the private key file already exists.
 		return fmt.Errorf("cannot read mnemonic: %s", err)
 	}
 
-	priv, err := keygen(string(mnemonic), *pathFl)
+	priv, err := keygen(string(mnemonic), *passphraseFl, *pathFl)
 	if err != nil {
 		return fmt.Errorf("cannot generate key: %s", err)
 	}
@@ -68,51 +70,18 @@ created. This command fails if the private key file already exists.
 	return nil
 }
 
-// keygen returns a private key generated using given mnemonic and derivation
-// path.
-func keygen(mnemonic, derivationPath string) (ed25519.PrivateKey, error) {
-	if err := validateMnemonic(string(mnemonic)); err != nil {
-		return nil, fmt.Errorf("invalid mnemonic: %s", err)
-	}
-
-	// We do not allow for passphrase.
-	seed := bip39.NewSeed(string(mnemonic), "")
-
-	key, err := derivation.DeriveForPath(derivationPath, seed)
+// keygen returns a private key generated using given mnemonic, BIP-39
+// passphrase and derivation path.
+func keygen(mnemonic, passphrase, derivationPath string) (ed25519.PrivateKey, error) {
+	w, err := client.NewHDWallet(mnemonic, passphrase)
 	if err != nil {
-		return nil, fmt.Errorf("cannot deriviate master key from seed: %s", err)
+		return nil, err
 	}
-
-	_, priv, err := ed25519.GenerateKey(bytes.NewReader(key.Key))
+	key, err := w.Derive(derivationPath)
 	if err != nil {
-		return nil, fmt.Errorf("cannot generate ed25519 private key: %s", err)
+		return nil, err
 	}
-	return priv, nil
-}
-
-// isMnemonicValid returns true if given mnemonic string is valid. Whitespaces
-// are relevant.
-//
-// Use this instead of bip39.IsMnemonicValid because this function ensures the
-// checksum consistency. bip39.IsMnemonicValid does not test the checksum. It
-// also ignores whitespaces.
-//
-// This function ensures that the mnemonic is a single space separated list of
-// words as this is important during seed creation.
-func validateMnemonic(mnemonic string) error {
-	// A lazy way to check that words are exactly single space separated.
-	expected := strings.Join(strings.Fields(mnemonic), " ")
-	if mnemonic != expected {
-		return errors.New("whitespace violation")
-	}
-
-	// Entropy generation does base validation of checking if words are
-	// valid and in the right amount. It also tests the checksum.
-	if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
-		return fmt.Errorf("entropy: %s", err)
-	}
-
-	return nil
+	return key.GetEd25519(), nil
 }
 
 func cmdKeyaddr(input io.Reader, output io.Writer, args []string) error {
@@ -120,6 +89,10 @@ func cmdKeyaddr(input io.Reader, output io.Writer, args []string) error {
 	fl.Usage = func() {
 		fmt.Fprint(flag.CommandLine.Output(), `
 Print out a hex-address associated with your private key.
+
+If -accounts is provided, read mnemonic instead and print out the derivation
+path, bech32 and hex-addresses of that many sequential accounts, starting
+with the account derived at m/44'/988'/0'.
 `)
 		fl.PrintDefaults()
 	}
@@ -127,9 +100,36 @@ Print out a hex-address associated with your private key.
 		keyPathFl = fl.String("key", env("BLOGCLI_PRIV_KEY", os.Getenv("HOME")+"/.blog.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BLOGCLI_PRIV_KEY environment variable to set it.")
 		bechPrefixFl = fl.String("bp", "custm", "Bech32 prefix.")
+		accountsFl   = fl.Uint("accounts", 0, "Number of accounts to derive from mnemonic read from the input.")
+		firstFl      = fl.Uint("first", 0, "Index of the first account derived when -accounts is used.")
+		passphraseFl = flBIP39Passphrase(fl)
 	)
 	fl.Parse(args)
 
+	if *accountsFl > 0 {
+		mnemonic, err := readInput(input)
+		if err != nil {
+			return fmt.Errorf("cannot read mnemonic: %s", err)
+		}
+		w, err := client.NewHDWallet(string(mnemonic), *passphraseFl)
+		if err != nil {
+			return err
+		}
+		keys, err := w.Accounts(uint32(*firstFl), uint32(*accountsFl))
+		if err != nil {
+			return fmt.Errorf("cannot derive accounts: %s", err)
+		}
+		for i, key := range keys {
+			bech, err := toBech32(*bechPrefixFl, key.PublicKey().GetEd25519())
+			if err != nil {
+				return fmt.Errorf("cannot generate bech32 address format: %s", err)
+			}
+			path := client.AccountPath(uint32(*firstFl) + uint32(i))
+			fmt.Fprintf(output, "%s\t%s\t%s\n", path, bech, key.PublicKey().Address())
+		}
+		return nil
+	}
+
 	raw, err := ioutil.ReadFile(*keyPathFl)
 	if err != nil {
 		return fmt.Errorf("cannot read private key file: %s", err)
@@ -155,6 +155,13 @@ Print out a hex-address associated with your private key.
 	return nil
 }
 
+// flBIP39Passphrase registers the flag of the BIP-39 passphrase used
+// together with a mnemonic.
+func flBIP39Passphrase(fl *flag.FlagSet) *string {
+	return fl.String("bip39-passphrase", env("BLOGCLI_BIP39_PASSPHRASE", ""),
+		"Optional BIP-39 passphrase used together with the mnemonic. You can use BLOGCLI_BIP39_PASSPHRASE environment variable to set it.")
+}
+
 // toBech32 computes the bech32 address representation as described in
 // https://github.com/iov-one/iov-core/blob/8846fed17443766a9ad9c908c3d7fc9d205e02ef/docs/address-derivation-v1.md#deriving-addresses-from-keypairs
 func toBech32(prefix string, pubkey []byte) ([]byte, error) {
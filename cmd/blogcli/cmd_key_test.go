@@ -1,25 +1,31 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/iov-one/weave/weavetest/assert"
 	"golang.org/x/crypto/ed25519"
 )
 
-func TestKeygen(t *testing.T) {
-	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+const keygenMnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
 
-	cases := map[string]string{
-		"m/44'/988'/0'": "BLOG1h7rpratsyt7mylq79pakjfdzg839zzqdzzmv8l",
-		"m/44'/988'/1'": "BLOG13ss78hz4epq88putspwmf5ks9m7g8cv2wdqqut",
-		"m/44'/988'/2'": "BLOG160jdxeaxyfjrqcfmf5cdzpujyz25nphtpm63rp",
-		"m/44'/988'/3'": "BLOG1x3wpuhvrm5vvex38k43csxdjs7l4n3gwjpt08w",
-		"m/44'/988'/4'": "BLOG1fm8wddz5mk8xeqcesleysrfd5pg9wg0xh5wj2v",
-	}
+// keygenAddresses are the bech32 addresses of keys derived from
+// keygenMnemonic without a passphrase.
+var keygenAddresses = map[string]string{
+	"m/44'/988'/0'": "BLOG1h7rpratsyt7mylq79pakjfdzg839zzqdzzmv8l",
+	"m/44'/988'/1'": "BLOG13ss78hz4epq88putspwmf5ks9m7g8cv2wdqqut",
+	"m/44'/988'/2'": "BLOG160jdxeaxyfjrqcfmf5cdzpujyz25nphtpm63rp",
+	"m/44'/988'/3'": "BLOG1x3wpuhvrm5vvex38k43csxdjs7l4n3gwjpt08w",
+	"m/44'/988'/4'": "BLOG1fm8wddz5mk8xeqcesleysrfd5pg9wg0xh5wj2v",
+}
 
-	for path, bech := range cases {
+func TestKeygen(t *testing.T) {
+	for path, bech := range keygenAddresses {
 		t.Run(path, func(t *testing.T) {
-			priv, err := keygen(mnemonic, path)
+			priv, err := keygen(keygenMnemonic, "", path)
 			if err != nil {
 				t.Fatalf("cannot generate key: %s", err)
 			}
@@ -36,6 +42,43 @@ func TestKeygen(t *testing.T) {
 	}
 }
 
+func TestKeygenPassphrase(t *testing.T) {
+	plain, err := keygen(keygenMnemonic, "", "m/44'/988'/0'")
+	assert.Nil(t, err)
+	withPass, err := keygen(keygenMnemonic, "my passphrase", "m/44'/988'/0'")
+	assert.Nil(t, err)
+	again, err := keygen(keygenMnemonic, "my passphrase", "m/44'/988'/0'")
+	assert.Nil(t, err)
+	assert.Equal(t, withPass, again)
+	assert.Equal(t, false, bytes.Equal(plain, withPass))
+}
+
+func TestKeyaddrAccounts(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{"-accounts", "5", "-bp", "BLOG"}
+	assert.Nil(t, cmdKeyaddr(strings.NewReader(keygenMnemonic), &output, args))
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Equal(t, len(keygenAddresses), len(lines))
+	for i, line := range lines {
+		cols := strings.Split(line, "\t")
+		assert.Equal(t, 3, len(cols))
+		assert.Equal(t, fmt.Sprintf("m/44'/988'/%d'", i), cols[0])
+		assert.Equal(t, keygenAddresses[cols[0]], cols[1])
+	}
+
+	// accounts can start at any index
+	output.Reset()
+	args = []string{"-accounts", "1", "-first", "3", "-bp", "BLOG"}
+	assert.Nil(t, cmdKeyaddr(strings.NewReader(keygenMnemonic), &output, args))
+	assert.Equal(t, true, strings.HasPrefix(output.String(), "m/44'/988'/3'\t"+keygenAddresses["m/44'/988'/3'"]+"\t"))
+
+	// a passphrase derives different accounts
+	output.Reset()
+	args = []string{"-accounts", "1", "-bp", "BLOG", "-bip39-passphrase", "my passphrase"}
+	assert.Nil(t, cmdKeyaddr(strings.NewReader(keygenMnemonic), &output, args))
+	assert.Equal(t, false, strings.Contains(output.String(), keygenAddresses["m/44'/988'/0'"]))
+}
+
 func TestMnemonic(t *testing.T) {
 	cases := map[string]struct {
 		mnemonic string
@@ -94,7 +137,7 @@ func TestMnemonic(t *testing.T) {
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
-			_, err := keygen(tc.mnemonic, "m/44'/988'/0'")
+			_, err := keygen(tc.mnemonic, "", "m/44'/988'/0'")
 			if hasErr := err != nil; hasErr != tc.wantErr {
 				t.Fatalf("returned erorr value: %+v", err)
 			}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/weave/crypto"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// keyCommands are the subcommands of the key command.
+var keyCommands = map[string]func(input io.Reader, output io.Writer, args []string) error{
+	"list":   cmdKeyList,
+	"add":    cmdKeyAdd,
+	"import": cmdKeyImport,
+	"export": cmdKeyExport,
+	"delete": cmdKeyDelete,
+}
+
+func cmdKey(input io.Reader, output io.Writer, args []string) error {
+	var names []string
+	for name := range keyCommands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(args) == 0 || args[0] == "-help" || args[0] == "-h" {
+		fmt.Fprintf(flag.CommandLine.Output(), `
+Manage private keys stored in an encrypted keystore.
+
+Every key is stored under a unique name and encrypted with a passphrase. The
+passphrase is read from the BLOGCLI_PASSPHRASE environment variable if set,
+otherwise it is prompted for.
+
+Usage: key <subcommand> [<flags>]
+
+Available subcommands are:
+	%s
+`, strings.Join(names, "\n\t"))
+		if len(args) == 0 {
+			return errors.New("subcommand is required")
+		}
+		return nil
+	}
+	cmd, ok := keyCommands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown subcommand %q, use one of: %s", args[0], strings.Join(names, ", "))
+	}
+	return cmd(input, output, args[1:])
+}
+
+func cmdKeyList(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Print out the names and hex-addresses of all keys stored in the keystore.
+`)
+		fl.PrintDefaults()
+	}
+	keystoreFl := flKeystore(fl)
+	fl.Parse(args)
+
+	ks, err := client.OpenKeystore(*keystoreFl)
+	if err != nil {
+		return fmt.Errorf("cannot open keystore: %s", err)
+	}
+	for _, name := range ks.Names() {
+		addr, err := ks.Address(name)
+		if err != nil {
+			return fmt.Errorf("cannot get %q key address: %s", name, err)
+		}
+		fmt.Fprintf(output, "%s\t%s\n", name, addr)
+	}
+	return nil
+}
+
+func cmdKeyAdd(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read mnemonic and store the private key generated from it in the keystore.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl   = flKeystore(fl)
+		nameFl       = fl.String("name", "", "Name the key is stored under. Required.")
+		pathFl       = fl.String("path", client.AccountPath(0), "Derivation path as described in BIP-44.")
+		passphraseFl = flBIP39Passphrase(fl)
+	)
+	fl.Parse(args)
+
+	if *nameFl == "" {
+		return errors.New("key name is required")
+	}
+	mnemonic, err := readInput(input)
+	if err != nil {
+		return fmt.Errorf("cannot read mnemonic: %s", err)
+	}
+	priv, err := keygen(string(mnemonic), *passphraseFl, *pathFl)
+	if err != nil {
+		return fmt.Errorf("cannot generate key: %s", err)
+	}
+	key := &crypto.PrivateKey{
+		Priv: &crypto.PrivateKey_Ed25519{Ed25519: priv},
+	}
+	return addToKeystore(*keystoreFl, *nameFl, key)
+}
+
+func cmdKeyImport(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Store in the keystore a private key read from an unencrypted private key file,
+as created by the keygen command.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl = flKeystore(fl)
+		nameFl     = fl.String("name", "", "Name the key is stored under. Required.")
+		keyPathFl  = fl.String("key", env("BLOGCLI_PRIV_KEY", os.Getenv("HOME")+"/.blog.priv.key"),
+			"Path to the private key file to import. You can use BLOGCLI_PRIV_KEY environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	if *nameFl == "" {
+		return errors.New("key name is required")
+	}
+	key, err := decodePrivateKey(*keyPathFl)
+	if err != nil {
+		return fmt.Errorf("cannot load private key: %s", err)
+	}
+	return addToKeystore(*keystoreFl, *nameFl, key)
+}
+
+// addToKeystore stores the key in the keystore, encrypted with a passphrase
+// that is confirmed by the user.
+func addToKeystore(keystorePath, name string, key *crypto.PrivateKey) error {
+	ks, err := client.OpenKeystore(keystorePath)
+	if err != nil {
+		return fmt.Errorf("cannot open keystore: %s", err)
+	}
+	if _, err := ks.Address(name); err == nil {
+		return fmt.Errorf("key %q already exists", name)
+	}
+	passphrase, err := readPassphrase(fmt.Sprintf("Passphrase for %q key: ", name), true)
+	if err != nil {
+		return fmt.Errorf("cannot read passphrase: %s", err)
+	}
+	if err := ks.Add(name, key, passphrase); err != nil {
+		return fmt.Errorf("cannot add key: %s", err)
+	}
+	if err := ks.Save(); err != nil {
+		return fmt.Errorf("cannot save keystore: %s", err)
+	}
+	return nil
+}
+
+func cmdKeyExport(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Write a key stored in the keystore into an unencrypted private key file, as
+created by the keygen command. This command fails if the file already exists.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl = flKeystore(fl)
+		nameFl     = fl.String("name", "", "Name of the key to export. Required.")
+		keyPathFl  = fl.String("key", "", "Path to the private key file to create. Required.")
+	)
+	fl.Parse(args)
+
+	if *nameFl == "" {
+		return errors.New("key name is required")
+	}
+	if *keyPathFl == "" {
+		return errors.New("private key file path is required")
+	}
+	if _, err := os.Stat(*keyPathFl); !os.IsNotExist(err) {
+		return fmt.Errorf("private key file %q already exists, delete this file and try again", *keyPathFl)
+	}
+	key, err := loadKeystoreKey(*keystoreFl, *nameFl)
+	if err != nil {
+		return err
+	}
+	raw := key.GetEd25519()
+	if len(raw) != ed25519.PrivateKeySize {
+		return fmt.Errorf("unsupported private key type")
+	}
+
+	fd, err := os.OpenFile(*keyPathFl, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0400)
+	if err != nil {
+		return fmt.Errorf("cannot create private key file: %s", err)
+	}
+	defer fd.Close()
+	if _, err := fd.Write(raw); err != nil {
+		return fmt.Errorf("cannot write private key: %s", err)
+	}
+	if err := fd.Close(); err != nil {
+		return fmt.Errorf("cannot close private key file: %s", err)
+	}
+	return nil
+}
+
+func cmdKeyDelete(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Remove a key from the keystore. The passphrase of the key is required, to
+prevent removing a key by a mistake.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		keystoreFl = flKeystore(fl)
+		nameFl     = fl.String("name", "", "Name of the key to delete. Required.")
+	)
+	fl.Parse(args)
+
+	if *nameFl == "" {
+		return errors.New("key name is required")
+	}
+	ks, err := client.OpenKeystore(*keystoreFl)
+	if err != nil {
+		return fmt.Errorf("cannot open keystore: %s", err)
+	}
+	if _, err := unlockKey(ks, *nameFl); err != nil {
+		return err
+	}
+	if err := ks.Delete(*nameFl); err != nil {
+		return fmt.Errorf("cannot delete key: %s", err)
+	}
+	if err := ks.Save(); err != nil {
+		return fmt.Errorf("cannot save keystore: %s", err)
+	}
+	return nil
+}
+
+// flKeystore registers the flag of the keystore file path.
+func flKeystore(fl *flag.FlagSet) *string {
+	return fl.String("keystore", env("BLOGCLI_KEYSTORE", os.Getenv("HOME")+"/.blog.keystore"),
+		"Path to the keystore file. You can use BLOGCLI_KEYSTORE environment variable to set it.")
+}
+
+// loadKeystoreKey returns the named key, decrypted with a passphrase
+// provided by the user.
+func loadKeystoreKey(keystorePath, name string) (*crypto.PrivateKey, error) {
+	ks, err := client.OpenKeystore(keystorePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open keystore: %s", err)
+	}
+	return unlockKey(ks, name)
+}
+
+func unlockKey(ks *client.Keystore, name string) (*crypto.PrivateKey, error) {
+	if _, err := ks.Address(name); err != nil {
+		return nil, fmt.Errorf("key %q not found in %s", name, ks.Path())
+	}
+	passphrase, err := readPassphrase(fmt.Sprintf("Passphrase for %q key: ", name), false)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read passphrase: %s", err)
+	}
+	key, err := ks.Get(name, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decrypt %q key: %s", name, err)
+	}
+	return key, nil
+}
+
+// readPassphrase returns the passphrase set in the BLOGCLI_PASSPHRASE
+// environment variable or prompts the user for it. The terminal is used
+// directly, so that the standard input can be used to pipe data. If confirm
+// is true, a prompted passphrase must be entered twice.
+func readPassphrase(prompt string, confirm bool) ([]byte, error) {
+	if p, ok := os.LookupEnv("BLOGCLI_PASSPHRASE"); ok {
+		if p == "" {
+			return nil, errors.New("empty passphrase")
+		}
+		return []byte(p), nil
+	}
+
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		return nil, fmt.Errorf("no terminal to prompt, set BLOGCLI_PASSPHRASE: %s", err)
+	}
+	defer tty.Close()
+
+	passphrase, err := promptPassword(tty, prompt)
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	if confirm {
+		again, err := promptPassword(tty, "Repeat passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(passphrase, again) {
+			return nil, errors.New("passphrases do not match")
+		}
+	}
+	return passphrase, nil
+}
+
+func promptPassword(tty *os.File, prompt string) ([]byte, error) {
+	fmt.Fprint(tty, prompt)
+	defer fmt.Fprintln(tty)
+	return terminal.ReadPassword(int(tty.Fd()))
+}
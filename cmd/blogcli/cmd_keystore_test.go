@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCmdKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	keystore := filepath.Join(dir, "keystore.json")
+
+	defer os.Unsetenv("BLOGCLI_PASSPHRASE")
+	assert.Nil(t, os.Setenv("BLOGCLI_PASSPHRASE", "secret passphrase"))
+
+	run := func(input string, args ...string) (string, error) {
+		var output bytes.Buffer
+		err := cmdKey(strings.NewReader(input), &output, append(args, "-keystore", keystore))
+		return output.String(), err
+	}
+
+	keyPath := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	_, err = run("", "import", "-name", "funded", "-key", keyPath)
+	assert.Nil(t, err)
+	if _, err := run("", "import", "-name", "funded", "-key", keyPath); err == nil {
+		t.Fatal("want duplicated name error")
+	}
+
+	const mnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+	_, err = run(mnemonic, "add", "-name", "derived")
+	assert.Nil(t, err)
+
+	out, err := run("", "list")
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	assert.Equal(t, 2, len(lines))
+	assert.Equal(t, "derived\t", lines[0][:8])
+	assert.Equal(t, "funded\t"+addr, lines[1])
+
+	// keystore keys are not stored in plain text
+	raw, err := ioutil.ReadFile(keystore)
+	assert.Nil(t, err)
+	assert.Equal(t, false, strings.Contains(strings.ToLower(string(raw)), privKeyHex[:32]))
+
+	// a keystore key can be used for signing
+	var input, signed bytes.Buffer
+	_, err = writeTx(&input, sendTx(t, "keystore"))
+	assert.Nil(t, err)
+	signArgs := []string{"-key-name", "funded", "-keystore", keystore, "-offline", "-chain-id", "test-chain", "-nonce", "0"}
+	assert.Nil(t, cmdSignTransaction(&input, &signed, signArgs))
+	tx, _, err := readTx(&signed)
+	assert.Nil(t, err)
+	assert.Equal(t, addr, tx.Signatures[0].Pubkey.Address().String())
+
+	exported := filepath.Join(dir, "exported.key")
+	_, err = run("", "export", "-name", "funded", "-key", exported)
+	assert.Nil(t, err)
+	exportedRaw, err := ioutil.ReadFile(exported)
+	assert.Nil(t, err)
+	assert.Equal(t, fromHex(t, privKeyHex), exportedRaw)
+	if _, err := run("", "export", "-name", "funded", "-key", exported); err == nil {
+		t.Fatal("want existing file error")
+	}
+
+	assert.Nil(t, os.Setenv("BLOGCLI_PASSPHRASE", "wrong passphrase"))
+	if _, err := run("", "delete", "-name", "funded"); err == nil {
+		t.Fatal("want invalid passphrase error")
+	}
+	input.Reset()
+	_, err = writeTx(&input, sendTx(t, "keystore"))
+	assert.Nil(t, err)
+	if err := cmdSignTransaction(&input, &signed, signArgs); err == nil {
+		t.Fatal("want invalid passphrase error")
+	}
+
+	assert.Nil(t, os.Setenv("BLOGCLI_PASSPHRASE", "secret passphrase"))
+	_, err = run("", "delete", "-name", "funded")
+	assert.Nil(t, err)
+	if _, err := run("", "delete", "-name", "funded"); err == nil {
+		t.Fatal("want missing key error")
+	}
+	out, err = run("", "list")
+	assert.Nil(t, err)
+	assert.Equal(t, "derived", strings.SplitN(out, "\t", 2)[0])
+
+	if _, err := run("", "unknown"); err == nil {
+		t.Fatal("want unknown subcommand error")
+	}
+}
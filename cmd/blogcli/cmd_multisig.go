@@ -1,12 +1,16 @@
 package main
 
 import (
+	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/iov-one/weave"
 	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
 	"github.com/iov-one/weave/x/multisig"
 )
 
@@ -140,3 +144,131 @@ Given multisig IDs must be a decimal number or a hex encoded 8 byte bigendian se
 	_, err = writeTx(output, tx)
 	return err
 }
+
+func cmdCheckMultisig(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Read a transaction or an envelope created by the prepare command from the input
+and check if the attached signatures meet the activation threshold of multisig
+contracts. Use it before submitting a transaction to ensure enough signatures
+were collected.
+
+Contracts are loaded from the node. Any number of multisig contract IDs can be
+provided. By default all contracts attached to the transaction are checked.
+Contracts are activated in the order they are attached, so that an activated
+contract can be a participant of the next one, as it is done when the
+transaction is processed.
+
+A single line is printed for every contract, containing the contract ID, the
+weight of collected signatures, the activation threshold and the result of the
+check. This command fails if the threshold of any contract is not met.
+
+Given multisig IDs must be a decimal number or a hex encoded 8 byte bigendian sequence.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BLOGCLI_TM_ADDR", "https://BLOG.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	tx, envelope, err := readTxOrEnvelope(input)
+	if err != nil {
+		return fmt.Errorf("cannot read input transaction: %s", err)
+	}
+	if envelope != nil {
+		if tx, err = envelope.Transaction(); err != nil {
+			return err
+		}
+	}
+
+	var checked [][]byte
+	for i, mid := range fl.Args() {
+		seq, err := unpackSequence(mid)
+		if err != nil {
+			return fmt.Errorf("sequence value #%d is invalid: %s", i, err)
+		}
+		checked = append(checked, seq)
+	}
+	if len(checked) == 0 {
+		checked = tx.Multisig
+	}
+	if len(checked) == 0 {
+		return errors.New("no multisig contract to check")
+	}
+
+	BlogClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
+
+	chainID, err := BlogClient.ChainID()
+	if err != nil {
+		return fmt.Errorf("cannot get chain ID: %s", err)
+	}
+	if envelope != nil && envelope.ChainID != chainID {
+		return fmt.Errorf("envelope is prepared for chain %q, node runs %q", envelope.ChainID, chainID)
+	}
+	conditions := validSigners(tx, chainID)
+
+	// Contracts not attached to the transaction are checked last.
+	contractIDs := append([][]byte{}, tx.Multisig...)
+	for _, id := range checked {
+		if !containsID(contractIDs, id) {
+			contractIDs = append(contractIDs, id)
+		}
+	}
+
+	results := make(map[string]multisigCheck)
+	for _, id := range contractIDs {
+		resp, err := BlogClient.GetContract(id)
+		if err != nil {
+			return fmt.Errorf("cannot load %X contract: %s", id, err)
+		}
+		weight, _ := client.SignedWeight(&resp.Contract, conditions)
+		activated := weight >= resp.Contract.ActivationThreshold
+		if activated {
+			conditions = append(conditions, multisig.MultiSigCondition(id).Address())
+		}
+		results[string(id)] = multisigCheck{
+			weight:    weight,
+			threshold: resp.Contract.ActivationThreshold,
+			activated: activated,
+		}
+	}
+
+	var failed []string
+	for _, id := range checked {
+		n, err := fromSequence(id)
+		if err != nil {
+			return fmt.Errorf("invalid contract ID %X: %s", id, err)
+		}
+		res := results[string(id)]
+		status := "activated"
+		if !res.activated {
+			status = "not activated"
+			failed = append(failed, fmt.Sprint(n))
+		}
+		fmt.Fprintf(output, "%d\t%d/%d\t%s\n", n, res.weight, res.threshold, status)
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("activation threshold is not met for contracts: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// multisigCheck is the result of checking the activation of a single
+// multisig contract.
+type multisigCheck struct {
+	weight    multisig.Weight
+	threshold multisig.Weight
+	activated bool
+}
+
+func containsID(ids [][]byte, id []byte) bool {
+	for _, x := range ids {
+		if bytes.Equal(x, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+// contractID is the ID of the multisig contract declared in the genesis. Its
+// participants are the addr account and the account of participantKey, both
+// with weight 1. Activation threshold is 2.
+const contractID = "1"
+
+// participantKey returns the path to the private key file of the second
+// participant of the genesis multisig contract.
+func participantKey(t testing.TB) string {
+	t.Helper()
+	priv, err := keygen(keygenMnemonic, "", "m/44'/988'/0'")
+	assert.Nil(t, err)
+	return mustCreateFile(t, bytes.NewReader(priv))
+}
+
+func TestCmdSignatures(t *testing.T) {
+	var unsigned bytes.Buffer
+	_, err := writeTx(&unsigned, sendTx(t, "signatures"))
+	assert.Nil(t, err)
+
+	sign := func(tx []byte, keyPath string, nonce string) ([]byte, error) {
+		var signed bytes.Buffer
+		args := []string{"-key", keyPath, "-offline", "-chain-id", "test-chain", "-nonce", nonce}
+		err := cmdSignTransaction(bytes.NewReader(tx), &signed, args)
+		return signed.Bytes(), err
+	}
+	funded := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	once, err := sign(unsigned.Bytes(), funded, "3")
+	assert.Nil(t, err)
+	twice, err := sign(once, participantKey(t), "0")
+	assert.Nil(t, err)
+	if _, err := sign(twice, funded, "4"); err == nil {
+		t.Fatal("want already signed error")
+	}
+
+	var output bytes.Buffer
+	err = cmdSignatures(bytes.NewReader(twice), &output, []string{"-chain-id", "test-chain"})
+	assert.Nil(t, err)
+	want := addr + "\t3\tvalid\n" +
+		"BF8611F57022FDB27C1E287B6925A241E251080D\t0\tvalid\n"
+	assert.Equal(t, want, output.String())
+
+	output.Reset()
+	err = cmdSignatures(bytes.NewReader(twice), &output, []string{"-chain-id", "another-chain"})
+	if err == nil {
+		t.Fatal("want invalid signatures error")
+	}
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+	assert.Equal(t, true, strings.HasPrefix(lines[0], addr+"\t3\tinvalid: "))
+}
+
+func TestCmdCheckMultisig(t *testing.T) {
+	var unsigned, withMultisig bytes.Buffer
+	_, err := writeTx(&unsigned, sendTx(t, "check multisig"))
+	assert.Nil(t, err)
+	assert.Nil(t, cmdWithMultisig(&unsigned, &withMultisig, []string{contractID}))
+
+	sign := func(tx []byte, keyPath string) []byte {
+		var signed bytes.Buffer
+		args := []string{"-tm", tmURL, "-key", keyPath}
+		assert.Nil(t, cmdSignTransaction(bytes.NewReader(tx), &signed, args))
+		return signed.Bytes()
+	}
+	check := func(tx []byte, ids ...string) (string, error) {
+		var output bytes.Buffer
+		err := cmdCheckMultisig(bytes.NewReader(tx), &output, append([]string{"-tm", tmURL}, ids...))
+		return output.String(), err
+	}
+
+	once := sign(withMultisig.Bytes(), mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex))))
+	out, err := check(once)
+	if err == nil {
+		t.Fatal("want threshold not met error")
+	}
+	assert.Equal(t, "1\t1/2\tnot activated\n", out)
+
+	twice := sign(once, participantKey(t))
+	out, err = check(twice)
+	assert.Nil(t, err)
+	assert.Equal(t, "1\t2/2\tactivated\n", out)
+
+	// a contract not attached to the transaction can be checked as well
+	var plain bytes.Buffer
+	_, err = writeTx(&plain, sendTx(t, "not attached"))
+	assert.Nil(t, err)
+	out, err = check(sign(plain.Bytes(), participantKey(t)), contractID)
+	if err == nil {
+		t.Fatal("want threshold not met error")
+	}
+	assert.Equal(t, "1\t1/2\tnot activated\n", out)
+
+	if _, err := check(twice, "99"); err == nil {
+		t.Fatal("want missing contract error")
+	}
+}
@@ -9,14 +9,18 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
 	"github.com/iov-one/blog-tutorial/cmd/blog/client"
 	"github.com/iov-one/blog-tutorial/x/blog"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/multisig"
 )
 
@@ -32,8 +36,13 @@ Execute a ABCI query and print JSON encoded result.
 		tmAddrFl = fl.String("tm", env("BLOGCLI_TM_ADDR", "https://blog.NETWORK:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
 		pathFl        = fl.String("path", "", "Path to be queried. Must be one of the supported.")
-		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates")
+		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates, hex or bech32 address for address indexes and 'blogID/time' for /articles/timedBlog, where time format is "+flagTimeFormat+".")
 		prefixQueryFl = fl.Bool("prefix", false, "If true, use prefix queries instead of the exact match with provided data.")
+		limitFl       = fl.Int("limit", 0, "If greater than zero, return a single page of at most that many results. Only paginated and time range queries are supported.")
+		afterFl       = fl.String("after", "", "Return a page of results stored after given key. Use the NextCursor value of the previous page. Only paginated and time range paths are supported.")
+		reverseFl     = fl.Bool("reverse", false, "If true, return a page of results in descending order. Only paginated and time range queries are supported.")
+		fromFl        = flTime(fl, "from", nil, "Return articles of the blog given as data, created at or after given time, format: "+flagTimeFormat+". Only time range paths are supported.")
+		toFl          = flTime(fl, "to", nil, "Return articles of the blog given as data, created before given time, format: "+flagTimeFormat+". Only time range paths are supported.")
 	)
 	fl.Parse(args)
 
@@ -43,9 +52,40 @@ Execute a ABCI query and print JSON encoded result.
 		for p := range queries {
 			paths = append(paths, p)
 		}
+		sort.Strings(paths)
 		return fmt.Errorf("available query paths:\n\t- %s", strings.Join(paths, "\n\t- "))
 	}
 
+	if !fromFl.Time().IsZero() || !toFl.Time().IsZero() || (conf.timeRange && *afterFl != "") {
+		if !conf.timeRange {
+			return fmt.Errorf("%s path does not support time range queries", *pathFl)
+		}
+		if *dataFl == "" {
+			return errors.New("time range query requires blog ID as data")
+		}
+		if *prefixQueryFl {
+			return errors.New("time range query cannot be combined with prefix query")
+		}
+		var from, to weave.UnixTime
+		if !fromFl.Time().IsZero() {
+			from = fromFl.UnixTime()
+		}
+		if !toFl.Time().IsZero() {
+			to = toFl.UnixTime()
+		}
+		return queryTimeRange(output, *tmAddrFl, *pathFl, *dataFl, from, to, *afterFl, *limitFl, *reverseFl)
+	}
+
+	if *limitFl != 0 || *afterFl != "" || *reverseFl {
+		if !conf.paginated {
+			return fmt.Errorf("%s path does not support pagination", *pathFl)
+		}
+		if *dataFl != "" || *prefixQueryFl {
+			return errors.New("pagination cannot be combined with data or prefix query")
+		}
+		return queryPage(output, *tmAddrFl, *pathFl, *afterFl, *limitFl, *reverseFl)
+	}
+
 	var data []byte
 	if len(*dataFl) != 0 {
 		var err error
@@ -64,19 +104,85 @@ Execute a ABCI query and print JSON encoded result.
 		return fmt.Errorf("failed to run query: %s", err)
 	}
 
-	result := make([]keyval, 0, len(resp.Models))
-	for i, m := range resp.Models {
-		obj := conf.newObj()
-		if err := obj.Unmarshal(m.Value); err != nil {
-			return fmt.Errorf("failed to unmarshal model %d: %s", i, err)
+	result, err := decodeModels(conf.newObj, conf.decKey, resp.Models)
+	if err != nil {
+		return err
+	}
+	pretty, err := json.MarshalIndent(result, "", "\t")
+	if err != nil {
+		return fmt.Errorf("cannot JSON serialize: %s", err)
+	}
+	_, err = output.Write(pretty)
+	return err
+}
+
+// queryPage executes a range query on a paginated path and prints a single
+// page of results together with the cursor of the next page.
+func queryPage(output io.Writer, tmAddr, path, after string, limit int, reverse bool) error {
+	conf := queries[path]
+
+	var cursor []byte
+	if after != "" {
+		var err error
+		if cursor, err = conf.encID(after); err != nil {
+			return fmt.Errorf("can not encode after: %s", err)
 		}
-		key, err := conf.decKey(m.Key)
-		if err != nil {
-			return fmt.Errorf("cannot decode %x key: %s", m.Key, err)
+	}
+
+	BlogClient := client.NewClient(client.NewHTTPConnection(tmAddr))
+	resp, err := BlogClient.AbciQueryPage(path, cursor, limit, reverse)
+	if err != nil {
+		return fmt.Errorf("failed to run query: %s", err)
+	}
+
+	result, err := decodeModels(conf.newObj, conf.decKey, resp.Models)
+	if err != nil {
+		return err
+	}
+	pg := page{Models: result}
+	if resp.NextCursor != nil {
+		if pg.NextCursor, err = conf.decKey(resp.NextCursor); err != nil {
+			return fmt.Errorf("cannot decode %x cursor: %s", resp.NextCursor, err)
 		}
-		result = append(result, keyval{Key: key, Value: obj})
 	}
-	pretty, err := json.MarshalIndent(result, "", "\t")
+	pretty, err := json.MarshalIndent(pg, "", "\t")
+	if err != nil {
+		return fmt.Errorf("cannot JSON serialize: %s", err)
+	}
+	_, err = output.Write(pretty)
+	return err
+}
+
+// queryTimeRange executes a range query on a time range path and prints a
+// single page of articles of a blog created within given time range,
+// together with the cursor of the next page. The cursor is hex encoded.
+func queryTimeRange(output io.Writer, tmAddr, path, blogID string, from, to weave.UnixTime, after string, limit int, reverse bool) error {
+	conf := queries[path]
+
+	blogKey, err := conf.encID(blogID)
+	if err != nil {
+		return fmt.Errorf("can not encode data: %s", err)
+	}
+	cursor, err := hex.DecodeString(after)
+	if err != nil {
+		return fmt.Errorf("can not decode after: %s", err)
+	}
+
+	BlogClient := client.NewClient(client.NewHTTPConnection(tmAddr))
+	resp, err := BlogClient.AbciQueryTimeRange(blogKey, from, to, cursor, limit, reverse)
+	if err != nil {
+		return fmt.Errorf("failed to run query: %s", err)
+	}
+
+	result, err := decodeModels(conf.newObj, conf.decKey, resp.Models)
+	if err != nil {
+		return err
+	}
+	pg := page{
+		Models:     result,
+		NextCursor: hex.EncodeToString(resp.NextCursor),
+	}
+	pretty, err := json.MarshalIndent(pg, "", "\t")
 	if err != nil {
 		return fmt.Errorf("cannot JSON serialize: %s", err)
 	}
@@ -84,14 +190,38 @@ Execute a ABCI query and print JSON encoded result.
 	return err
 }
 
+// decodeModels unmarshal all models and decode their keys into a human
+// readable form.
+func decodeModels(newObj func() model, decKey func([]byte) (string, error), models []weave.Model) ([]keyval, error) {
+	result := make([]keyval, 0, len(models))
+	for i, m := range models {
+		obj := newObj()
+		if err := obj.Unmarshal(m.Value); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal model %d: %s", i, err)
+		}
+		key, err := decKey(m.Key)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode %x key: %s", m.Key, err)
+		}
+		result = append(result, keyval{Key: key, Value: obj})
+	}
+	return result, nil
+}
+
 type keyval struct {
 	Key   string
 	Value model
 }
 
-// queries contains a mapping of query path to that query specifics. Each query
-// returns a custom model type and may use different ID encoding pattern.
-var queries = map[string]struct {
+// page is a single page of results returned by a paginated query.
+type page struct {
+	Models []keyval
+	// NextCursor is empty if there are no more results.
+	NextCursor string `json:",omitempty"`
+}
+
+// queryConf describes how to query a path and how to present its result.
+type queryConf struct {
 	// newObj returns a new instance of the model that the result of the
 	// ABCI query should be extracted into.
 	newObj func() model
@@ -102,32 +232,110 @@ var queries = map[string]struct {
 	// form that will be passed to the ABCI query. The format can differ
 	// from decKey if we use secondary index for matching.
 	encID func(string) ([]byte, error)
-}{
-	"/blogUsers": {
-		newObj: func() model { return &blog.User{} },
+	// paginated is true if the path supports range queries, returning a
+	// single page of results.
+	paginated bool
+	// timeRange is true if the path supports range queries over a time
+	// range, returning articles of a single blog.
+	timeRange bool
+}
+
+// queries contains a mapping of query path to that query specifics. Each query
+// returns a custom model type and may use different ID encoding pattern.
+// Blog extension paths are added from their registration description, see
+// blogQueries.
+var queries = map[string]queryConf{
+	"/wallets": {
+		newObj: func() model { return &cash.Set{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/contracts": {
+		newObj: func() model { return &multisig.Contract{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
-	"/blogs": {
-		newObj: func() model { return &blog.Blog{} },
+	"/proposals": {
+		newObj: func() model { return &extendedProposal{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
-	"/articles": {
-		newObj: func() model { return &blog.Article{} },
+	"/proposals/author": {
+		newObj: func() model { return &extendedProposal{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
+	"/proposals/electorate": {
+		newObj: func() model { return &extendedProposal{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
-	"/wallets": {
-		newObj: func() model { return &cash.Set{} },
+	"/electionrules": {
+		newObj: func() model { return &gov.ElectionRule{} },
+		decKey: refKey,
+		encID:  refID,
+	},
+	"/electorates": {
+		newObj: func() model { return &gov.Electorate{} },
+		decKey: refKey,
+		encID:  refID,
+	},
+	"/electorates/elector": {
+		newObj: func() model { return &gov.Electorate{} },
+		decKey: refKey,
+		encID:  addressID,
+	},
+	"/votes": {
+		newObj: func() model { return &gov.Vote{} },
 		decKey: rawKey,
 		encID:  addressID,
 	},
-	"/contracts": {
-		newObj: func() model { return &multisig.Contract{} },
-		decKey: sequenceKey,
+	"/votes/proposals": {
+		newObj: func() model { return &gov.Vote{} },
+		decKey: rawKey,
 		encID:  numericID,
 	},
+	"/votes/electors": {
+		newObj: func() model { return &gov.Vote{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+}
+
+func init() {
+	for path, conf := range blogQueries(blog.QueryPaths()) {
+		queries[path] = conf
+	}
+}
+
+// blogQueries returns query configurations of all blog extension query paths.
+func blogQueries(paths []blog.QueryPath) map[string]queryConf {
+	confs := make(map[string]queryConf, len(paths))
+	for _, p := range paths {
+		conf := queryConf{
+			newObj:    modelFactory(p.NewModel),
+			decKey:    sequenceKey,
+			paginated: p.Paginated,
+			timeRange: p.TimeRange,
+		}
+		switch p.Key {
+		case blog.SequenceKeyFormat:
+			conf.encID = numericID
+		case blog.AddressKeyFormat:
+			conf.encID = addressID
+		case blog.TimedBlogKeyFormat:
+			conf.encID = timedBlogID
+		default:
+			panic(fmt.Sprintf("unknown key format of %s path: %d", p.Path, p.Key))
+		}
+		confs[p.Path] = conf
+	}
+	return confs
+}
+
+// modelFactory adapts persistent model constructor to return a model.
+func modelFactory(fn func() weave.Persistent) func() model {
+	return func() model { return fn() }
 }
 
 // model is an entity used by weave to store data. This interface is
@@ -171,8 +379,37 @@ func refID(s string) ([]byte, error) {
 	return orm.MarshalVersionedID(ref), nil
 }
 
+// addressID expects an address in any format supported by weave, and
+// additionally a bech32 encoded address without the "bech32:" prefix.
 func addressID(s string) ([]byte, error) {
-	return weave.ParseAddress(s)
+	addr, err := weave.ParseAddress(s)
+	if err != nil && !strings.Contains(s, ":") {
+		if bech, berr := weave.ParseAddress("bech32:" + s); berr == nil {
+			return bech, nil
+		}
+	}
+	return addr, err
+}
+
+// timedBlogID expects `blogID` or `blogID/time` value, where time is in
+// the same format as time flags. Providing just the blog ID is useful with prefix queries.
+func timedBlogID(s string) ([]byte, error) {
+	tokens := strings.SplitN(s, "/", 2)
+	blogKey, err := numericID(tokens[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode blog ID: %s", err)
+	}
+	if len(tokens) == 1 {
+		return blogKey, nil
+	}
+	t, err := time.Parse(flagTimeFormat, tokens[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode time: %s", err)
+	}
+	return blog.BuildBlogTimedIndex(&blog.Article{
+		BlogKey:   blogKey,
+		CreatedAt: weave.AsUnixTime(t),
+	})
 }
 
 func refKey(raw []byte) (string, error) {
@@ -211,3 +448,26 @@ func sequenceKey(raw []byte) (string, error) {
 func rawKey(raw []byte) (string, error) {
 	return hex.EncodeToString(raw), nil
 }
+
+// extendedProposal is the gov.Proposal with an additional field to extract
+// RawOption. When serialized using JSON, this structure produce the same
+// result as the gov.Proposal with an addition of an attribute representing
+// deserialized (human readable) form of the message that is proposed.
+type extendedProposal struct {
+	gov.Proposal
+	// Option contains a deserialized value of the RawOption
+	Option interface{} `json:"executed_when_accepted"`
+}
+
+// Unmarshal implements protobuf unmarshaler interface.
+func (p *extendedProposal) Unmarshal(raw []byte) error {
+	if err := p.Proposal.Unmarshal(raw); err != nil {
+		return fmt.Errorf("cannot unmarshal proposal: %s", err)
+	}
+	var opts app.ProposalOptions
+	if err := opts.Unmarshal(p.Proposal.RawOption); err != nil {
+		return fmt.Errorf("cannot unmarshal proposal option: %s", err)
+	}
+	p.Option = opts.GetOption()
+	return nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+)
+
+func TestCmdQueryRangeNotSupported(t *testing.T) {
+	cases := map[string][]string{
+		"path is not paginated":           {"-path", "/wallets", "-limit", "10"},
+		"combined with data":              {"-path", "/blogs", "-limit", "10", "-data", "1"},
+		"combined with a prefix":          {"-path", "/articles", "-after", "3", "-prefix"},
+		"path is not time range":          {"-path", "/articles", "-data", "1", "-from", "2019-01-01 00:00"},
+		"time range without blog":         {"-path", "/articles/timedBlog", "-to", "2019-01-01 00:00"},
+		"time range with invalid after":   {"-path", "/articles/timedBlog", "-data", "1", "-after", "zz"},
+		"time range combined with prefix": {"-path", "/articles/timedBlog", "-data", "1", "-from", "2019-01-01 00:00", "-prefix"},
+	}
+	for testName, args := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			err := cmdQuery(strings.NewReader(""), &output, append([]string{"-tm", "http://localhost:1"}, args...))
+			if err == nil {
+				t.Fatal("want error")
+			}
+			if output.Len() != 0 {
+				t.Fatalf("unexpected output: %s", output.String())
+			}
+		})
+	}
+}
+
+func TestBlogQueriesRegistered(t *testing.T) {
+	for _, p := range blog.QueryPaths() {
+		conf, ok := queries[p.Path]
+		if !ok {
+			t.Errorf("%q path is missing", p.Path)
+			continue
+		}
+		if conf.paginated != p.Paginated || conf.timeRange != p.TimeRange {
+			t.Errorf("%q path range support mismatch", p.Path)
+		}
+	}
+	if _, ok := queries["/blogUsers"]; ok {
+		t.Error("/blogUsers path is not registered by the blog extension")
+	}
+}
+
+func TestTimedBlogID(t *testing.T) {
+	cases := map[string]struct {
+		raw     string
+		want    []byte
+		wantErr bool
+	}{
+		"blog ID only": {
+			raw:  "3",
+			want: []byte{0, 0, 0, 0, 0, 0, 0, 3},
+		},
+		"blog ID and time": {
+			raw:  "3/1970-01-01 00:01",
+			want: []byte{0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, 60},
+		},
+		"invalid blog ID": {
+			raw:     "x/1970-01-01 00:01",
+			wantErr: true,
+		},
+		"invalid time": {
+			raw:     "3/1970-01-01T00:01:00Z",
+			wantErr: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := timedBlogID(tc.raw)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !bytes.Equal(tc.want, got) {
+				t.Fatalf("want %x, got %x", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAddressID(t *testing.T) {
+	want, err := weave.ParseAddress("b1ca7e78f74423ae01da3b51e676934d9105f282")
+	if err != nil {
+		t.Fatalf("cannot parse address: %s", err)
+	}
+	bech, err := want.Bech32String("tiov")
+	if err != nil {
+		t.Fatalf("cannot encode address: %s", err)
+	}
+
+	for _, raw := range []string{want.String(), "hex:" + want.String(), bech, "bech32:" + bech} {
+		got, err := addressID(raw)
+		if err != nil {
+			t.Fatalf("cannot decode %q: %s", raw, err)
+		}
+		if !bytes.Equal(want, got) {
+			t.Fatalf("%q: want %x, got %x", raw, want, got)
+		}
+	}
+
+	if _, err := addressID("not an address"); err == nil {
+		t.Fatal("want error")
+	}
+}
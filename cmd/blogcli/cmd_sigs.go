@@ -10,7 +10,9 @@ import (
 	"net/http"
 	"os"
 
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
 	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/x/sigs"
 )
@@ -27,6 +29,23 @@ Sign given transaction. This is decoding a transaction data from standard
 input, adds a signature and writes back to standard output signed transaction
 content.
 
+The key is read from an unencrypted private key file, or from the keystore if
+-key-name is provided.
+
+By default the chain ID and the nonce are fetched from the node. Use -offline
+together with -chain-id and -nonce to sign without network access.
+
+Signatures already attached to the transaction are preserved and the new
+signature is appended, so that a transaction that requires more than one
+signature, i.e. to activate a multisig contract, can be passed through this
+command once for each signer. Signing with a key that already signed the
+transaction is not allowed. Use the signatures command to list who signed.
+
+An envelope created by the prepare command can be signed instead of a
+transaction. The chain ID and the nonce declared in the envelope are used and
+the network is never contacted. The envelope is rejected if its decoded
+message does not match the transaction. Signed envelope is written out.
+
 `)
 		fl.PrintDefaults()
 	}
@@ -35,18 +54,259 @@ content.
 			"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
 		keyPathFl = fl.String("key", env("BLOGCLI_PRIV_KEY", os.Getenv("HOME")+"/.blog.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BLOGCLI_PRIV_KEY environment variable to set it.")
+		keyNameFl = fl.String("key-name", "",
+			"Name of the keystore key that transaction should be signed with. Used instead of -key if provided.")
+		keystoreFl = flKeystore(fl)
+		offlineFl  = fl.Bool("offline", false,
+			"Do not contact the node. Both -chain-id and -nonce are required unless an envelope is signed.")
+		chainIDFl = fl.String("chain-id", "",
+			"Chain ID the transaction is signed for. Fetched from the node if not provided.")
+		nonceFl = fl.Int64("nonce", -1,
+			"Nonce of the signer. Fetched from the node if not provided.")
 	)
 	fl.Parse(args)
 
-	if *keyPathFl == "" {
+	var (
+		key *crypto.PrivateKey
+		err error
+	)
+	switch {
+	case *keyNameFl != "":
+		key, err = loadKeystoreKey(*keystoreFl, *keyNameFl)
+		if err != nil {
+			return err
+		}
+	case *keyPathFl != "":
+		key, err = decodePrivateKey(*keyPathFl)
+		if err != nil {
+			return fmt.Errorf("cannot load private key: %s", err)
+		}
+	default:
 		return errors.New("private key is required")
 	}
-	key, err := decodePrivateKey(*keyPathFl)
+
+	tx, envelope, err := readTxOrEnvelope(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction: %s", err)
+	}
+
+	if envelope != nil {
+		if err := envelope.Verify(false); err != nil {
+			return fmt.Errorf("invalid envelope: %s", err)
+		}
+		signer, ok := envelope.Signer(key.PublicKey().Address())
+		if !ok {
+			return fmt.Errorf("envelope does not declare signer %s", key.PublicKey().Address())
+		}
+		if *chainIDFl != "" && *chainIDFl != envelope.ChainID {
+			return fmt.Errorf("envelope is prepared for chain %q", envelope.ChainID)
+		}
+		if *nonceFl >= 0 && *nonceFl != signer.Nonce {
+			return fmt.Errorf("envelope declares nonce %d", signer.Nonce)
+		}
+		tx, err := envelope.Transaction()
+		if err != nil {
+			return err
+		}
+		if err := ensureNotSigned(tx, key.PublicKey().Address()); err != nil {
+			return err
+		}
+		sig, err := sigs.SignTx(key, tx, envelope.ChainID, signer.Nonce)
+		if err != nil {
+			return fmt.Errorf("cannot sign transaction: %s", err)
+		}
+		tx.Signatures = append(tx.Signatures, sig)
+		if err := envelope.SetTransaction(tx); err != nil {
+			return err
+		}
+		return writeEnvelope(output, envelope)
+	}
+
+	if *offlineFl && (*chainIDFl == "" || *nonceFl < 0) {
+		return errors.New("offline signing requires -chain-id and -nonce")
+	}
+	if err := ensureNotSigned(tx, key.PublicKey().Address()); err != nil {
+		return err
+	}
+
+	chainID := *chainIDFl
+	if chainID == "" {
+		genesis, err := fetchGenesis(*tmAddrFl)
+		if err != nil {
+			return fmt.Errorf("cannot fetch genesis: %s", err)
+		}
+		chainID = genesis.ChainID
+	}
+
+	seq := *nonceFl
+	if seq < 0 {
+		BlogClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
+		seq, err = BlogClient.NextNonce(key.PublicKey().Address())
+		if err != nil {
+			return fmt.Errorf("cannot get the next sequence number: %s", err)
+		}
+	}
+
+	sig, err := sigs.SignTx(key, tx, chainID, seq)
+	if err != nil {
+		return fmt.Errorf("cannot sign transaction: %s", err)
+	}
+	tx.Signatures = append(tx.Signatures, sig)
+
+	_, err = writeTx(output, tx)
+	return err
+}
+
+// ensureNotSigned returns an error if the transaction already contains a
+// signature of given address.
+func ensureNotSigned(tx *blog.Tx, signer weave.Address) error {
+	for _, sig := range tx.Signatures {
+		if sig.Pubkey != nil && sig.Pubkey.Address().Equals(signer) {
+			return fmt.Errorf("transaction is already signed by %s", signer)
+		}
+	}
+	return nil
+}
+
+func cmdSignatures(
+	input io.Reader,
+	output io.Writer,
+	args []string,
+) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read binary serialized transaction or an envelope created by the prepare
+command from standard input and print out all signatures attached to it. A
+single line is printed for every signature, containing the hex-address of the
+signer, the nonce used and the result of the signature verification.
+
+The chain ID is required to verify signatures. The chain ID declared in the
+envelope is used. Otherwise it is fetched from the node, unless provided.
+
+This command fails if any of the signatures is not valid.
+
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BLOGCLI_TM_ADDR", "https://BLOG.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
+		chainIDFl = fl.String("chain-id", "",
+			"Chain ID the transaction is signed for. Fetched from the node if not provided.")
+	)
+	fl.Parse(args)
+
+	tx, envelope, err := readTxOrEnvelope(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction: %s", err)
+	}
+
+	chainID := *chainIDFl
+	if envelope != nil {
+		if chainID != "" && chainID != envelope.ChainID {
+			return fmt.Errorf("envelope is prepared for chain %q", envelope.ChainID)
+		}
+		chainID = envelope.ChainID
+		if tx, err = envelope.Transaction(); err != nil {
+			return err
+		}
+	}
+	if chainID == "" {
+		genesis, err := fetchGenesis(*tmAddrFl)
+		if err != nil {
+			return fmt.Errorf("cannot fetch genesis: %s", err)
+		}
+		chainID = genesis.ChainID
+	}
+
+	var invalid int
+	for i, sig := range tx.Signatures {
+		signer := "-"
+		if sig.Pubkey != nil {
+			signer = sig.Pubkey.Address().String()
+		}
+		status := "valid"
+		if err := verifySignature(tx, sig, chainID); err != nil {
+			status = fmt.Sprintf("invalid: %s", err)
+			invalid++
+		}
+		if _, err := fmt.Fprintf(output, "%s\t%d\t%s\n", signer, sig.Sequence, status); err != nil {
+			return fmt.Errorf("cannot write signature #%d: %s", i, err)
+		}
+	}
+	if invalid != 0 {
+		return fmt.Errorf("%d of %d signatures are not valid", invalid, len(tx.Signatures))
+	}
+	return nil
+}
+
+// verifySignature returns an error if given signature of the transaction is
+// not valid for the chain.
+func verifySignature(tx *blog.Tx, sig *sigs.StdSignature, chainID string) error {
+	if err := sig.Validate(); err != nil {
+		return err
+	}
+	signBytes, err := sigs.BuildSignBytesTx(tx, chainID, sig.Sequence)
 	if err != nil {
-		return fmt.Errorf("cannot load private key: %s", err)
+		return fmt.Errorf("cannot build sign bytes: %s", err)
+	}
+	if !sig.Pubkey.Verify(signBytes, sig.Signature) {
+		return errors.New("signature does not match the transaction")
+	}
+	return nil
+}
+
+// validSigners returns the addresses of all signers that attached a valid
+// signature to the transaction.
+func validSigners(tx *blog.Tx, chainID string) []weave.Address {
+	var signers []weave.Address
+	for _, sig := range tx.Signatures {
+		if verifySignature(tx, sig, chainID) == nil {
+			signers = append(signers, sig.Pubkey.Address())
+		}
 	}
+	return signers
+}
 
-	tx, _, err := readTx(input)
+func cmdPrepareTransaction(
+	input io.Reader,
+	output io.Writer,
+	args []string,
+) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read binary serialized transaction from standard input and write out an
+envelope that allows to sign it without network access.
+
+The envelope is JSON serialized and contains the transaction, the chain ID and
+the nonce that the signer must use, together with the bytes that the signer
+signs. The message of the transaction is included in a decoded form, so that it
+can be reviewed before signing. Transfer the envelope to the signing machine and sign it using the sign
+command. Submit the signed envelope using the submit command.
+
+To collect more than one signature, pass the envelope through this command
+again, once for each signer.
+
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BLOGCLI_TM_ADDR", "https://BLOG.NETWORK.iov.one:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
+		signerFl = flAddress(fl, "signer", "", "Address of the account that signs the transaction.")
+	)
+	fl.Parse(args)
+
+	if len(*signerFl) == 0 {
+		return errors.New("signer address is required")
+	}
+	if err := signerFl.Validate(); err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+
+	tx, envelope, err := readTxOrEnvelope(input)
 	if err != nil {
 		return fmt.Errorf("cannot read transaction: %s", err)
 	}
@@ -55,21 +315,24 @@ content.
 	if err != nil {
 		return fmt.Errorf("cannot fetch genesis: %s", err)
 	}
+	if envelope == nil {
+		envelope, err = newTxEnvelope(tx, genesis.ChainID)
+		if err != nil {
+			return err
+		}
+	} else if envelope.ChainID != genesis.ChainID {
+		return fmt.Errorf("envelope is prepared for chain %q", envelope.ChainID)
+	}
 
 	BlogClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
-	seq, err := BlogClient.NextNonce(key.PublicKey().Address())
+	seq, err := BlogClient.NextNonce(*signerFl)
 	if err != nil {
 		return fmt.Errorf("cannot get the next sequence number: %s", err)
 	}
-
-	sig, err := sigs.SignTx(key, tx, genesis.ChainID, seq)
-	if err != nil {
-		return fmt.Errorf("cannot sign transaction: %s", err)
+	if err := envelope.AddSigner(*signerFl, seq); err != nil {
+		return err
 	}
-	tx.Signatures = append(tx.Signatures, sig)
-
-	_, err = writeTx(output, tx)
-	return err
+	return writeEnvelope(output, envelope)
 }
 
 func decodePrivateKey(filepath string) (*crypto.PrivateKey, error) {
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/weave/coin"
+)
+
+func cmdSimulate(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Read binary serialized transaction from standard input and check it as if it
+was included in the next block, without submitting it.
+
+The report contains the gas allocated by the check, the fee required by the
+transaction and the minimal and message fees configured on the chain. If the
+check fails, the report is written together with the error.
+
+The transaction is checked against the committed state only. A transaction
+signed with a nonce that follows a transaction waiting to be committed fails.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		tmAddrFl = fl.String("tm", env("BLOGCLI_TM_ADDR", "https://blog.NETWORK:443"),
+			"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
+	)
+	fl.Parse(args)
+
+	tx, _, err := readTx(input)
+	if err != nil {
+		return fmt.Errorf("cannot read transaction from input: %s", err)
+	}
+
+	bc := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
+	res, err := bc.Simulate(tx)
+	if err != nil {
+		return fmt.Errorf("cannot simulate transaction: %s", err)
+	}
+
+	report := simulateReport{
+		Height:       res.Height,
+		GasAllocated: res.GasAllocated,
+		GasPayment:   res.GasPayment,
+		RequiredFee:  res.RequiredFee,
+		MinimalFee:   res.MinimalFee,
+		MessageFee:   res.MessageFee,
+	}
+	checkErr := res.Err()
+	if checkErr != nil {
+		report.Error = checkErr.Error()
+	}
+	pretty, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return fmt.Errorf("cannot JSON serialize: %s", err)
+	}
+	fmt.Fprintln(output, string(pretty))
+	if checkErr != nil {
+		return fmt.Errorf("transaction check failed: %s", checkErr)
+	}
+	return nil
+}
+
+// simulateReport is the human readable result of a transaction simulation.
+type simulateReport struct {
+	Height       int64
+	GasAllocated int64
+	GasPayment   int64
+	RequiredFee  coin.Coin
+	MinimalFee   coin.Coin
+	MessageFee   coin.Coin
+	Error        string `json:",omitempty"`
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestCmdSimulateHappyPath(t *testing.T) {
+	tx := &blog.Tx{
+		Sum: &blog.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      fromHex(t, addr),
+				Destination: fromHex(t, addr),
+				Amount:      &coin.Coin{Whole: 1, Ticker: "BLOG"},
+			},
+		},
+	}
+	var input bytes.Buffer
+	if _, err := writeTx(&input, tx); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+	unsigned := bytes.NewReader(input.Bytes())
+
+	var signedTx bytes.Buffer
+	signArgs := []string{
+		"-tm", tmURL,
+		"-key", mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex))),
+	}
+	assert.Nil(t, cmdSignTransaction(&input, &signedTx, signArgs))
+	signed := bytes.NewReader(signedTx.Bytes())
+
+	var output bytes.Buffer
+	if err := cmdSimulate(signed, &output, []string{"-tm", tmURL}); err != nil {
+		t.Fatalf("cannot simulate the transaction: %s", err)
+	}
+	if !strings.Contains(output.String(), `"GasAllocated"`) {
+		t.Fatalf("unexpected output: %s", output.String())
+	}
+
+	output.Reset()
+	if err := cmdSimulate(unsigned, &output, []string{"-tm", tmURL}); err == nil {
+		t.Fatal("want unsigned transaction check to fail")
+	}
+	if !strings.Contains(output.String(), `"Error"`) {
+		t.Fatalf("error not reported: %s", output.String())
+	}
+}
+
+func TestCmdSimulateInvalidInput(t *testing.T) {
+	var output bytes.Buffer
+	err := cmdSimulate(strings.NewReader("not a transaction"), &output, []string{"-tm", "http://localhost:1"})
+	if err == nil {
+		t.Fatal("want error")
+	}
+	if output.Len() != 0 {
+		t.Fatalf("unexpected output: %s", output.String())
+	}
+}
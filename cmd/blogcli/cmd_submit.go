@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/blog-tutorial/cmd/blog/client"
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/x/batch"
+	"github.com/iov-one/weave/x/gov"
 )
 
 func cmdSubmitTransaction(input io.Reader, output io.Writer, args []string) error {
@@ -21,6 +22,11 @@ submitted, multiple responses can be printed out, one for each message
 submitted as part of the batch.
 
 Make sure to collect enough signatures before submitting the transaction.
+
+A signed envelope created by the prepare command can be submitted instead of a
+transaction. Before broadcasting, every signer declared in the envelope must
+have signed the transaction with the declared nonce, the nonce must not be used
+yet and the envelope must be prepared for the chain of the node.
 `)
 		fl.PrintDefaults()
 	}
@@ -30,13 +36,22 @@ Make sure to collect enough signatures before submitting the transaction.
 	)
 	fl.Parse(args)
 
-	tx, _, err := readTx(input)
+	tx, envelope, err := readTxOrEnvelope(input)
 	if err != nil {
 		return fmt.Errorf("cannot read transaction from input: %s", err)
 	}
 
 	BlogClient := client.NewClient(client.NewHTTPConnection(*tmAddrFl))
 
+	if envelope != nil {
+		if err := verifyEnvelope(BlogClient, envelope); err != nil {
+			return fmt.Errorf("invalid envelope: %s", err)
+		}
+		if tx, err = envelope.Transaction(); err != nil {
+			return err
+		}
+	}
+
 	resp := BlogClient.BroadcastTx(tx)
 	if resp.IsError(); err != nil {
 		return fmt.Errorf("cannot broadcast transaction: %s", err)
@@ -52,6 +67,31 @@ Make sure to collect enough signatures before submitting the transaction.
 	return nil
 }
 
+// verifyEnvelope checks that the envelope is completely signed and that it
+// can be accepted by the node.
+func verifyEnvelope(bc *client.BlogClient, e *txEnvelope) error {
+	if err := e.Verify(true); err != nil {
+		return err
+	}
+	chainID, err := bc.ChainID()
+	if err != nil {
+		return fmt.Errorf("cannot get chain ID: %s", err)
+	}
+	if chainID != e.ChainID {
+		return fmt.Errorf("prepared for chain %q, node runs %q", e.ChainID, chainID)
+	}
+	for _, s := range e.Signers {
+		seq, err := bc.NextNonce(s.Address)
+		if err != nil {
+			return fmt.Errorf("cannot get the next sequence number of %s: %s", s.Address, err)
+		}
+		if seq != s.Nonce {
+			return fmt.Errorf("nonce %d of %s is not the next one, expected %d", s.Nonce, s.Address, seq)
+		}
+	}
+	return nil
+}
+
 // extractResponses parse given raw response data bytes according to what is
 // expected considering the submitted transaction. It returns a human readable
 // representation of given response. It can return no data (and no error) if
@@ -113,6 +153,7 @@ var formatters = map[string]func([]byte) (string, error){
 	// add desired format as :
 	// gov.CreateTextResolutionMsg{}.Path(): fmtSequence,
 	// escrow.CreateMsg{}.Path():            fmtSequence,
+	gov.CreateProposalMsg{}.Path(): fmtSequence,
 }
 
 func fmtSequence(raw []byte) (string, error) {
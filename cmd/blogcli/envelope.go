@@ -0,0 +1,268 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+// txEnvelope is a portable container of a transaction together with
+// everything needed to sign it without network access. It is created by the
+// prepare command on a machine connected to the network, signed offline and
+// verified by the submit command before broadcasting.
+//
+// Envelope is serialized as JSON, so that its content can be reviewed before
+// signing.
+type txEnvelope struct {
+	ChainID string           `json:"chain_id"`
+	Signers []envelopeSigner `json:"signers"`
+	// Message is the decoded message of the transaction, so that it can be
+	// reviewed without decoding Tx. It must match the message of Tx.
+	Message *envelopeMessage `json:"message"`
+	// Tx is the protobuf serialized transaction, including all signatures
+	// collected so far.
+	Tx []byte `json:"tx"`
+}
+
+// envelopeMessage is a human readable representation of a transaction
+// message.
+type envelopeMessage struct {
+	Path    string          `json:"path"`
+	Content json.RawMessage `json:"content"`
+}
+
+// newEnvelopeMessage returns the representation of the message of given
+// transaction.
+func newEnvelopeMessage(tx *blog.Tx) (*envelopeMessage, error) {
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return nil, fmt.Errorf("cannot extract message: %s", err)
+	}
+	content, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot JSON serialize message: %s", err)
+	}
+	return &envelopeMessage{Path: msg.Path(), Content: content}, nil
+}
+
+// envelopeSigner declares the nonce that a signer must use.
+type envelopeSigner struct {
+	Address weave.Address `json:"address"`
+	Nonce   int64         `json:"nonce"`
+	// SignBytes is the digest the signer signs. It is provided for
+	// external signers, i.e. hardware wallets, and to cross-check the
+	// transaction content.
+	SignBytes hexBytes `json:"sign_bytes"`
+}
+
+type hexBytes []byte
+
+func (b hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("%X", []byte(b)))
+}
+
+func (b *hexBytes) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return err
+	}
+	val, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	*b = val
+	return nil
+}
+
+// newTxEnvelope returns an envelope of given transaction without any
+// signers declared.
+func newTxEnvelope(tx *blog.Tx, chainID string) (*txEnvelope, error) {
+	if !weave.IsValidChainID(chainID) {
+		return nil, fmt.Errorf("invalid chain ID %q", chainID)
+	}
+	e := &txEnvelope{ChainID: chainID}
+	if err := e.SetTransaction(tx); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Transaction returns the deserialized transaction.
+func (e *txEnvelope) Transaction() (*blog.Tx, error) {
+	var tx blog.Tx
+	if err := tx.Unmarshal(e.Tx); err != nil {
+		return nil, fmt.Errorf("cannot deserialize transaction: %s", err)
+	}
+	return &tx, nil
+}
+
+// SetTransaction replaces the transaction. Only signatures are allowed to
+// change, so that the declared sign bytes remain valid.
+func (e *txEnvelope) SetTransaction(tx *blog.Tx) error {
+	raw, err := tx.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize transaction: %s", err)
+	}
+	msg, err := newEnvelopeMessage(tx)
+	if err != nil {
+		return err
+	}
+	e.Tx = raw
+	e.Message = msg
+	return nil
+}
+
+// AddSigner declares the nonce of a signer.
+func (e *txEnvelope) AddSigner(addr weave.Address, nonce int64) error {
+	if _, ok := e.Signer(addr); ok {
+		return fmt.Errorf("signer %s is already declared", addr)
+	}
+	tx, err := e.Transaction()
+	if err != nil {
+		return err
+	}
+	signBytes, err := sigs.BuildSignBytesTx(tx, e.ChainID, nonce)
+	if err != nil {
+		return fmt.Errorf("cannot build sign bytes: %s", err)
+	}
+	e.Signers = append(e.Signers, envelopeSigner{
+		Address:   addr,
+		Nonce:     nonce,
+		SignBytes: signBytes,
+	})
+	return nil
+}
+
+// Signer returns the declaration of given signer.
+func (e *txEnvelope) Signer(addr weave.Address) (envelopeSigner, bool) {
+	for _, s := range e.Signers {
+		if s.Address.Equals(addr) {
+			return s, true
+		}
+	}
+	return envelopeSigner{}, false
+}
+
+// Verify checks that the envelope is consistent. The message and the sign
+// bytes of every signer must match the transaction and every signature of a
+// declared signer must be valid. If complete is true, every declared signer must have signed
+// the transaction.
+func (e *txEnvelope) Verify(complete bool) error {
+	if !weave.IsValidChainID(e.ChainID) {
+		return fmt.Errorf("invalid chain ID %q", e.ChainID)
+	}
+	tx, err := e.Transaction()
+	if err != nil {
+		return err
+	}
+	if err := e.verifyMessage(tx); err != nil {
+		return err
+	}
+	for _, s := range e.Signers {
+		signBytes, err := sigs.BuildSignBytesTx(tx, e.ChainID, s.Nonce)
+		if err != nil {
+			return fmt.Errorf("cannot build sign bytes of %s: %s", s.Address, err)
+		}
+		if !bytes.Equal(signBytes, s.SignBytes) {
+			return fmt.Errorf("sign bytes of %s do not match the transaction", s.Address)
+		}
+		signed := false
+		for i, sig := range tx.Signatures {
+			if err := sig.Validate(); err != nil {
+				return fmt.Errorf("invalid signature #%d: %s", i, err)
+			}
+			if !sig.Pubkey.Address().Equals(s.Address) {
+				continue
+			}
+			if sig.Sequence != s.Nonce {
+				return fmt.Errorf("signature of %s uses nonce %d instead of %d", s.Address, sig.Sequence, s.Nonce)
+			}
+			if !sig.Pubkey.Verify(signBytes, sig.Signature) {
+				return fmt.Errorf("invalid signature of %s", s.Address)
+			}
+			signed = true
+		}
+		if complete && !signed {
+			return fmt.Errorf("missing signature of %s", s.Address)
+		}
+	}
+	return nil
+}
+
+// verifyMessage checks that the declared message is the message of given
+// transaction. Both are compared in their compact JSON form, so that the
+// formatting of the envelope does not matter.
+func (e *txEnvelope) verifyMessage(tx *blog.Tx) error {
+	if e.Message == nil {
+		return errors.New("missing message")
+	}
+	want, err := newEnvelopeMessage(tx)
+	if err != nil {
+		return err
+	}
+	wantRaw, err := json.Marshal(want)
+	if err != nil {
+		return fmt.Errorf("cannot JSON serialize message: %s", err)
+	}
+	gotRaw, err := json.Marshal(e.Message)
+	if err != nil {
+		return fmt.Errorf("cannot JSON serialize message: %s", err)
+	}
+	if !bytes.Equal(wantRaw, gotRaw) {
+		return errors.New("message does not match the transaction")
+	}
+	return nil
+}
+
+// writeEnvelope writes JSON serialized envelope.
+func writeEnvelope(w io.Writer, e *txEnvelope) error {
+	pretty, err := json.MarshalIndent(e, "", "\t")
+	if err != nil {
+		return fmt.Errorf("cannot serialize envelope: %s", err)
+	}
+	_, err = fmt.Fprintln(w, string(pretty))
+	return err
+}
+
+// readTxOrEnvelope reads either a transaction serialized with writeTx or an
+// envelope serialized with writeEnvelope. Exactly one of the returned
+// transaction and envelope is not nil.
+func readTxOrEnvelope(r io.Reader) (*blog.Tx, *txEnvelope, error) {
+	if s, ok := r.(stater); ok {
+		if info, err := s.Stat(); err == nil {
+			isPipe := (info.Mode() & os.ModeCharDevice) == 0
+			if !isPipe {
+				return nil, nil, io.EOF
+			}
+		}
+	}
+
+	br := bufio.NewReader(r)
+	// A serialized transaction starts with its size, which would have to
+	// be bigger than 2GB for the first byte to be an opening brace.
+	head, err := br.Peek(1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if head[0] != '{' {
+		tx, _, err := readTx(br)
+		return tx, nil, err
+	}
+	var e txEnvelope
+	if err := json.NewDecoder(br).Decode(&e); err != nil {
+		return nil, nil, fmt.Errorf("cannot decode envelope: %s", err)
+	}
+	if len(e.Tx) == 0 {
+		return nil, nil, errors.New("envelope without transaction")
+	}
+	return nil, &e, nil
+}
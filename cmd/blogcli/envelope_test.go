@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	blog "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func sendTx(t testing.TB, memo string) *blog.Tx {
+	t.Helper()
+	return &blog.Tx{
+		Sum: &blog.Tx_CashSendMsg{
+			CashSendMsg: &cash.SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      fromHex(t, addr),
+				Destination: fromHex(t, addr),
+				Amount:      &coin.Coin{Whole: 1, Ticker: "BLOG"},
+				Memo:        memo,
+			},
+		},
+	}
+}
+
+func TestTxEnvelope(t *testing.T) {
+	keyPath := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	signer := weave.Address(fromHex(t, addr))
+
+	e, err := newTxEnvelope(sendTx(t, "envelope"), "test-chain")
+	assert.Nil(t, err)
+	assert.Nil(t, e.AddSigner(signer, 5))
+	if err := e.AddSigner(signer, 6); err == nil {
+		t.Fatal("want duplicated signer error")
+	}
+	assert.Nil(t, e.Verify(false))
+	if err := e.Verify(true); err == nil {
+		t.Fatal("want missing signature error")
+	}
+
+	var input bytes.Buffer
+	assert.Nil(t, writeEnvelope(&input, e))
+	raw := input.Bytes()
+
+	// the envelope declares the chain ID and nonce, so the node is never
+	// contacted
+	var output bytes.Buffer
+	args := []string{"-tm", "http://localhost:1", "-key", keyPath}
+	assert.Nil(t, cmdSignTransaction(bytes.NewReader(raw), &output, args))
+	tx, signed, err := readTxOrEnvelope(&output)
+	assert.Nil(t, err)
+	assert.Nil(t, tx)
+	assert.Nil(t, signed.Verify(true))
+	stx, err := signed.Transaction()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(stx.Signatures))
+	assert.Equal(t, int64(5), stx.Signatures[0].Sequence)
+
+	// a signer cannot sign the same envelope twice
+	var signedRaw bytes.Buffer
+	assert.Nil(t, writeEnvelope(&signedRaw, signed))
+	output.Reset()
+	if err := cmdSignTransaction(&signedRaw, &output, args); err == nil {
+		t.Fatal("want already signed error")
+	}
+	assert.Equal(t, 0, output.Len())
+
+	// flags must agree with the envelope
+	for _, extra := range [][]string{{"-chain-id", "other-chain"}, {"-nonce", "4"}} {
+		output.Reset()
+		if err := cmdSignTransaction(bytes.NewReader(raw), &output, append(args, extra...)); err == nil {
+			t.Fatalf("want %v mismatch error", extra)
+		}
+	}
+
+	// only a declared signer can sign
+	other := mustCreateFile(t, bytes.NewReader(client.GenPrivateKey().GetEd25519()))
+	if err := cmdSignTransaction(bytes.NewReader(raw), &output, []string{"-key", other}); err == nil {
+		t.Fatal("want undeclared signer error")
+	}
+
+	// the transaction cannot be changed once the sign bytes are declared
+	stx.GetCashSendMsg().Memo = "changed"
+	assert.Nil(t, signed.SetTransaction(stx))
+	if err := signed.Verify(false); err == nil {
+		t.Fatal("want sign bytes mismatch error")
+	}
+}
+
+func TestTxEnvelopeMessage(t *testing.T) {
+	keyPath := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+
+	e, err := newTxEnvelope(sendTx(t, "readable memo"), "test-chain")
+	assert.Nil(t, err)
+	assert.Nil(t, e.AddSigner(weave.Address(fromHex(t, addr)), 1))
+
+	var input bytes.Buffer
+	assert.Nil(t, writeEnvelope(&input, e))
+	// the message can be reviewed without decoding the transaction
+	if !strings.Contains(input.String(), `"path": "cash/send"`) {
+		t.Fatalf("message path not found in %s", input.String())
+	}
+	if !strings.Contains(input.String(), `"memo": "readable memo"`) {
+		t.Fatalf("message memo not found in %s", input.String())
+	}
+	_, e, err = readTxOrEnvelope(&input)
+	assert.Nil(t, err)
+	assert.Nil(t, e.Verify(false))
+
+	// the message must describe the transaction that is signed
+	e.Message.Content = json.RawMessage(bytes.Replace(e.Message.Content, []byte("readable memo"), []byte("other memo"), 1))
+	if err := e.Verify(false); err == nil {
+		t.Fatal("want message mismatch error")
+	}
+	var tampered bytes.Buffer
+	assert.Nil(t, writeEnvelope(&tampered, e))
+	var output bytes.Buffer
+	args := []string{"-tm", "http://localhost:1", "-key", keyPath}
+	if err := cmdSignTransaction(&tampered, &output, args); err == nil {
+		t.Fatal("want message mismatch error")
+	}
+
+	e.Message = nil
+	if err := e.Verify(false); err == nil {
+		t.Fatal("want missing message error")
+	}
+}
+
+func TestCmdSignTransactionOffline(t *testing.T) {
+	keyPath := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	var input bytes.Buffer
+	if _, err := writeTx(&input, sendTx(t, "offline")); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+	raw := input.Bytes()
+
+	cases := map[string][]string{
+		"missing chain ID": {"-nonce", "3"},
+		"missing nonce":    {"-chain-id", "test-chain"},
+	}
+	for testName, extra := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			args := append([]string{"-tm", "http://localhost:1", "-key", keyPath, "-offline"}, extra...)
+			if err := cmdSignTransaction(bytes.NewReader(raw), &output, args); err == nil {
+				t.Fatal("want error")
+			}
+		})
+	}
+
+	var output bytes.Buffer
+	args := []string{"-tm", "http://localhost:1", "-key", keyPath, "-offline", "-chain-id", "test-chain", "-nonce", "3"}
+	assert.Nil(t, cmdSignTransaction(bytes.NewReader(raw), &output, args))
+	tx, _, err := readTx(&output)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(tx.Signatures))
+	assert.Equal(t, int64(3), tx.Signatures[0].Sequence)
+}
+
+func TestCmdPrepareTransaction(t *testing.T) {
+	var input bytes.Buffer
+	if _, err := writeTx(&input, sendTx(t, "prepared")); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+	var prepared bytes.Buffer
+	assert.Nil(t, cmdPrepareTransaction(&input, &prepared, []string{"-tm", tmURL, "-signer", addr}))
+	raw := prepared.Bytes()
+
+	_, e, err := readTxOrEnvelope(bytes.NewReader(raw))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(e.Signers))
+
+	// a signer can be declared only once
+	var again bytes.Buffer
+	if err := cmdPrepareTransaction(bytes.NewReader(raw), &again, []string{"-tm", tmURL, "-signer", addr}); err == nil {
+		t.Fatal("want duplicated signer error")
+	}
+
+	var signed bytes.Buffer
+	keyPath := mustCreateFile(t, bytes.NewReader(fromHex(t, privKeyHex)))
+	assert.Nil(t, cmdSignTransaction(bytes.NewReader(raw), &signed, []string{"-key", keyPath, "-offline"}))
+	_, e, err = readTxOrEnvelope(&signed)
+	assert.Nil(t, err)
+
+	bc := client.NewClient(client.NewHTTPConnection(tmURL))
+	assert.Nil(t, verifyEnvelope(bc, e))
+
+	// a nonce that is not the next one is rejected
+	stale, err := newTxEnvelope(sendTx(t, "stale"), e.ChainID)
+	assert.Nil(t, err)
+	assert.Nil(t, stale.AddSigner(e.Signers[0].Address, e.Signers[0].Nonce+1))
+	var staleInput, staleSigned bytes.Buffer
+	assert.Nil(t, writeEnvelope(&staleInput, stale))
+	assert.Nil(t, cmdSignTransaction(&staleInput, &staleSigned, []string{"-key", keyPath}))
+	_, stale, err = readTxOrEnvelope(&staleSigned)
+	assert.Nil(t, err)
+	assert.Nil(t, stale.Verify(true))
+	if err := verifyEnvelope(bc, stale); err == nil {
+		t.Fatal("want stale nonce error")
+	}
+}
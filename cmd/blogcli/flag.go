@@ -179,6 +179,46 @@ func (b *flagseq) Set(raw string) error {
 	return nil
 }
 
+// flSeqList returns a value that is being initialized with given default
+// value and optionally overwritten by a command line argument if provided.
+// Value is a comma separated list of sequences, each using any of the formats
+// supported by flSeq.
+// If given value cannot be deserialized to required type, process is
+// terminated.
+func flSeqList(fl *flag.FlagSet, name, defaultVal, usage string) *flagseqlist {
+	var fs flagseqlist
+	if defaultVal != "" {
+		if err := fs.Set(defaultVal); err != nil {
+			flagDie("Cannot parse %q sequence list flag value. %s", name, err)
+		}
+	}
+	fl.Var(&fs, name, usage)
+	return &fs
+}
+
+type flagseqlist [][]byte
+
+func (l flagseqlist) String() string {
+	res := make([]string, len(l))
+	for i, b := range l {
+		res[i] = flagseq(b).String()
+	}
+	return strings.Join(res, ",")
+}
+
+func (l *flagseqlist) Set(raw string) error {
+	var res [][]byte
+	for _, chunk := range strings.Split(raw, ",") {
+		val, err := unpackSequence(strings.TrimSpace(chunk))
+		if err != nil {
+			return err
+		}
+		res = append(res, val)
+	}
+	*l = res
+	return nil
+}
+
 func flFraction(fl *flag.FlagSet, name, defaultVal, usage string) *flagfraction {
 	var ff flagfraction
 	if defaultVal != "" {
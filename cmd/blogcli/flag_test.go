@@ -82,6 +82,80 @@ func TestSeqFlag(t *testing.T) {
 	}
 }
 
+func TestSeqListFlag(t *testing.T) {
+	cases := map[string]struct {
+		setup     func(fl *flag.FlagSet) *flagseqlist
+		args      []string
+		wantDie   int
+		wantError bool
+		wantVal   [][]byte
+	}{
+		"use default value": {
+			setup: func(fl *flag.FlagSet) *flagseqlist {
+				return flSeqList(fl, "x", "1,2", "")
+			},
+			args:    []string{},
+			wantDie: 0,
+			wantVal: [][]byte{sequenceID(1), sequenceID(2)},
+		},
+		"no default value": {
+			setup: func(fl *flag.FlagSet) *flagseqlist {
+				return flSeqList(fl, "x", "", "")
+			},
+			args:    []string{},
+			wantDie: 0,
+			wantVal: nil,
+		},
+		"parse mixed representations": {
+			setup: func(fl *flag.FlagSet) *flagseqlist {
+				return flSeqList(fl, "x", "", "")
+			},
+			args:    []string{"-x", "3, hex:" + hex.EncodeToString(sequenceID(987654))},
+			wantDie: 0,
+			wantVal: [][]byte{sequenceID(3), sequenceID(987654)},
+		},
+		"invalid value": {
+			setup: func(fl *flag.FlagSet) *flagseqlist {
+				return flSeqList(fl, "x", "", "")
+			},
+			args:      []string{"-x", "1,,2"},
+			wantDie:   0,
+			wantError: true,
+			wantVal:   nil,
+		},
+		"invalid default value": {
+			setup: func(fl *flag.FlagSet) *flagseqlist {
+				return flSeqList(fl, "x", "xyz", "")
+			},
+			args:    []string{},
+			wantDie: 1,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			cnt, cleanup := observeFlagDie(t)
+			defer cleanup()
+
+			fl := flag.NewFlagSet("", flag.ContinueOnError)
+			fl.SetOutput(ioutil.Discard)
+			val := tc.setup(fl)
+			err := fl.Parse(tc.args)
+			if !tc.wantError {
+				assert.Nil(t, err)
+			} else if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if *cnt != tc.wantDie {
+				t.Errorf("want %d flagDie calls, got %d", tc.wantDie, cnt)
+			}
+			if tc.wantDie == 0 {
+				assert.Equal(t, tc.wantVal, [][]byte(*val))
+			}
+		})
+	}
+}
+
 func TestTimeFlag(t *testing.T) {
 	now := time.Now()
 
@@ -35,19 +35,32 @@ import (
 //
 var commands = map[string]func(input io.Reader, output io.Writer, args []string) error{
 	"as-batch":                  cmdAsBatch,
+	"as-proposal":               cmdAsProposal,
 	"as-sequence":               cmdAsSequence,
+	"check-multisig":            cmdCheckMultisig,
+	"del-proposal":              cmdDelProposal,
+	"from-json":                 cmdFromJSON,
 	"from-sequence":             cmdFromSequence,
 	"keyaddr":                   cmdKeyaddr,
+	"key":                       cmdKey,
 	"keygen":                    cmdKeygen,
 	"mnemonic":                  cmdMnemonic,
 	"multisig":                  cmdMultisig,
+	"prepare":                   cmdPrepareTransaction,
 	"query":                     cmdQuery,
 	"send-tokens":               cmdSendTokens,
 	"set-validators":            cmdSetValidators,
 	"sign":                      cmdSignTransaction,
+	"signatures":                cmdSignatures,
+	"simulate":                  cmdSimulate,
 	"submit":                    cmdSubmitTransaction,
+	"text-resolution":           cmdTextResolution,
+	"update-election-rule":      cmdUpdateElectionRule,
+	"update-electorate":         cmdUpdateElectorate,
 	"version":                   cmdVersion,
 	"view":                      cmdTransactionView,
+	"vote":                      cmdVote,
+	"with-elector":              cmdWithElector,
 	"with-fee":                  cmdWithFee,
 	"with-multisig":             cmdWithMultisig,
 	"with-multisig-participant": cmdWithMultisigParticipant,
@@ -59,6 +72,17 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"create-article":             cmdCreateArticle,
 	"delete-article":             cmdDeleteArticle,
 	"cancel-delete-article-task": cmdCancelDeleteArticleTask,
+	"hide-article":               cmdHideArticle,
+	"update-blog-configuration":  cmdUpdateBlogConfiguration,
+	"commission-article":         cmdCommissionArticle,
+	"fulfill-commission":         cmdFulfillCommission,
+	"pin-article":                cmdPinArticle,
+	"unpin-article":              cmdUnpinArticle,
+	"create-series":              cmdCreateSeries,
+	"append-series-article":      cmdAppendSeriesArticle,
+	"reorder-series":             cmdReorderSeries,
+	"remove-series-article":      cmdRemoveSeriesArticle,
+	"feed":                       cmdFeed,
 }
 
 func main() {
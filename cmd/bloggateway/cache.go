@@ -0,0 +1,66 @@
+package main
+
+import (
+	"sync"
+)
+
+// heightCache caches query results of the latest block height. All entries
+// are dropped when the chain moves to a new height, so a cached result is
+// never older than the latest block known to the cache.
+type heightCache struct {
+	mu      sync.Mutex
+	height  int64
+	entries map[string]interface{}
+	// max is the maximum number of entries kept for a single height.
+	max int
+}
+
+func newHeightCache(max int) *heightCache {
+	return &heightCache{
+		entries: make(map[string]interface{}),
+		max:     max,
+	}
+}
+
+// advance moves the cache to given height, dropping all entries if the
+// height is newer than the cached one.
+func (c *heightCache) advance(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.advanceLocked(height)
+}
+
+func (c *heightCache) advanceLocked(height int64) {
+	if height > c.height {
+		c.height = height
+		c.entries = make(map[string]interface{})
+	}
+}
+
+// load returns the cached value of given key together with the height it
+// was queried at. If the value is not cached, load calls fn to query it and
+// caches the result unless it comes from an older height. Errors are not
+// cached.
+func (c *heightCache) load(key string, fn func() (interface{}, int64, error)) (interface{}, int64, error) {
+	c.mu.Lock()
+	if v, ok := c.entries[key]; ok {
+		height := c.height
+		c.mu.Unlock()
+		return v, height, nil
+	}
+	c.mu.Unlock()
+
+	v, height, err := fn()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// A query can see a block before its header is delivered.
+	c.advanceLocked(height)
+	if height == c.height && len(c.entries) < c.max {
+		c.entries[key] = v
+	}
+	return v, height, nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestHeightCache(t *testing.T) {
+	c := newHeightCache(2)
+
+	var queries int
+	query := func(value string, height int64) func() (interface{}, int64, error) {
+		return func() (interface{}, int64, error) {
+			queries++
+			return value, height, nil
+		}
+	}
+	load := func(key string, fn func() (interface{}, int64, error)) (interface{}, int64) {
+		t.Helper()
+		v, h, err := c.load(key, fn)
+		assert.Nil(t, err)
+		return v, h
+	}
+
+	v, h := load("a", query("a1", 1))
+	assert.Equal(t, "a1", v)
+	assert.Equal(t, int64(1), h)
+
+	// served from the cache until the next block
+	v, h = load("a", query("a1 again", 1))
+	assert.Equal(t, "a1", v)
+	assert.Equal(t, int64(1), h)
+	assert.Equal(t, 1, queries)
+
+	c.advance(2)
+	v, h = load("a", query("a2", 2))
+	assert.Equal(t, "a2", v)
+	assert.Equal(t, int64(2), h)
+	assert.Equal(t, 2, queries)
+
+	// a query of a newer block moves the cache
+	v, h = load("b", query("b3", 3))
+	assert.Equal(t, "b3", v)
+	assert.Equal(t, int64(3), h)
+	v, _ = load("a", query("a3", 3))
+	assert.Equal(t, "a3", v)
+	assert.Equal(t, 4, queries)
+
+	// results of older blocks and errors are not cached
+	load("c", query("c2", 2))
+	load("c", query("c2", 2))
+	assert.Equal(t, 6, queries)
+	_, _, err := c.load("d", func() (interface{}, int64, error) {
+		queries++
+		return nil, 0, errors.ErrNotFound
+	})
+	assert.Equal(t, true, errors.ErrNotFound.Is(err))
+
+	// the cache is full, so new keys are not cached
+	load("e", query("e3", 3))
+	load("e", query("e3", 3))
+	assert.Equal(t, 9, queries)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/cmd/blog/feed"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestFeed(t *testing.T) {
+	bc := client.NewClient(client.NewLocalConnection(node))
+	poster := client.NewPoster(bc, client.GenPrivateKey(), coin.Coin{})
+
+	blogRes, err := poster.Post(client.BuildCreateBlogTx("Feed blog", "description"))
+	assert.Nil(t, err)
+	_, err = poster.Post(client.BuildCreateArticleTx(blogRes.Key, "Published article", "Some content", 0))
+	assert.Nil(t, err)
+	deleteAt := weave.AsUnixTime(time.Now().Add(time.Hour))
+	_, err = poster.Post(client.BuildCreateArticleTx(blogRes.Key, "Expiring article", "Some content", deleteAt))
+	assert.Nil(t, err)
+
+	h := feed.NewHandler(bc)
+	h.Link = "https://blog.example.com/blogs"
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	blogID := strconv.FormatUint(seq(blogRes.Key), 10)
+
+	cases := map[string]struct {
+		query       string
+		wantStatus  int
+		wantType    string
+		wantContent []string
+	}{
+		"rss": {
+			query:       "?blog=" + blogID,
+			wantStatus:  http.StatusOK,
+			wantType:    "application/rss+xml; charset=utf-8",
+			wantContent: []string{"<rss", "Published article", "Expiring article", "<ttl>", "https://blog.example.com/blogs/" + blogID},
+		},
+		"atom": {
+			query:       "?blog=" + blogID + "&format=atom",
+			wantStatus:  http.StatusOK,
+			wantType:    "application/atom+xml; charset=utf-8",
+			wantContent: []string{"<feed", "Published article"},
+		},
+		"missing blog": {
+			query:      "?blog=999999",
+			wantStatus: http.StatusNotFound,
+		},
+		"invalid format": {
+			query:      "?blog=" + blogID + "&format=json",
+			wantStatus: http.StatusBadRequest,
+		},
+		"invalid blog": {
+			query:      "?blog=first",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := http.Get(srv.URL + tc.query)
+			assert.Nil(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, tc.wantStatus, resp.StatusCode)
+			if tc.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, tc.wantType, resp.Header.Get("Content-Type"))
+			body, err := ioutil.ReadAll(resp.Body)
+			assert.Nil(t, err)
+			for _, s := range tc.wantContent {
+				if !strings.Contains(string(body), s) {
+					t.Errorf("%q not found in\n%s", s, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/cmd/blog/feed"
+	"github.com/iov-one/blog-tutorial/cmd/blog/graphql"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	varTm = flag.String("tm", env("BLOGCLI_TM_ADDR", "https://blog.NETWORK:443"),
+		"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
+	varHTTP  = flag.String("http", ":8080", "address the HTTP server listens on")
+	varCache = flag.Int("cache", 10000, "maximum number of query results cached for a single block")
+)
+
+func init() {
+	flag.CommandLine.Usage = helpMessage
+}
+
+func helpMessage() {
+	fmt.Println("bloggateway")
+	fmt.Println("          Read-only REST gateway serving blog data as JSON")
+	fmt.Println("")
+	fmt.Println("Endpoints:")
+	fmt.Println("  GET /users/{id}")
+	fmt.Println("  GET /blogs/{id}")
+	fmt.Println("  GET /blogs/{id}/articles?after={next}&limit={n}")
+	fmt.Println("  GET /addresses/{addr}/blogs")
+	fmt.Println("  GET /wallets/{addr}")
+	fmt.Println("  GET /feed?blog={id}&format=rss|atom")
+	fmt.Println("  GET|POST /graphql")
+	fmt.Println(`
+  -cache int
+        maximum number of query results cached for a single block (default 10000)
+  -http string
+        address the HTTP server listens on (default ":8080")
+  -tm string
+        Tendermint node address (default $BLOGCLI_TM_ADDR)`)
+}
+
+func main() {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).
+		With("module", "bloggateway")
+
+	flag.Parse()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bc := client.NewClient(client.NewHTTPConnection(*varTm))
+	srv := NewServer(bc, *varCache)
+
+	sm := client.NewSubscriptionManager(bc)
+	sm.OnError = func(err error) {
+		logger.Error("Following headers failed", "err", err)
+	}
+	go func() {
+		if err := srv.FollowHeaders(ctx, sm); err != nil && err != context.Canceled {
+			logger.Error("Cannot follow headers", "err", err)
+		}
+	}()
+
+	gql, err := graphql.NewHandler(bc, sm)
+	if err != nil {
+		fmt.Printf("Error: %+v\n\n", err)
+		os.Exit(1)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", srv)
+	mux.Handle("/feed", feed.NewHandler(bc))
+	mux.Handle("/graphql", gql)
+
+	httpSrv := &http.Server{Addr: *varHTTP, Handler: mux}
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		cancel()
+		shutdownCtx, done := context.WithTimeout(context.Background(), 5*time.Second)
+		defer done()
+		_ = httpSrv.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info("Listening", "addr", *varHTTP)
+	if err := httpSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		fmt.Printf("Error: %+v\n\n", err)
+		os.Exit(1)
+	}
+}
+
+// env returns the value of an environment variable if provided (even if empty)
+// or a fallback value.
+func env(name, fallback string) string {
+	if v, ok := os.LookupEnv(name); ok {
+		return v
+	}
+	return fallback
+}
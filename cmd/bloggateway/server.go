@@ -0,0 +1,337 @@
+package main
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/cash"
+)
+
+// Server is a read-only HTTP gateway serving blog data as JSON. Entities are
+// identified by their sequence numbers, for example /blogs/7.
+//
+// Query results are cached until the next block. Call FollowHeaders to
+// move the cache to every new block.
+type Server struct {
+	client *client.BlogClient
+	cache  *heightCache
+}
+
+// NewServer returns a gateway querying given client. Cache size is the
+// maximum number of query results cached for a single block.
+func NewServer(bc *client.BlogClient, cacheSize int) *Server {
+	return &Server{
+		client: bc,
+		cache:  newHeightCache(cacheSize),
+	}
+}
+
+// FollowHeaders drops cached results whenever a new block is committed,
+// until the context is cancelled.
+func (s *Server) FollowHeaders(ctx context.Context, sm *client.SubscriptionManager) error {
+	headers, err := sm.SubscribeHeaders(ctx, 0)
+	if err != nil {
+		return errors.Wrap(err, "cannot subscribe to headers")
+	}
+	for h := range headers {
+		s.cache.advance(h.Height)
+	}
+	return ctx.Err()
+}
+
+// UserResponse is the response of /users/{id}.
+type UserResponse struct {
+	Height int64      `json:"height"`
+	ID     uint64     `json:"id"`
+	User   *blog.User `json:"user"`
+}
+
+// BlogResponse is the response of /blogs/{id}.
+type BlogResponse struct {
+	Height int64      `json:"height"`
+	ID     uint64     `json:"id"`
+	Blog   *blog.Blog `json:"blog"`
+}
+
+// BlogListResponse is the response of /addresses/{addr}/blogs.
+type BlogListResponse struct {
+	Height int64          `json:"height"`
+	Blogs  []BlogListItem `json:"blogs"`
+}
+
+// BlogListItem is a single blog of a list.
+type BlogListItem struct {
+	ID   uint64     `json:"id"`
+	Blog *blog.Blog `json:"blog"`
+}
+
+// ArticleListResponse is the response of /blogs/{id}/articles. Articles are
+// ordered by their creation time and ID. Next, if set, is the after value of
+// the next page.
+type ArticleListResponse struct {
+	Height   int64             `json:"height"`
+	Articles []ArticleListItem `json:"articles"`
+	Next     string            `json:"next,omitempty"`
+}
+
+// ArticleListItem is a single article of a list.
+type ArticleListItem struct {
+	ID      uint64        `json:"id"`
+	Article *blog.Article `json:"article"`
+}
+
+// WalletResponse is the response of /wallets/{addr}.
+type WalletResponse struct {
+	Height  int64         `json:"height"`
+	Address weave.Address `json:"address"`
+	Wallet  *cash.Set     `json:"wallet"`
+}
+
+// ErrorResponse is returned with every unsuccessful status code.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeJSON(w, http.StatusMethodNotAllowed, ErrorResponse{Error: "method not allowed"})
+		return
+	}
+
+	var (
+		resp interface{}
+		err  error
+	)
+	switch path := strings.Split(strings.Trim(r.URL.Path, "/"), "/"); {
+	case len(path) == 2 && path[0] == "users":
+		resp, err = s.user(path[1])
+	case len(path) == 2 && path[0] == "blogs":
+		resp, err = s.blog(path[1])
+	case len(path) == 3 && path[0] == "blogs" && path[2] == "articles":
+		resp, err = s.articles(path[1], r.URL.Query())
+	case len(path) == 3 && path[0] == "addresses" && path[2] == "blogs":
+		resp, err = s.ownerBlogs(path[1])
+	case len(path) == 2 && path[0] == "wallets":
+		resp, err = s.wallet(path[1])
+	default:
+		err = errors.Wrap(errors.ErrNotFound, "unknown path")
+	}
+	if err != nil {
+		writeJSON(w, errorStatus(err), ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) user(id string) (*UserResponse, error) {
+	key, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	v, height, err := s.cache.load("users/"+id, func() (interface{}, int64, error) {
+		res, err := s.client.GetBlogUser(key)
+		if err != nil {
+			return nil, 0, err
+		}
+		return &res.User, res.Height, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &UserResponse{Height: height, ID: seq(key), User: v.(*blog.User)}, nil
+}
+
+func (s *Server) blog(id string) (*BlogResponse, error) {
+	key, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	v, height, err := s.cache.load("blogs/"+id, func() (interface{}, int64, error) {
+		res, err := s.client.GetBlog(key)
+		if err != nil {
+			return nil, 0, err
+		}
+		return &res.Blog, res.Height, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BlogResponse{Height: height, ID: seq(key), Blog: v.(*blog.Blog)}, nil
+}
+
+// articles returns a page of the blog articles. Pages are read with the
+// time range query of the blog articles, so that the node returns only the
+// requested page. The after cursor is the next cursor of the node time range
+// query, hex encoded. Articles that are not published, see
+// blog.Article.Published, are not listed.
+func (s *Server) articles(id string, query url.Values) (*ArticleListResponse, error) {
+	key, err := parseID(id)
+	if err != nil {
+		return nil, err
+	}
+	limit := blog.DefaultPageLimit
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > blog.MaxPageLimit {
+			return nil, errors.Wrapf(errors.ErrInput, "limit must be between 1 and %d", blog.MaxPageLimit)
+		}
+		limit = n
+	}
+	after, err := parseArticleCursor(query.Get("after"))
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := "blogs/" + id + "/articles?after=" + hex.EncodeToString(after) + "&limit=" + strconv.Itoa(limit)
+	v, height, err := s.cache.load(cacheKey, func() (interface{}, int64, error) {
+		return s.loadArticlesPage(key, after, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ArticleListResponse{
+		Height:   height,
+		Articles: v.(*articlesPage).items,
+		Next:     v.(*articlesPage).next,
+	}, nil
+}
+
+// articlesPage is a cached page of the blog articles.
+type articlesPage struct {
+	items []ArticleListItem
+	next  string
+}
+
+// loadArticlesPage queries at most limit published articles of a blog
+// following the cursor. Articles that are not published are skipped, so the
+// node is queried until the page is filled up or there are no more articles.
+func (s *Server) loadArticlesPage(blogKey, after []byte, limit int) (interface{}, int64, error) {
+	page := articlesPage{items: []ArticleListItem{}}
+	now := weave.AsUnixTime(time.Now())
+	var height int64
+	for {
+		res, err := s.client.AbciQueryTimeRange(blogKey, 0, 0, after, limit-len(page.items), false)
+		if err != nil {
+			return nil, 0, err
+		}
+		height = res.Height
+		for _, m := range res.Models {
+			var a blog.Article
+			if err := a.Unmarshal(m.Value); err != nil {
+				return nil, 0, errors.Wrap(err, "cannot unmarshal article")
+			}
+			if !a.Published(now) {
+				continue
+			}
+			page.items = append(page.items, ArticleListItem{ID: seq(a.PrimaryKey), Article: &a})
+		}
+		after = res.NextCursor
+		if after == nil || len(page.items) == limit {
+			break
+		}
+	}
+	page.next = hex.EncodeToString(after)
+	return &page, height, nil
+}
+
+// parseArticleCursor parses the after value of an article list request, a
+// hex encoded time range query cursor. An empty value selects the first page.
+func parseArticleCursor(v string) ([]byte, error) {
+	after, err := hex.DecodeString(v)
+	if err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid after %q", v)
+	}
+	return after, nil
+}
+
+func (s *Server) ownerBlogs(addr string) (*BlogListResponse, error) {
+	owner, err := weave.ParseAddress(addr)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	v, height, err := s.cache.load("addresses/"+owner.String()+"/blogs", func() (interface{}, int64, error) {
+		res, err := s.client.ListBlogsByOwner(owner)
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Blogs, res.Height, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := BlogListResponse{
+		Height: height,
+		Blogs:  []BlogListItem{},
+	}
+	for _, b := range v.([]*blog.Blog) {
+		resp.Blogs = append(resp.Blogs, BlogListItem{ID: seq(b.PrimaryKey), Blog: b})
+	}
+	return &resp, nil
+}
+
+func (s *Server) wallet(addr string) (*WalletResponse, error) {
+	address, err := weave.ParseAddress(addr)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	v, height, err := s.cache.load("wallets/"+address.String(), func() (interface{}, int64, error) {
+		res, err := s.client.GetWallet(address)
+		if err != nil {
+			return nil, 0, err
+		}
+		return &res.Wallet, res.Height, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &WalletResponse{Height: height, Address: address, Wallet: v.(*cash.Set)}, nil
+}
+
+// parseID returns the sequence key of a decimal entity ID.
+func parseID(id string) ([]byte, error) {
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil || n == 0 {
+		return nil, errors.Wrapf(errors.ErrInput, "invalid ID %q", id)
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, n)
+	return key, nil
+}
+
+// seq returns the sequence number of an entity key.
+func seq(key []byte) uint64 {
+	if len(key) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(key)
+}
+
+// errorStatus returns the HTTP status code of an error.
+func errorStatus(err error) int {
+	switch {
+	case errors.ErrNotFound.Is(err):
+		return http.StatusNotFound
+	case errors.ErrInput.Is(err), client.ErrInvalid.Is(err):
+		return http.StatusBadRequest
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client/clienttest"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestServer(t *testing.T) {
+	bc := client.NewClient(client.NewLocalConnection(node))
+	author := client.GenPrivateKey()
+	poster := client.NewPoster(bc, author, coin.Coin{})
+
+	user, err := poster.Post(client.BuildCreateUserTx("gateway", "Gateway user"))
+	assert.Nil(t, err)
+	blogRes, err := poster.Post(client.BuildCreateBlogTx("Gateway blog", "description"))
+	assert.Nil(t, err)
+	var articleIDs []uint64
+	for _, title := range []string{"First article", "Second article", "Hidden article", "Third article"} {
+		res, err := poster.Post(client.BuildCreateArticleTx(blogRes.Key, title, "Some content", 0))
+		assert.Nil(t, err)
+		if title == "Hidden article" {
+			// The faucet is the moderator of the test chain.
+			moderator := client.NewPoster(bc, faucet, coin.Coin{})
+			_, err := moderator.Post(client.BuildHideArticleTx(res.Key, true))
+			assert.Nil(t, err)
+			continue
+		}
+		articleIDs = append(articleIDs, seq(res.Key))
+	}
+
+	srv := httptest.NewServer(NewServer(bc, 100))
+	defer srv.Close()
+	userID := strconv.FormatUint(seq(user.Key), 10)
+	blogID := strconv.FormatUint(seq(blogRes.Key), 10)
+	owner := author.PublicKey().Address()
+
+	t.Run("user", func(t *testing.T) {
+		var resp UserResponse
+		get(t, srv, "/users/"+userID, http.StatusOK, &resp)
+		assert.Equal(t, seq(user.Key), resp.ID)
+		assert.Equal(t, "gateway", resp.User.Username)
+		assert.Equal(t, "Gateway user", resp.User.Bio)
+		assert.Equal(t, true, resp.Height >= user.Height)
+	})
+
+	t.Run("blog", func(t *testing.T) {
+		var resp BlogResponse
+		get(t, srv, "/blogs/"+blogID, http.StatusOK, &resp)
+		assert.Equal(t, seq(blogRes.Key), resp.ID)
+		assert.Equal(t, "Gateway blog", resp.Blog.Title)
+		assert.Equal(t, owner, resp.Blog.Owner)
+	})
+
+	t.Run("articles", func(t *testing.T) {
+		var first ArticleListResponse
+		get(t, srv, "/blogs/"+blogID+"/articles?limit=2", http.StatusOK, &first)
+		assert.Equal(t, 2, len(first.Articles))
+		assert.Equal(t, articleIDs[0], first.Articles[0].ID)
+		assert.Equal(t, "First article", first.Articles[0].Article.Title)
+		assert.Equal(t, articleIDs[1], first.Articles[1].ID)
+		assert.Equal(t, true, first.Next != "")
+
+		var second ArticleListResponse
+		get(t, srv, "/blogs/"+blogID+"/articles?limit=2&after="+first.Next, http.StatusOK, &second)
+		assert.Equal(t, 1, len(second.Articles))
+		assert.Equal(t, articleIDs[2], second.Articles[0].ID)
+		assert.Equal(t, "", second.Next)
+	})
+
+	t.Run("owner blogs", func(t *testing.T) {
+		var resp BlogListResponse
+		get(t, srv, "/addresses/"+owner.String()+"/blogs", http.StatusOK, &resp)
+		assert.Equal(t, 1, len(resp.Blogs))
+		assert.Equal(t, seq(blogRes.Key), resp.Blogs[0].ID)
+		assert.Equal(t, "Gateway blog", resp.Blogs[0].Blog.Title)
+	})
+
+	t.Run("wallet", func(t *testing.T) {
+		var resp WalletResponse
+		get(t, srv, "/wallets/"+faucet.PublicKey().Address().String(), http.StatusOK, &resp)
+		assert.Equal(t, faucet.PublicKey().Address(), resp.Address)
+		assert.Equal(t, 1, len(resp.Wallet.Coins))
+		assert.Equal(t, true, resp.Wallet.Coins[0].Equals(clienttest.InitBalance))
+	})
+
+	errCases := map[string]struct {
+		path string
+		want int
+	}{
+		"missing user":      {path: "/users/999999", want: http.StatusNotFound},
+		"invalid id":        {path: "/blogs/first", want: http.StatusBadRequest},
+		"invalid limit":     {path: "/blogs/" + blogID + "/articles?limit=1000", want: http.StatusBadRequest},
+		"invalid after":     {path: "/blogs/" + blogID + "/articles?after=7", want: http.StatusBadRequest},
+		"invalid address":   {path: "/wallets/nope", want: http.StatusBadRequest},
+		"missing wallet":    {path: "/wallets/" + owner.String(), want: http.StatusNotFound},
+		"unknown path":      {path: "/blogs/" + blogID + "/series", want: http.StatusNotFound},
+		"unknown top level": {path: "/", want: http.StatusNotFound},
+	}
+	for name, tc := range errCases {
+		t.Run(name, func(t *testing.T) {
+			var resp ErrorResponse
+			get(t, srv, tc.path, tc.want, &resp)
+			assert.Equal(t, true, resp.Error != "")
+		})
+	}
+
+	t.Run("method not allowed", func(t *testing.T) {
+		resp, err := http.Post(srv.URL+"/users/"+userID, "application/json", nil)
+		assert.Nil(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+// get requests given path and decodes the JSON response into out.
+func get(t testing.TB, srv *httptest.Server, path string, wantStatus int, out interface{}) {
+	t.Helper()
+	resp, err := http.Get(srv.URL + path)
+	if err != nil {
+		t.Fatalf("cannot get %s: %s", path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != wantStatus {
+		t.Fatalf("want %d status, got %d", wantStatus, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		t.Fatalf("cannot decode response: %s", err)
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave/errors"
+)
+
+// chainState is the part of the client used to read the blog state.
+type chainState interface {
+	AbciQueryPage(path string, after []byte, limit int, descending bool, opts ...client.QueryOption) (client.AbciResponse, error)
+}
+
+// Mismatch is a difference between the index and the chain state.
+type Mismatch struct {
+	Table string
+	Key   int64
+	// Indexed and Chain are the compared values, empty if the entity is
+	// missing.
+	Indexed string
+	Chain   string
+}
+
+func (m Mismatch) String() string {
+	switch {
+	case m.Indexed == "":
+		return fmt.Sprintf("%s %d: not indexed, chain %s", m.Table, m.Key, m.Chain)
+	case m.Chain == "":
+		return fmt.Sprintf("%s %d: not on chain, indexed %s", m.Table, m.Key, m.Indexed)
+	default:
+		return fmt.Sprintf("%s %d: indexed %s, chain %s", m.Table, m.Key, m.Indexed, m.Chain)
+	}
+}
+
+// checkedTable describes how rows of a table are compared with the models
+// returned by a paginated query. Both the query and decode must return the
+// compared values in the same order.
+type checkedTable struct {
+	name   string
+	path   string
+	query  string
+	decode func(value []byte) ([]interface{}, error)
+}
+
+var checkedTables = []checkedTable{
+	{
+		name:  "users",
+		path:  "/users",
+		query: `SELECT key, username, bio, registered_at FROM users`,
+		decode: func(value []byte) ([]interface{}, error) {
+			var u blog.User
+			if err := u.Unmarshal(value); err != nil {
+				return nil, err
+			}
+			return []interface{}{u.Username, u.Bio, int64(u.RegisteredAt)}, nil
+		},
+	},
+	{
+		name:  "blogs",
+		path:  "/blogs",
+		query: `SELECT key, owner, title, description, created_at FROM blogs`,
+		decode: func(value []byte) ([]interface{}, error) {
+			var b blog.Blog
+			if err := b.Unmarshal(value); err != nil {
+				return nil, err
+			}
+			return []interface{}{b.Owner.String(), b.Title, b.Description, int64(b.CreatedAt)}, nil
+		},
+	},
+	{
+		name:  "articles",
+		path:  "/articles",
+		query: `SELECT key, blog_key, owner, title, content, hidden, created_at, delete_at FROM articles`,
+		decode: func(value []byte) ([]interface{}, error) {
+			var a blog.Article
+			if err := a.Unmarshal(value); err != nil {
+				return nil, err
+			}
+			blogKey, err := seq(a.BlogKey)
+			if err != nil {
+				return nil, err
+			}
+			var hidden int64
+			if a.Hidden {
+				hidden = 1
+			}
+			return []interface{}{blogKey, a.Owner.String(), a.Title, a.Content, hidden, int64(a.CreatedAt), int64(a.DeleteAt)}, nil
+		},
+	},
+}
+
+// Check compares every indexed user, blog and article with the chain state
+// and returns all differences. The chain state is queried at the latest
+// height, so entities changed after the checkpoint are reported as well.
+func Check(db *sql.DB, state chainState) ([]Mismatch, error) {
+	var mismatches []Mismatch
+	for _, t := range checkedTables {
+		found, err := t.check(db, state)
+		if err != nil {
+			return nil, errors.Wrapf(err, "check %s", t.name)
+		}
+		mismatches = append(mismatches, found...)
+	}
+	return mismatches, nil
+}
+
+func (t checkedTable) check(db *sql.DB, state chainState) ([]Mismatch, error) {
+	indexed, keys, err := t.load(db)
+	if err != nil {
+		return nil, err
+	}
+
+	var mismatches []Mismatch
+	var after []byte
+	for {
+		resp, err := state.AbciQueryPage(t.path, after, 0, false)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot query chain")
+		}
+		for _, m := range resp.Models {
+			key, err := seq(m.Key[bytes.IndexByte(m.Key, ':')+1:])
+			if err != nil {
+				return nil, err
+			}
+			values, err := t.decode(m.Value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot decode %d", key)
+			}
+			chain := formatValues(values)
+			if row, ok := indexed[key]; !ok || row != chain {
+				mismatches = append(mismatches, Mismatch{Table: t.name, Key: key, Indexed: row, Chain: chain})
+			}
+			delete(indexed, key)
+		}
+		if resp.NextCursor == nil {
+			break
+		}
+		after = resp.NextCursor
+	}
+
+	for _, key := range keys {
+		if row, ok := indexed[key]; ok {
+			mismatches = append(mismatches, Mismatch{Table: t.name, Key: key, Indexed: row})
+		}
+	}
+	return mismatches, nil
+}
+
+// load returns the indexed rows formatted for comparison and their keys in
+// the ascending order.
+func (t checkedTable) load(db *sql.DB) (map[int64]string, []int64, error) {
+	rows, err := db.Query(t.query + ` ORDER BY key`)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot query index")
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot read columns")
+	}
+	indexed := make(map[int64]string)
+	var keys []int64
+	for rows.Next() {
+		var key int64
+		values := make([]interface{}, len(columns)-1)
+		dest := []interface{}{&key}
+		for i := range values {
+			dest = append(dest, &values[i])
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot read row")
+		}
+		indexed[key] = formatValues(values)
+		keys = append(keys, key)
+	}
+	return indexed, keys, errors.Wrap(rows.Err(), "cannot read rows")
+}
+
+// formatValues returns a representation of compared values that is the
+// same for a database row and a decoded model.
+func formatValues(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case string:
+			parts[i] = strconv.Quote(v)
+		case []byte:
+			parts[i] = strconv.Quote(string(v))
+		default:
+			parts[i] = fmt.Sprint(v)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCheck(t *testing.T) {
+	owner := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		indexed string
+		models  map[string][]weave.Persistent
+		want    []string
+	}{
+		"consistent": {
+			indexed: `
+				INSERT INTO users VALUES (1, 'indexed', 'Indexed user', 100, 1);
+				INSERT INTO blogs VALUES (1, '` + owner.String() + `', 'Blog', 'description', 100, 1);
+				INSERT INTO articles VALUES (1, 1, '` + owner.String() + `', 'First', 'content', 0, 100, 0, 1);
+				INSERT INTO articles VALUES (2, 1, '` + owner.String() + `', 'Second', 'content', 1, 100, 200, 1);
+			`,
+			models: map[string][]weave.Persistent{
+				"/users": {
+					&blog.User{PrimaryKey: seqKey(1), Username: "indexed", Bio: "Indexed user", RegisteredAt: 100},
+				},
+				"/blogs": {
+					&blog.Blog{PrimaryKey: seqKey(1), Owner: owner, Title: "Blog", Description: "description", CreatedAt: 100},
+				},
+				"/articles": {
+					&blog.Article{PrimaryKey: seqKey(1), BlogKey: seqKey(1), Owner: owner, Title: "First", Content: "content", CreatedAt: 100},
+					&blog.Article{PrimaryKey: seqKey(2), BlogKey: seqKey(1), Owner: owner, Title: "Second", Content: "content", Hidden: true, CreatedAt: 100, DeleteAt: 200},
+				},
+			},
+		},
+		"different values": {
+			indexed: `INSERT INTO users VALUES (1, 'indexed', 'Indexed user', 100, 1);`,
+			models: map[string][]weave.Persistent{
+				"/users": {
+					&blog.User{PrimaryKey: seqKey(1), Username: "indexed", Bio: "Changed bio", RegisteredAt: 100},
+				},
+			},
+			want: []string{`users 1: indexed "indexed" "Indexed user" 100, chain "indexed" "Changed bio" 100`},
+		},
+		"not indexed": {
+			models: map[string][]weave.Persistent{
+				"/blogs": {
+					&blog.Blog{PrimaryKey: seqKey(3), Owner: owner, Title: "Blog", Description: "description", CreatedAt: 100},
+				},
+			},
+			want: []string{`blogs 3: not indexed, chain "` + owner.String() + `" "Blog" "description" 100`},
+		},
+		"not on chain": {
+			indexed: `
+				INSERT INTO articles VALUES (1, 1, '` + owner.String() + `', 'First', 'content', 0, 100, 0, 1);
+				INSERT INTO articles VALUES (2, 1, '` + owner.String() + `', 'Second', 'content', 0, 100, 0, 1);
+			`,
+			models: map[string][]weave.Persistent{
+				"/articles": {
+					&blog.Article{PrimaryKey: seqKey(1), BlogKey: seqKey(1), Owner: owner, Title: "First", Content: "content", CreatedAt: 100},
+				},
+			},
+			want: []string{`articles 2: not on chain, indexed 1 "` + owner.String() + `" "Second" "content" 0 100 0`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			db := openTestStore(t)
+			defer db.Close()
+			if tc.indexed != "" {
+				_, err := db.Exec(tc.indexed)
+				assert.Nil(t, err)
+			}
+
+			mismatches, err := Check(db, &pagedState{t: t, models: tc.models})
+			assert.Nil(t, err)
+			var got []string
+			for _, m := range mismatches {
+				got = append(got, m.String())
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+// pagedState returns models of every path one per page, so that paging is
+// exercised.
+type pagedState struct {
+	t      testing.TB
+	models map[string][]weave.Persistent
+}
+
+func (s *pagedState) AbciQueryPage(path string, after []byte, limit int, descending bool, opts ...client.QueryOption) (client.AbciResponse, error) {
+	var bucket string
+	switch path {
+	case "/users":
+		bucket = "user"
+	case "/blogs":
+		bucket = "blog"
+	case "/articles":
+		bucket = "article"
+	default:
+		s.t.Fatalf("unexpected path %q", path)
+	}
+
+	models := s.models[path]
+	var resp client.AbciResponse
+	for i, m := range models {
+		key := pageKey(m)
+		if after != nil && string(key) <= string(after) {
+			continue
+		}
+		value, err := m.Marshal()
+		assert.Nil(s.t, err)
+		resp.Models = []weave.Model{{Key: append([]byte(bucket+":"), key...), Value: value}}
+		if i < len(models)-1 {
+			resp.NextCursor = key
+		}
+		break
+	}
+	return resp, nil
+}
+
+func pageKey(m weave.Persistent) []byte {
+	switch m := m.(type) {
+	case *blog.User:
+		return m.PrimaryKey
+	case *blog.Blog:
+		return m.PrimaryKey
+	case *blog.Article:
+		return m.PrimaryKey
+	}
+	panic("unexpected model")
+}
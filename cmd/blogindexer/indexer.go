@@ -0,0 +1,344 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Indexer writes the blog state into the SQLite tables block by block. Every
+// block is indexed in a single database transaction together with the
+// checkpoint, so indexing can be stopped at any time and resumed from the
+// last indexed block.
+//
+// Entities are created from the decoded messages of successful
+// transactions, batches are unpacked. Article deletions are read from the
+// key tags, so that deletions made by the scheduled tasks are indexed as
+// well. Users, blogs and articles changed by any other message, for example
+// by a governance proposal executed by the scheduled tally, are found in the
+// key tags too and re-read from the chain.
+type Indexer struct {
+	db       *sql.DB
+	client   *client.BlogClient
+	subs     *client.SubscriptionManager
+	entities entityReader
+}
+
+// entityReader is the part of the client used to re-read changed entities.
+// The node serves the latest state only, so a re-read entity can be newer
+// than the indexed block. Indexing the following blocks converges to the
+// same rows.
+type entityReader interface {
+	GetBlogUser(key []byte, opts ...client.QueryOption) (*client.BlogUserResponse, error)
+	GetBlog(key []byte, opts ...client.QueryOption) (*client.BlogResponse, error)
+	GetArticle(key []byte, opts ...client.QueryOption) (*client.ArticleResponse, error)
+}
+
+// NewIndexer returns an indexer writing blocks of given client into db.
+func NewIndexer(db *sql.DB, bc *client.BlogClient) *Indexer {
+	return &Indexer{
+		db:       db,
+		client:   bc,
+		subs:     client.NewSubscriptionManager(bc),
+		entities: bc,
+	}
+}
+
+// Run indexes all blocks missing in the database and then follows the chain,
+// indexing every new block until the context is cancelled.
+func (ix *Indexer) Run(ctx context.Context) error {
+	height, err := ix.client.Height()
+	if err != nil {
+		return errors.Wrap(err, "cannot get height")
+	}
+	if err := ix.Sync(ctx, height); err != nil {
+		return err
+	}
+	last, err := Checkpoint(ix.db)
+	if err != nil {
+		return err
+	}
+	if last == 0 {
+		return errors.Wrap(errors.ErrState, "chain has no blocks")
+	}
+
+	blocks, err := ix.subs.SubscribeBlocks(ctx, last)
+	if err != nil {
+		return errors.Wrap(err, "cannot subscribe to blocks")
+	}
+	for block := range blocks {
+		if err := ix.IndexBlock(block); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// Sync indexes all blocks after the checkpoint up to given height.
+func (ix *Indexer) Sync(ctx context.Context, height int64) error {
+	last, err := Checkpoint(ix.db)
+	if err != nil {
+		return err
+	}
+	for h := last + 1; h <= height; h++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		block, err := ix.client.GetBlock(h)
+		if err != nil {
+			return err
+		}
+		if err := ix.IndexBlock(block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IndexBlock writes the changes of a block and moves the checkpoint to it.
+// Blocks must be indexed in order, a block that is already indexed is
+// ignored.
+func (ix *Indexer) IndexBlock(b *client.Block) error {
+	tx, err := ix.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "cannot begin transaction")
+	}
+	defer tx.Rollback()
+
+	var last int64
+	if err := tx.QueryRow(`SELECT COALESCE(MAX(height), 0) FROM checkpoint`).Scan(&last); err != nil {
+		return errors.Wrap(err, "cannot read checkpoint")
+	}
+	switch {
+	case b.Height <= last:
+		return nil
+	case b.Height != last+1:
+		return errors.Wrapf(errors.ErrState, "block %d cannot follow block %d", b.Height, last)
+	}
+
+	if b.BeginBlock != nil {
+		for _, key := range client.DeletedArticles(b.BeginBlock.Tags) {
+			if err := deleteArticle(tx, key, b.Height, nil, true); err != nil {
+				return err
+			}
+		}
+		if err := ix.refresh(tx, b.BeginBlock.Tags, nil, b.Height); err != nil {
+			return errors.Wrapf(err, "block %d scheduled tasks", b.Height)
+		}
+	}
+	for i, raw := range b.Txs {
+		if res := b.DeliverTx[i]; !res.IsErr() {
+			if err := ix.indexTx(tx, b, raw, res); err != nil {
+				return errors.Wrapf(err, "block %d transaction %d", b.Height, i)
+			}
+		}
+	}
+
+	if err := setCheckpoint(tx, b.Height); err != nil {
+		return err
+	}
+	return errors.Wrap(tx.Commit(), "cannot commit")
+}
+
+// indexTx writes the changes of a single successful transaction.
+func (ix *Indexer) indexTx(tx *sql.Tx, b *client.Block, raw tmtypes.Tx, res *abci.ResponseDeliverTx) error {
+	decoded, err := app.TxDecoder(raw)
+	if err != nil {
+		return errors.Wrap(err, "cannot decode transaction")
+	}
+	msg, err := decoded.GetMsg()
+	if err != nil {
+		return errors.Wrap(err, "cannot get message")
+	}
+	msgs, err := client.ExecutedMsgs(msg, res.Data)
+	if err != nil {
+		return err
+	}
+	written := make(map[string]bool)
+	for _, m := range msgs {
+		bucket, key, err := indexMsg(tx, b, m, res)
+		if err != nil {
+			return err
+		}
+		if bucket != "" {
+			written[bucket+":"+string(key)] = true
+		}
+	}
+
+	for _, key := range client.DeletedArticles(res.Tags) {
+		if err := deleteArticle(tx, key, b.Height, raw.Hash(), false); err != nil {
+			return err
+		}
+	}
+	return ix.refresh(tx, res.Tags, written, b.Height)
+}
+
+// indexMsg writes the changes of a single executed message. It returns the
+// bucket and the key of the written entity, or an empty bucket if the
+// message is not indexed. All messages of a transaction share its signer,
+// so the owner tag of the transaction result applies to each of them.
+func indexMsg(tx *sql.Tx, b *client.Block, m client.ExecutedMsg, res *abci.ResponseDeliverTx) (string, []byte, error) {
+	now := int64(weave.AsUnixTime(b.Time))
+
+	switch msg := m.Msg.(type) {
+	case *blog.CreateUserMsg:
+		key, err := seq(m.Data)
+		if err != nil {
+			return "", nil, err
+		}
+		_, err = tx.Exec(`INSERT INTO users (key, username, bio, registered_at, height) VALUES (?, ?, ?, ?, ?)`,
+			key, msg.Username, msg.Bio, now, b.Height)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "cannot insert user")
+		}
+		return "user", m.Data, nil
+	case *blog.CreateBlogMsg:
+		key, err := seq(m.Data)
+		if err != nil {
+			return "", nil, err
+		}
+		_, err = tx.Exec(`INSERT INTO blogs (key, owner, title, description, created_at, height) VALUES (?, ?, ?, ?, ?, ?)`,
+			key, tagValue(res.Tags, blog.TagOwner), msg.Title, msg.Description, now, b.Height)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "cannot insert blog")
+		}
+		return "blog", m.Data, nil
+	case *blog.ChangeBlogOwnerMsg:
+		key, err := seq(msg.BlogKey)
+		if err != nil {
+			return "", nil, err
+		}
+		_, err = tx.Exec(`UPDATE blogs SET owner = ?, height = ? WHERE key = ?`,
+			msg.NewOwner.String(), b.Height, key)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "cannot update blog")
+		}
+		return "blog", msg.BlogKey, nil
+	case *blog.CreateArticleMsg:
+		key, err := seq(m.Data)
+		if err != nil {
+			return "", nil, err
+		}
+		blogKey, err := seq(msg.BlogKey)
+		if err != nil {
+			return "", nil, err
+		}
+		_, err = tx.Exec(`INSERT INTO articles (key, blog_key, owner, title, content, created_at, delete_at, height) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			key, blogKey, tagValue(res.Tags, blog.TagOwner), msg.Title, msg.Content, now, int64(msg.DeleteAt), b.Height)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "cannot insert article")
+		}
+		return "article", m.Data, nil
+	case *blog.HideArticleMsg:
+		key, err := seq(msg.ArticleKey)
+		if err != nil {
+			return "", nil, err
+		}
+		_, err = tx.Exec(`UPDATE articles SET hidden = ?, height = ? WHERE key = ?`,
+			msg.Hidden, b.Height, key)
+		if err != nil {
+			return "", nil, errors.Wrap(err, "cannot update article")
+		}
+		return "article", msg.ArticleKey, nil
+	}
+	return "", nil, nil
+}
+
+// refresh re-reads from the chain the users, blogs and articles that the
+// key tags mark as set, except those already written. Entities that no
+// longer exist are skipped, their deletion is indexed with a later block.
+func (ix *Indexer) refresh(tx *sql.Tx, tags []cmn.KVPair, written map[string]bool, height int64) error {
+	for _, c := range client.KeyChanges(tags, "user", "blog", "article") {
+		if c.Deleted || written[c.Bucket+":"+string(c.Key)] {
+			continue
+		}
+		key, err := seq(c.Key)
+		if err != nil {
+			return err
+		}
+		switch c.Bucket {
+		case "user":
+			res, err := ix.entities.GetBlogUser(c.Key)
+			if err != nil {
+				if errors.ErrNotFound.Is(err) {
+					continue
+				}
+				return errors.Wrap(err, "cannot read user")
+			}
+			u := res.User
+			_, err = tx.Exec(`INSERT OR REPLACE INTO users (key, username, bio, registered_at, height) VALUES (?, ?, ?, ?, ?)`,
+				key, u.Username, u.Bio, int64(u.RegisteredAt), height)
+			if err != nil {
+				return errors.Wrap(err, "cannot write user")
+			}
+		case "blog":
+			res, err := ix.entities.GetBlog(c.Key)
+			if err != nil {
+				if errors.ErrNotFound.Is(err) {
+					continue
+				}
+				return errors.Wrap(err, "cannot read blog")
+			}
+			bl := res.Blog
+			_, err = tx.Exec(`INSERT OR REPLACE INTO blogs (key, owner, title, description, created_at, height) VALUES (?, ?, ?, ?, ?, ?)`,
+				key, bl.Owner.String(), bl.Title, bl.Description, int64(bl.CreatedAt), height)
+			if err != nil {
+				return errors.Wrap(err, "cannot write blog")
+			}
+		case "article":
+			res, err := ix.entities.GetArticle(c.Key)
+			if err != nil {
+				if errors.ErrNotFound.Is(err) {
+					continue
+				}
+				return errors.Wrap(err, "cannot read article")
+			}
+			a := res.Article
+			blogKey, err := seq(a.BlogKey)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(`INSERT OR REPLACE INTO articles (key, blog_key, owner, title, content, hidden, created_at, delete_at, height) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+				key, blogKey, a.Owner.String(), a.Title, a.Content, a.Hidden, int64(a.CreatedAt), int64(a.DeleteAt), height)
+			if err != nil {
+				return errors.Wrap(err, "cannot write article")
+			}
+		}
+	}
+	return nil
+}
+
+// deleteArticle moves an article into the deletions table. Transaction hash
+// is nil for articles deleted by the scheduled task.
+func deleteArticle(tx *sql.Tx, articleKey []byte, height int64, txHash []byte, cron bool) error {
+	key, err := seq(articleKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`INSERT OR REPLACE INTO deletions (article_key, blog_key, title, height, tx_hash, cron)
+		SELECT key, blog_key, title, ?, ?, ? FROM articles WHERE key = ?`,
+		height, txHash, cron, key)
+	if err != nil {
+		return errors.Wrap(err, "cannot insert deletion")
+	}
+	_, err = tx.Exec(`DELETE FROM articles WHERE key = ?`, key)
+	return errors.Wrap(err, "cannot delete article")
+}
+
+// tagValue returns the value of the first tag with given key.
+func tagValue(tags []cmn.KVPair, key string) string {
+	for _, t := range tags {
+		if string(t.Key) == key {
+			return string(t.Value)
+		}
+	}
+	return ""
+}
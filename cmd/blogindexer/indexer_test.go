@@ -0,0 +1,319 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	app "github.com/iov-one/blog-tutorial/cmd/blog/app"
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/iov-one/blog-tutorial/x/blog"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/batch"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func TestIndexBlock(t *testing.T) {
+	db := openTestStore(t)
+	defer db.Close()
+	ix := &Indexer{db: db}
+
+	owner := weavetest.NewCondition().Address()
+	newOwner := weavetest.NewCondition().Address()
+	blockTime := time.Unix(1500000000, 0)
+	deleteAt := weave.AsUnixTime(blockTime.Add(time.Hour))
+
+	createTx, createRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateUserMsg{BlogCreateUserMsg: &blog.CreateUserMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Username: "indexed",
+		Bio:      "Indexed user",
+	}}}, seqKey(1))
+	blogTx, blogRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateBlogMsg{BlogCreateBlogMsg: &blog.CreateBlogMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Title:       "Indexed blog",
+		Description: "description",
+	}}}, seqKey(1), ownerTag(owner))
+	articleTx, articleRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateArticleMsg{BlogCreateArticleMsg: &blog.CreateArticleMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		BlogKey:  seqKey(1),
+		Title:    "deleted",
+		Content:  "content",
+	}}}, seqKey(1), ownerTag(owner))
+	expiringTx, expiringRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateArticleMsg{BlogCreateArticleMsg: &blog.CreateArticleMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		BlogKey:  seqKey(1),
+		Title:    "expiring",
+		Content:  "content",
+		DeleteAt: deleteAt,
+	}}}, seqKey(2), ownerTag(owner))
+	failedTx, failedRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateBlogMsg{BlogCreateBlogMsg: &blog.CreateBlogMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Title:    "Failed blog",
+	}}}, seqKey(2), ownerTag(owner))
+	failedRes.Code = errors.ErrInput.ABCICode()
+
+	hideTx, hideRes := blockTx(&app.Tx{Sum: &app.Tx_BlogHideArticleMsg{BlogHideArticleMsg: &blog.HideArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ArticleKey: seqKey(2),
+		Hidden:     true,
+	}}}, nil)
+	changeTx, changeRes := blockTx(&app.Tx{Sum: &app.Tx_BlogChangeBlogOwnerMsg{BlogChangeBlogOwnerMsg: &blog.ChangeBlogOwnerMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		BlogKey:  seqKey(1),
+		NewOwner: newOwner,
+	}}}, nil)
+	deleteTx, deleteRes := blockTx(&app.Tx{Sum: &app.Tx_BlogDeleteArticleMsg{BlogDeleteArticleMsg: &blog.DeleteArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ArticleKey: seqKey(1),
+	}}}, nil, deletedTag(seqKey(1)))
+
+	blocks := []*client.Block{
+		{
+			Height:    1,
+			Time:      blockTime,
+			Txs:       tmtypes.Txs{createTx, blogTx, articleTx, expiringTx, failedTx},
+			DeliverTx: []*abci.ResponseDeliverTx{createRes, blogRes, articleRes, expiringRes, failedRes},
+		},
+		{
+			Height:    2,
+			Time:      blockTime.Add(time.Second),
+			Txs:       tmtypes.Txs{hideTx, changeTx, deleteTx},
+			DeliverTx: []*abci.ResponseDeliverTx{hideRes, changeRes, deleteRes},
+		},
+		{
+			Height:     3,
+			Time:       deleteAt.Time(),
+			BeginBlock: &abci.ResponseBeginBlock{Tags: []cmn.KVPair{deletedTag(seqKey(2))}},
+		},
+	}
+	for _, b := range blocks {
+		assert.Nil(t, ix.IndexBlock(b))
+	}
+
+	assertRows(t, db, `SELECT key, username, bio, registered_at, height FROM users`, []string{
+		`1 "indexed" "Indexed user" 1500000000 1`,
+	})
+	assertRows(t, db, `SELECT key, owner, title, height FROM blogs`, []string{
+		`1 "` + newOwner.String() + `" "Indexed blog" 2`,
+	})
+	assertRows(t, db, `SELECT key FROM articles`, nil)
+	assertRows(t, db, `SELECT article_key, blog_key, title, height, tx_hash IS NULL, cron FROM deletions`, []string{
+		`1 1 "deleted" 2 0 0`,
+		`2 1 "expiring" 3 1 1`,
+	})
+	height, err := Checkpoint(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), height)
+
+	// indexed blocks are ignored and gaps are not allowed
+	assert.Nil(t, ix.IndexBlock(blocks[1]))
+	if err := ix.IndexBlock(&client.Block{Height: 5}); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestIndexBlockIndirectChanges(t *testing.T) {
+	db := openTestStore(t)
+	defer db.Close()
+	owner := weavetest.NewCondition().Address()
+	newOwner := weavetest.NewCondition().Address()
+	entities := &stubEntities{
+		blogs: map[string]blog.Blog{
+			string(seqKey(1)): {Owner: newOwner, Title: "Batched blog", Description: "description", CreatedAt: 1500000000},
+		},
+		articles: map[string]blog.Article{
+			string(seqKey(2)): {BlogKey: seqKey(1), Owner: owner, Title: "second", Content: "content", Hidden: true, CreatedAt: 1500000000},
+		},
+	}
+	ix := &Indexer{db: db, entities: entities}
+
+	article := func(title string) app.ExecuteBatchMsg_Union {
+		return app.ExecuteBatchMsg_Union{Sum: &app.ExecuteBatchMsg_Union_BlogCreateArticleMsg{
+			BlogCreateArticleMsg: &blog.CreateArticleMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				BlogKey:  seqKey(1),
+				Title:    title,
+				Content:  "content",
+			},
+		}}
+	}
+	results, err := (&batch.ByteArrayList{Elements: [][]byte{seqKey(1), seqKey(2)}}).Marshal()
+	assert.Nil(t, err)
+	blogTx, blogRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateBlogMsg{BlogCreateBlogMsg: &blog.CreateBlogMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Title:       "Batched blog",
+		Description: "description",
+	}}}, seqKey(1), ownerTag(owner), setTag("blog", seqKey(1)))
+	batchTx, batchRes := blockTx(&app.Tx{Sum: &app.Tx_ExecuteBatchMsg{ExecuteBatchMsg: &app.ExecuteBatchMsg{
+		Messages: []app.ExecuteBatchMsg_Union{article("first"), article("second")},
+	}}}, results, ownerTag(owner), setTag("article", seqKey(1)), setTag("article", seqKey(2)))
+
+	blocks := []*client.Block{
+		{
+			Height:    1,
+			Time:      time.Unix(1500000000, 0),
+			Txs:       tmtypes.Txs{blogTx, batchTx},
+			DeliverTx: []*abci.ResponseDeliverTx{blogRes, batchRes},
+		},
+		{
+			// A proposal executed by the scheduled tally changed the
+			// blog owner and hid the second article.
+			Height: 2,
+			Time:   time.Unix(1500000001, 0),
+			BeginBlock: &abci.ResponseBeginBlock{Tags: []cmn.KVPair{
+				setTag("blog", seqKey(1)),
+				setTag("article", seqKey(2)),
+				setTag("_i.article_blog", seqKey(2)),
+			}},
+		},
+	}
+	for _, b := range blocks {
+		assert.Nil(t, ix.IndexBlock(b))
+	}
+	// Entities written from the decoded messages are not re-read.
+	assert.Equal(t, 2, entities.reads)
+
+	assertRows(t, db, `SELECT key, owner, height FROM blogs`, []string{
+		`1 "` + newOwner.String() + `" 2`,
+	})
+	assertRows(t, db, `SELECT key, blog_key, title, hidden, height FROM articles`, []string{
+		`1 1 "first" 0 1`,
+		`2 1 "second" 1 2`,
+	})
+}
+
+func TestIndexBlockRollback(t *testing.T) {
+	db := openTestStore(t)
+	defer db.Close()
+	ix := &Indexer{db: db}
+
+	// the second user has an invalid key, so the whole block must fail
+	first, firstRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateUserMsg{BlogCreateUserMsg: &blog.CreateUserMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Username: "first",
+	}}}, seqKey(1))
+	second, secondRes := blockTx(&app.Tx{Sum: &app.Tx_BlogCreateUserMsg{BlogCreateUserMsg: &blog.CreateUserMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Username: "second",
+	}}}, []byte("invalid"))
+	block := &client.Block{
+		Height:    1,
+		Txs:       tmtypes.Txs{first, second},
+		DeliverTx: []*abci.ResponseDeliverTx{firstRes, secondRes},
+	}
+	if err := ix.IndexBlock(block); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	assertRows(t, db, `SELECT key FROM users`, nil)
+	height, err := Checkpoint(db)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), height)
+}
+
+func openTestStore(t testing.TB) *sql.DB {
+	t.Helper()
+	db, err := OpenStore(":memory:")
+	if err != nil {
+		t.Fatalf("cannot open store: %+v", err)
+	}
+	return db
+}
+
+// blockTx returns a serialized transaction and its successful result.
+func blockTx(tx *app.Tx, data []byte, tags ...cmn.KVPair) (tmtypes.Tx, *abci.ResponseDeliverTx) {
+	raw, err := tx.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return raw, &abci.ResponseDeliverTx{Data: data, Tags: tags}
+}
+
+func ownerTag(owner weave.Address) cmn.KVPair {
+	return cmn.KVPair{Key: []byte(blog.TagOwner), Value: []byte(owner.String())}
+}
+
+// deletedTag returns the key tag of a deleted article.
+func deletedTag(articleKey []byte) cmn.KVPair {
+	key := append([]byte("article:"), articleKey...)
+	return cmn.KVPair{
+		Key:   []byte(strings.ToUpper(hex.EncodeToString(key))),
+		Value: []byte("d"),
+	}
+}
+
+// setTag returns the key tag of a set entity.
+func setTag(bucket string, key []byte) cmn.KVPair {
+	raw := append([]byte(bucket+":"), key...)
+	return cmn.KVPair{
+		Key:   []byte(strings.ToUpper(hex.EncodeToString(raw))),
+		Value: []byte("s"),
+	}
+}
+
+// stubEntities serves entities from memory and counts the reads.
+type stubEntities struct {
+	users    map[string]blog.User
+	blogs    map[string]blog.Blog
+	articles map[string]blog.Article
+	reads    int
+}
+
+func (s *stubEntities) GetBlogUser(key []byte, opts ...client.QueryOption) (*client.BlogUserResponse, error) {
+	s.reads++
+	u, ok := s.users[string(key)]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	return &client.BlogUserResponse{Key: key, User: u}, nil
+}
+
+func (s *stubEntities) GetBlog(key []byte, opts ...client.QueryOption) (*client.BlogResponse, error) {
+	s.reads++
+	b, ok := s.blogs[string(key)]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	return &client.BlogResponse{Key: key, Blog: b}, nil
+}
+
+func (s *stubEntities) GetArticle(key []byte, opts ...client.QueryOption) (*client.ArticleResponse, error) {
+	s.reads++
+	a, ok := s.articles[string(key)]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	return &client.ArticleResponse{Key: key, Article: a}, nil
+}
+
+// assertRows compares rows returned by a query with their formatted values.
+func assertRows(t testing.TB, db *sql.DB, query string, want []string) {
+	t.Helper()
+	rows, err := db.Query(query + ` ORDER BY 1`)
+	if err != nil {
+		t.Fatalf("cannot query: %s", err)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	assert.Nil(t, err)
+
+	var got []string
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		dest := make([]interface{}, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		assert.Nil(t, rows.Scan(dest...))
+		got = append(got, formatValues(values))
+	}
+	assert.Nil(t, rows.Err())
+	assert.Equal(t, want, got)
+}
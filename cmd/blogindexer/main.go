@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/iov-one/blog-tutorial/cmd/blog/client"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+var (
+	varTm = flag.String("tm", env("BLOGCLI_TM_ADDR", "https://blog.NETWORK:443"),
+		"Tendermint node address. Use proper NETWORK name. You can use BLOGCLI_TM_ADDR environment variable to set it.")
+	varDB = flag.String("db", "blog.sqlite", "path of the SQLite database file")
+)
+
+func init() {
+	flag.CommandLine.Usage = helpMessage
+}
+
+func helpMessage() {
+	fmt.Println("blogindexer")
+	fmt.Println("          Blog state indexer writing into a SQLite database")
+	fmt.Println("")
+	fmt.Println("help      Print this message")
+	fmt.Println("run       Index all past blocks and follow new ones")
+	fmt.Println("sync      Index all past blocks and exit")
+	fmt.Println("check     Compare the index with the chain state")
+	fmt.Println(`
+  -db string
+        path of the SQLite database file (default "blog.sqlite")
+  -tm string
+        Tendermint node address (default $BLOGCLI_TM_ADDR)`)
+}
+
+func main() {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).
+		With("module", "blogindexer")
+
+	flag.Parse()
+	if flag.NArg() == 0 {
+		fmt.Println("Missing command:")
+		helpMessage()
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		cancel()
+	}()
+
+	var err error
+	switch cmd := flag.Arg(0); cmd {
+	case "help":
+		helpMessage()
+		return
+	case "run", "sync", "check":
+		err = withIndexer(func(ix *Indexer) error {
+			ix.subs.OnError = func(err error) {
+				logger.Error("Following blocks failed", "err", err)
+			}
+			switch cmd {
+			case "run":
+				return ix.Run(ctx)
+			case "sync":
+				return sync(ctx, ix, logger)
+			default:
+				return check(ctx, ix, logger)
+			}
+		})
+	default:
+		err = fmt.Errorf("unknown command: %s", cmd)
+	}
+
+	if err != nil && err != context.Canceled {
+		fmt.Printf("Error: %+v\n\n", err)
+		os.Exit(1)
+	}
+}
+
+// withIndexer calls fn with an indexer of the configured node and database.
+func withIndexer(fn func(*Indexer) error) error {
+	db, err := OpenStore(*varDB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	bc := client.NewClient(client.NewHTTPConnection(*varTm))
+	return fn(NewIndexer(db, bc))
+}
+
+// sync indexes all blocks up to the current height.
+func sync(ctx context.Context, ix *Indexer, logger log.Logger) error {
+	height, err := ix.client.Height()
+	if err != nil {
+		return err
+	}
+	if err := ix.Sync(ctx, height); err != nil {
+		return err
+	}
+	logger.Info("Index synced", "height", height)
+	return nil
+}
+
+// check syncs the index and compares it with the chain state. The chain
+// keeps moving while the state is compared, so a check that found
+// differences is repeated once after syncing again and only the result of
+// the second check is reported.
+func check(ctx context.Context, ix *Indexer, logger log.Logger) error {
+	var mismatches []Mismatch
+	for i := 0; i < 2; i++ {
+		if err := sync(ctx, ix, logger); err != nil {
+			return err
+		}
+		found, err := Check(ix.db, ix.client)
+		if err != nil {
+			return err
+		}
+		if mismatches = found; len(mismatches) == 0 {
+			break
+		}
+	}
+	for _, m := range mismatches {
+		logger.Error("Index differs from chain", "diff", m.String())
+	}
+	if len(mismatches) != 0 {
+		return fmt.Errorf("%d differences found", len(mismatches))
+	}
+	logger.Info("Index consistent with chain")
+	return nil
+}
+
+// env returns the value of an environment variable if provided (even if empty)
+// or a fallback value.
+func env(name, fallback string) string {
+	if v, ok := os.LookupEnv(name); ok {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/binary"
+
+	"github.com/iov-one/weave/errors"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// schema creates the tables of the index. Entity keys are stored as their
+// sequence numbers and times as unix timestamps, so that they can be used
+// for paging.
+const schema = `
+CREATE TABLE IF NOT EXISTS users (
+	key           INTEGER PRIMARY KEY,
+	username      TEXT NOT NULL,
+	bio           TEXT NOT NULL,
+	registered_at INTEGER NOT NULL,
+	height        INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS blogs (
+	key         INTEGER PRIMARY KEY,
+	owner       TEXT NOT NULL,
+	title       TEXT NOT NULL,
+	description TEXT NOT NULL,
+	created_at  INTEGER NOT NULL,
+	height      INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS blogs_owner ON blogs (owner, key);
+
+CREATE TABLE IF NOT EXISTS articles (
+	key        INTEGER PRIMARY KEY,
+	blog_key   INTEGER NOT NULL,
+	owner      TEXT NOT NULL,
+	title      TEXT NOT NULL,
+	content    TEXT NOT NULL,
+	hidden     INTEGER NOT NULL DEFAULT 0,
+	created_at INTEGER NOT NULL,
+	delete_at  INTEGER NOT NULL,
+	height     INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS articles_blog ON articles (blog_key, created_at);
+
+CREATE TABLE IF NOT EXISTS deletions (
+	article_key INTEGER PRIMARY KEY,
+	blog_key    INTEGER NOT NULL,
+	title       TEXT NOT NULL,
+	height      INTEGER NOT NULL,
+	tx_hash     BLOB,
+	cron        INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS deletions_blog ON deletions (blog_key, height);
+
+CREATE TABLE IF NOT EXISTS checkpoint (
+	id     INTEGER PRIMARY KEY CHECK (id = 0),
+	height INTEGER NOT NULL
+);
+`
+
+// OpenStore opens the SQLite database at given path, creating the index
+// tables if needed.
+func OpenStore(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot open database")
+	}
+	// A single connection serializes writers, which SQLite requires anyway.
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, errors.Wrap(err, "cannot create schema")
+	}
+	return db, nil
+}
+
+// Checkpoint returns the height of the last indexed block, or zero if
+// nothing was indexed yet.
+func Checkpoint(db *sql.DB) (int64, error) {
+	var height int64
+	err := db.QueryRow(`SELECT height FROM checkpoint WHERE id = 0`).Scan(&height)
+	switch {
+	case err == sql.ErrNoRows:
+		return 0, nil
+	case err != nil:
+		return 0, errors.Wrap(err, "cannot read checkpoint")
+	}
+	return height, nil
+}
+
+// setCheckpoint records the height of the last indexed block.
+func setCheckpoint(tx *sql.Tx, height int64) error {
+	_, err := tx.Exec(`INSERT OR REPLACE INTO checkpoint (id, height) VALUES (0, ?)`, height)
+	return errors.Wrap(err, "cannot write checkpoint")
+}
+
+// seq returns the sequence number of an entity key.
+func seq(key []byte) (int64, error) {
+	if len(key) != 8 {
+		return 0, errors.Wrapf(errors.ErrInput, "invalid sequence key %X", key)
+	}
+	return int64(binary.BigEndian.Uint64(key)), nil
+}
+
+// seqKey returns the entity key of a sequence number.
+func seqKey(n int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(n))
+	return key
+}
@@ -2,12 +2,16 @@ module github.com/iov-one/blog-tutorial
 
 require (
 	github.com/gogo/protobuf v1.2.1
+	github.com/graph-gophers/graphql-go v1.3.0
 	github.com/iov-one/weave v0.25.1
+	github.com/mattn/go-sqlite3 v1.14.0
 	github.com/pmezard/go-difflib v1.0.0
 	github.com/stellar/go v0.0.0-20190723221356-14eed5a46caf
+	github.com/tendermint/iavl v0.12.2
 	github.com/tendermint/tendermint v0.31.9
 	github.com/tyler-smith/go-bip39 v1.0.1-0.20181017060643-dbb3b84ba2ef
 	golang.org/x/crypto v0.0.0-20190513172903-22d7a77e9e5f
+	gopkg.in/yaml.v2 v2.4.0
 )
 
 go 1.13
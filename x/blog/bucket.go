@@ -98,3 +98,94 @@ func BuildBlogTimedIndex(article *Article) ([]byte, error) {
 	binary.BigEndian.PutUint64(res[8:], uint64(article.CreatedAt))
 	return res, nil
 }
+
+type CommissionBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewCommissionBucket returns a new commission bucket
+func NewCommissionBucket() *CommissionBucket {
+	return &CommissionBucket{
+		orm.NewSerialModelBucket("commission", &Commission{},
+			orm.WithIndexSerial("blog", commissionBlogIDIndexer, false),
+			orm.WithIndexSerial("requester", commissionRequesterIndexer, false),
+			orm.WithIndexSerial("article", commissionArticleIDIndexer, true)),
+	}
+}
+
+// commissionBlogIDIndexer enables querying commissions by blog ids
+func commissionBlogIDIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	commission, ok := obj.Value().(*Commission)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected commission, got %T", obj.Value())
+	}
+	return commission.BlogKey, nil
+}
+
+// commissionRequesterIndexer enables querying commissions by requester
+// addresses
+func commissionRequesterIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	commission, ok := obj.Value().(*Commission)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected commission, got %T", obj.Value())
+	}
+	return commission.Requester, nil
+}
+
+// commissionArticleIDIndexer enables querying commissions by the article that
+// fulfilled them. Pending commissions are not indexed.
+func commissionArticleIDIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	commission, ok := obj.Value().(*Commission)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected commission, got %T", obj.Value())
+	}
+	if len(commission.ArticleKey) == 0 {
+		return nil, nil
+	}
+	return commission.ArticleKey, nil
+}
+
+type SeriesBucket struct {
+	orm.SerialModelBucket
+}
+
+// NewSeriesBucket returns a new series bucket
+func NewSeriesBucket() *SeriesBucket {
+	return &SeriesBucket{
+		orm.NewSerialModelBucket("series", &Series{},
+			orm.WithIndexSerial("blog", seriesBlogIDIndexer, false)),
+	}
+}
+
+// seriesBlogIDIndexer enables querying series by blog ids
+func seriesBlogIDIndexer(obj orm.Object) ([]byte, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, nil
+	}
+	series, ok := obj.Value().(*Series)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrState, "expected series, got %T", obj.Value())
+	}
+	return series.BlogKey, nil
+}
+
+type RateLimitBucket struct {
+	orm.ModelBucket
+}
+
+// NewRateLimitBucket returns a new rate limit bucket. Rate limits are stored
+// under the address they apply to.
+func NewRateLimitBucket() *RateLimitBucket {
+	return &RateLimitBucket{
+		orm.NewModelBucket("ratelimit", &RateLimit{}),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	io "io"
 	math "math"
 )
@@ -116,6 +117,9 @@ type Blog struct {
 	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	// CreatedAt defines creation time of the blog
 	CreatedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
+	// PinnedArticleKeys is an ordered list of articles pinned by the blog owner.
+	// Pinned articles are listed before any other article of the blog.
+	PinnedArticleKeys [][]byte `protobuf:"bytes,7,rep,name=pinned_article_keys,json=pinnedArticleKeys,proto3" json:"pinned_article_keys,omitempty"`
 }
 
 func (m *Blog) Reset()         { *m = Blog{} }
@@ -193,6 +197,13 @@ func (m *Blog) GetCreatedAt() github_com_iov_one_weave.UnixTime {
 	return 0
 }
 
+func (m *Blog) GetPinnedArticleKeys() [][]byte {
+	if m != nil {
+		return m.PinnedArticleKeys
+	}
+	return nil
+}
+
 type Article struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// PrimaryKey is article's identifier
@@ -213,6 +224,9 @@ type Article struct {
 	// DeleteTaskID holds an ID of a tasks scheduled to delete this article.
 	// This value can be empty if no deletion task was scheduled.
 	DeleteTaskID []byte `protobuf:"bytes,11,opt,name=delete_task_id,json=deleteTaskId,proto3" json:"delete_task_id,omitempty"`
+	// Hidden is set when the article was hidden by a moderation decision.
+	// Hidden articles are kept in the store but must not be displayed.
+	Hidden bool `protobuf:"varint,12,opt,name=hidden,proto3" json:"hidden,omitempty"`
 }
 
 func (m *Article) Reset()         { *m = Article{} }
@@ -311,26 +325,53 @@ func (m *Article) GetDeleteTaskID() []byte {
 	return nil
 }
 
-type CreateUserMsg struct {
+func (m *Article) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+// Commission is an article requested from a blog owner. Commissioned amount
+// is locked on the commission address until the request is either fulfilled
+// by the blog owner or it times out and the requester is refunded.
+type Commission struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Username is user's alias
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// Bio is user information
-	Bio string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	// PrimaryKey is commission's identifier
+	PrimaryKey []byte `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	// BlogKey identifies blog that the article is commissioned from
+	BlogKey []byte `protobuf:"bytes,3,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
+	// Requester is the address of the commission creator. Funds are returned
+	// to this address if the commission was not fulfilled on time.
+	Requester github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=requester,proto3,casttype=github.com/iov-one/weave.Address" json:"requester,omitempty"`
+	// Amount is the price of the commissioned article
+	Amount []*coin.Coin `protobuf:"bytes,5,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Description is what the requester wants the article to be about
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// CreatedAt defines creation time of the commission
+	CreatedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
+	// Timeout defines the time after which the requester is refunded if the
+	// commission was not fulfilled.
+	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,8,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
+	// TimeoutTaskID holds an ID of a task scheduled to refund the requester.
+	TimeoutTaskID []byte `protobuf:"bytes,9,opt,name=timeout_task_id,json=timeoutTaskId,proto3" json:"timeout_task_id,omitempty"`
+	// ArticleKey identifies the article that fulfilled this commission. It is
+	// empty until the commission is fulfilled.
+	ArticleKey []byte `protobuf:"bytes,10,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
 }
 
-func (m *CreateUserMsg) Reset()         { *m = CreateUserMsg{} }
-func (m *CreateUserMsg) String() string { return proto.CompactTextString(m) }
-func (*CreateUserMsg) ProtoMessage()    {}
-func (*CreateUserMsg) Descriptor() ([]byte, []int) {
+func (m *Commission) Reset()         { *m = Commission{} }
+func (m *Commission) String() string { return proto.CompactTextString(m) }
+func (*Commission) ProtoMessage()    {}
+func (*Commission) Descriptor() ([]byte, []int) {
 	return fileDescriptor_87ea59410c2fea68, []int{3}
 }
-func (m *CreateUserMsg) XXX_Unmarshal(b []byte) error {
+func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateUserMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Commission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateUserMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Commission.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -340,59 +381,118 @@ func (m *CreateUserMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *CreateUserMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserMsg.Merge(m, src)
+func (m *Commission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Commission.Merge(m, src)
 }
-func (m *CreateUserMsg) XXX_Size() int {
+func (m *Commission) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateUserMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserMsg.DiscardUnknown(m)
+func (m *Commission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Commission.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateUserMsg proto.InternalMessageInfo
+var xxx_messageInfo_Commission proto.InternalMessageInfo
 
-func (m *CreateUserMsg) GetMetadata() *weave.Metadata {
+func (m *Commission) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateUserMsg) GetUsername() string {
+func (m *Commission) GetPrimaryKey() []byte {
 	if m != nil {
-		return m.Username
+		return m.PrimaryKey
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateUserMsg) GetBio() string {
+func (m *Commission) GetBlogKey() []byte {
 	if m != nil {
-		return m.Bio
+		return m.BlogKey
+	}
+	return nil
+}
+
+func (m *Commission) GetRequester() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Requester
+	}
+	return nil
+}
+
+func (m *Commission) GetAmount() []*coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Commission) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
 	return ""
 }
 
-type CreateBlogMsg struct {
+func (m *Commission) GetCreatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Commission) GetTimeout() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *Commission) GetTimeoutTaskID() []byte {
+	if m != nil {
+		return m.TimeoutTaskID
+	}
+	return nil
+}
+
+func (m *Commission) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
+	}
+	return nil
+}
+
+// Series is an ordered collection of articles published on a single blog, for
+// example parts of a tutorial.
+type Series struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Title is title of the blog
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// Description is description section of the blog
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// PrimaryKey is series identifier
+	PrimaryKey []byte `protobuf:"bytes,2,opt,name=pk,proto3" json:"pk,omitempty"`
+	// BlogKey identifies blog that the series and all its articles belong to
+	BlogKey []byte `protobuf:"bytes,3,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
+	// Title is title of the series
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is description section of the series
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// ArticleKeys is an ordered list of articles that are part of the series
+	ArticleKeys [][]byte `protobuf:"bytes,6,rep,name=article_keys,json=articleKeys,proto3" json:"article_keys,omitempty"`
+	// CreatedAt defines creation time of the series
+	CreatedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"created_at,omitempty"`
 }
 
-func (m *CreateBlogMsg) Reset()         { *m = CreateBlogMsg{} }
-func (m *CreateBlogMsg) String() string { return proto.CompactTextString(m) }
-func (*CreateBlogMsg) ProtoMessage()    {}
-func (*CreateBlogMsg) Descriptor() ([]byte, []int) {
+func (m *Series) Reset()         { *m = Series{} }
+func (m *Series) String() string { return proto.CompactTextString(m) }
+func (*Series) ProtoMessage()    {}
+func (*Series) Descriptor() ([]byte, []int) {
 	return fileDescriptor_87ea59410c2fea68, []int{4}
 }
-func (m *CreateBlogMsg) XXX_Unmarshal(b []byte) error {
+func (m *Series) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateBlogMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Series) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateBlogMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Series.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -402,59 +502,86 @@ func (m *CreateBlogMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *CreateBlogMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateBlogMsg.Merge(m, src)
+func (m *Series) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Series.Merge(m, src)
 }
-func (m *CreateBlogMsg) XXX_Size() int {
+func (m *Series) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateBlogMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateBlogMsg.DiscardUnknown(m)
+func (m *Series) XXX_DiscardUnknown() {
+	xxx_messageInfo_Series.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateBlogMsg proto.InternalMessageInfo
+var xxx_messageInfo_Series proto.InternalMessageInfo
 
-func (m *CreateBlogMsg) GetMetadata() *weave.Metadata {
+func (m *Series) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateBlogMsg) GetTitle() string {
+func (m *Series) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *Series) GetBlogKey() []byte {
+	if m != nil {
+		return m.BlogKey
+	}
+	return nil
+}
+
+func (m *Series) GetTitle() string {
 	if m != nil {
 		return m.Title
 	}
 	return ""
 }
 
-func (m *CreateBlogMsg) GetDescription() string {
+func (m *Series) GetDescription() string {
 	if m != nil {
 		return m.Description
 	}
 	return ""
 }
 
-type ChangeBlogOwnerMsg struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// BlogKey is the blog's primary key that is desired to change owner
-	BlogKey []byte `protobuf:"bytes,2,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
-	// Address of the new owner
-	NewOwner github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3,casttype=github.com/iov-one/weave.Address" json:"new_owner,omitempty"`
+func (m *Series) GetArticleKeys() [][]byte {
+	if m != nil {
+		return m.ArticleKeys
+	}
+	return nil
 }
 
-func (m *ChangeBlogOwnerMsg) Reset()         { *m = ChangeBlogOwnerMsg{} }
-func (m *ChangeBlogOwnerMsg) String() string { return proto.CompactTextString(m) }
-func (*ChangeBlogOwnerMsg) ProtoMessage()    {}
-func (*ChangeBlogOwnerMsg) Descriptor() ([]byte, []int) {
+func (m *Series) GetCreatedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// ResolvedSeries is a series together with all its articles, in the series
+// order. It is not stored but returned by the resolved series query.
+type ResolvedSeries struct {
+	Series   *Series    `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	Articles []*Article `protobuf:"bytes,2,rep,name=articles,proto3" json:"articles,omitempty"`
+}
+
+func (m *ResolvedSeries) Reset()         { *m = ResolvedSeries{} }
+func (m *ResolvedSeries) String() string { return proto.CompactTextString(m) }
+func (*ResolvedSeries) ProtoMessage()    {}
+func (*ResolvedSeries) Descriptor() ([]byte, []int) {
 	return fileDescriptor_87ea59410c2fea68, []int{5}
 }
-func (m *ChangeBlogOwnerMsg) XXX_Unmarshal(b []byte) error {
+func (m *ResolvedSeries) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangeBlogOwnerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResolvedSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangeBlogOwnerMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResolvedSeries.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -464,64 +591,65 @@ func (m *ChangeBlogOwnerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ChangeBlogOwnerMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeBlogOwnerMsg.Merge(m, src)
+func (m *ResolvedSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolvedSeries.Merge(m, src)
 }
-func (m *ChangeBlogOwnerMsg) XXX_Size() int {
+func (m *ResolvedSeries) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangeBlogOwnerMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeBlogOwnerMsg.DiscardUnknown(m)
+func (m *ResolvedSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolvedSeries.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeBlogOwnerMsg proto.InternalMessageInfo
-
-func (m *ChangeBlogOwnerMsg) GetMetadata() *weave.Metadata {
-	if m != nil {
-		return m.Metadata
-	}
-	return nil
-}
+var xxx_messageInfo_ResolvedSeries proto.InternalMessageInfo
 
-func (m *ChangeBlogOwnerMsg) GetBlogKey() []byte {
+func (m *ResolvedSeries) GetSeries() *Series {
 	if m != nil {
-		return m.BlogKey
+		return m.Series
 	}
 	return nil
 }
 
-func (m *ChangeBlogOwnerMsg) GetNewOwner() github_com_iov_one_weave.Address {
+func (m *ResolvedSeries) GetArticles() []*Article {
 	if m != nil {
-		return m.NewOwner
+		return m.Articles
 	}
 	return nil
 }
 
-type CreateArticleMsg struct {
+// Configuration is the blog extension configuration. It is managed by the
+// moderators, usually through a governance election rule.
+type Configuration struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// BlogKey identifies blog that article is posted to
-	BlogKey []byte `protobuf:"bytes,2,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
-	// Title is title of the article
-	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	// Content is content of the blog
-	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	// DeleteAt defines deletion time of the article.
-	// Could be nil if there is not a time of deletion, or in future
-	DeleteAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=delete_at,json=deleteAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"delete_at,omitempty"`
+	// Owner is present to implement gconf.OwnedConfig interface
+	// This defines the Address that is allowed to update the Configuration object and is
+	// needed to make use of gconf.NewUpdateConfigurationHandler
+	// Owner is also the moderation authority that can hide articles and
+	// change the owner of any blog.
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// MaxPinnedArticles is the maximum number of articles that can be pinned on
+	// a single blog. If not set, a default value is used.
+	MaxPinnedArticles int32 `protobuf:"varint,3,opt,name=max_pinned_articles,json=maxPinnedArticles,proto3" json:"max_pinned_articles,omitempty"`
+	// ArticleRateLimit is the maximum number of articles a single address can
+	// create within the rate limit window. Zero disables rate limiting.
+	ArticleRateLimit int32 `protobuf:"varint,4,opt,name=article_rate_limit,json=articleRateLimit,proto3" json:"article_rate_limit,omitempty"`
+	// RateLimitWindow is the length of the sliding window of block time that
+	// the article rate limit applies to.
+	RateLimitWindow github_com_iov_one_weave.UnixDuration `protobuf:"varint,5,opt,name=rate_limit_window,json=rateLimitWindow,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"rate_limit_window,omitempty"`
 }
 
-func (m *CreateArticleMsg) Reset()         { *m = CreateArticleMsg{} }
-func (m *CreateArticleMsg) String() string { return proto.CompactTextString(m) }
-func (*CreateArticleMsg) ProtoMessage()    {}
-func (*CreateArticleMsg) Descriptor() ([]byte, []int) {
+func (m *Configuration) Reset()         { *m = Configuration{} }
+func (m *Configuration) String() string { return proto.CompactTextString(m) }
+func (*Configuration) ProtoMessage()    {}
+func (*Configuration) Descriptor() ([]byte, []int) {
 	return fileDescriptor_87ea59410c2fea68, []int{6}
 }
-func (m *CreateArticleMsg) XXX_Unmarshal(b []byte) error {
+func (m *Configuration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateArticleMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -531,72 +659,80 @@ func (m *CreateArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *CreateArticleMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateArticleMsg.Merge(m, src)
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
 }
-func (m *CreateArticleMsg) XXX_Size() int {
+func (m *Configuration) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateArticleMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateArticleMsg.DiscardUnknown(m)
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateArticleMsg proto.InternalMessageInfo
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
 
-func (m *CreateArticleMsg) GetMetadata() *weave.Metadata {
+func (m *Configuration) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateArticleMsg) GetBlogKey() []byte {
+func (m *Configuration) GetOwner() github_com_iov_one_weave.Address {
 	if m != nil {
-		return m.BlogKey
+		return m.Owner
 	}
 	return nil
 }
 
-func (m *CreateArticleMsg) GetTitle() string {
+func (m *Configuration) GetMaxPinnedArticles() int32 {
 	if m != nil {
-		return m.Title
+		return m.MaxPinnedArticles
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateArticleMsg) GetContent() string {
+func (m *Configuration) GetArticleRateLimit() int32 {
 	if m != nil {
-		return m.Content
+		return m.ArticleRateLimit
 	}
-	return ""
+	return 0
 }
 
-func (m *CreateArticleMsg) GetDeleteAt() github_com_iov_one_weave.UnixTime {
+func (m *Configuration) GetRateLimitWindow() github_com_iov_one_weave.UnixDuration {
 	if m != nil {
-		return m.DeleteAt
+		return m.RateLimitWindow
 	}
 	return 0
 }
 
-// DeleteArticleMsg message deletes the the article instantly
-type DeleteArticleMsg struct {
+// RateLimit tracks recent actions of a single address that are subject to the
+// rate limiting. It is stored only as long as there are actions within the
+// rate limit window.
+type RateLimit struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ArticleKey is the identifier of the article that is desired to be deleted
-	ArticleKey []byte `protobuf:"bytes,2,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+	// Address is the rate limited address
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// ActionTimes are block times of the actions executed within the rate
+	// limit window, oldest first.
+	ActionTimes []github_com_iov_one_weave.UnixTime `protobuf:"varint,3,rep,packed,name=action_times,json=actionTimes,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"action_times,omitempty"`
+	// ExpireTaskID holds an ID of a task scheduled to remove expired action
+	// times.
+	ExpireTaskID []byte `protobuf:"bytes,4,opt,name=expire_task_id,json=expireTaskId,proto3" json:"expire_task_id,omitempty"`
 }
 
-func (m *DeleteArticleMsg) Reset()         { *m = DeleteArticleMsg{} }
-func (m *DeleteArticleMsg) String() string { return proto.CompactTextString(m) }
-func (*DeleteArticleMsg) ProtoMessage()    {}
-func (*DeleteArticleMsg) Descriptor() ([]byte, []int) {
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_87ea59410c2fea68, []int{7}
 }
-func (m *DeleteArticleMsg) XXX_Unmarshal(b []byte) error {
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteArticleMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -606,51 +742,73 @@ func (m *DeleteArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DeleteArticleMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteArticleMsg.Merge(m, src)
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
 }
-func (m *DeleteArticleMsg) XXX_Size() int {
+func (m *RateLimit) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeleteArticleMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteArticleMsg.DiscardUnknown(m)
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteArticleMsg proto.InternalMessageInfo
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
 
-func (m *DeleteArticleMsg) GetMetadata() *weave.Metadata {
+func (m *RateLimit) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *DeleteArticleMsg) GetArticleKey() []byte {
+func (m *RateLimit) GetAddress() github_com_iov_one_weave.Address {
 	if m != nil {
-		return m.ArticleKey
+		return m.Address
 	}
 	return nil
 }
 
-// CancelDeleteArticleTaskMsg message cancels scheduled article deletion
-type CancelDeleteArticleTaskMsg struct {
-	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ArticleKey is the identifier of the article
-	ArticleKey []byte `protobuf:"bytes,2,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+func (m *RateLimit) GetActionTimes() []github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ActionTimes
+	}
+	return nil
 }
 
-func (m *CancelDeleteArticleTaskMsg) Reset()         { *m = CancelDeleteArticleTaskMsg{} }
-func (m *CancelDeleteArticleTaskMsg) String() string { return proto.CompactTextString(m) }
-func (*CancelDeleteArticleTaskMsg) ProtoMessage()    {}
-func (*CancelDeleteArticleTaskMsg) Descriptor() ([]byte, []int) {
+func (m *RateLimit) GetExpireTaskID() []byte {
+	if m != nil {
+		return m.ExpireTaskID
+	}
+	return nil
+}
+
+// PageQuery is the data of a range query. It selects a single page of the
+// bucket entities ordered by their keys.
+type PageQuery struct {
+	// After is the key of the entity that the page starts after. It is the
+	// next cursor returned with the previous page. Empty value selects the
+	// first page.
+	After []byte `protobuf:"bytes,1,opt,name=after,proto3" json:"after,omitempty"`
+	// Limit is the maximum number of entities of a page. If not set, a default
+	// value is used. One more entity is returned if there is a next page, so
+	// that clients can tell if there is a next page.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Descending reverses the order of the entities.
+	Descending bool `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (m *PageQuery) Reset()         { *m = PageQuery{} }
+func (m *PageQuery) String() string { return proto.CompactTextString(m) }
+func (*PageQuery) ProtoMessage()    {}
+func (*PageQuery) Descriptor() ([]byte, []int) {
 	return fileDescriptor_87ea59410c2fea68, []int{8}
 }
-func (m *CancelDeleteArticleTaskMsg) XXX_Unmarshal(b []byte) error {
+func (m *PageQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CancelDeleteArticleTaskMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PageQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CancelDeleteArticleTaskMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PageQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -660,761 +818,6548 @@ func (m *CancelDeleteArticleTaskMsg) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *CancelDeleteArticleTaskMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CancelDeleteArticleTaskMsg.Merge(m, src)
+func (m *PageQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageQuery.Merge(m, src)
 }
-func (m *CancelDeleteArticleTaskMsg) XXX_Size() int {
+func (m *PageQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *CancelDeleteArticleTaskMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CancelDeleteArticleTaskMsg.DiscardUnknown(m)
+func (m *PageQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CancelDeleteArticleTaskMsg proto.InternalMessageInfo
+var xxx_messageInfo_PageQuery proto.InternalMessageInfo
 
-func (m *CancelDeleteArticleTaskMsg) GetMetadata() *weave.Metadata {
+func (m *PageQuery) GetAfter() []byte {
 	if m != nil {
-		return m.Metadata
+		return m.After
 	}
 	return nil
 }
 
-func (m *CancelDeleteArticleTaskMsg) GetArticleKey() []byte {
+func (m *PageQuery) GetLimit() uint32 {
 	if m != nil {
-		return m.ArticleKey
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func init() {
-	proto.RegisterType((*User)(nil), "blog.User")
-	proto.RegisterType((*Blog)(nil), "blog.Blog")
-	proto.RegisterType((*Article)(nil), "blog.Article")
-	proto.RegisterType((*CreateUserMsg)(nil), "blog.CreateUserMsg")
-	proto.RegisterType((*CreateBlogMsg)(nil), "blog.CreateBlogMsg")
-	proto.RegisterType((*ChangeBlogOwnerMsg)(nil), "blog.ChangeBlogOwnerMsg")
-	proto.RegisterType((*CreateArticleMsg)(nil), "blog.CreateArticleMsg")
-	proto.RegisterType((*DeleteArticleMsg)(nil), "blog.DeleteArticleMsg")
-	proto.RegisterType((*CancelDeleteArticleTaskMsg)(nil), "blog.CancelDeleteArticleTaskMsg")
-}
-
-func init() { proto.RegisterFile("x/blog/codec.proto", fileDescriptor_87ea59410c2fea68) }
-
-var fileDescriptor_87ea59410c2fea68 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6a, 0xdb, 0x40,
-	0x10, 0x8e, 0x24, 0x3b, 0xb6, 0xc6, 0x4e, 0x6a, 0x96, 0x1c, 0x84, 0x0f, 0xb2, 0x2b, 0xda, 0x62,
-	0x28, 0xb5, 0xa1, 0x85, 0x1e, 0x7a, 0xb3, 0x93, 0x4b, 0x5b, 0x42, 0x8b, 0x48, 0xce, 0x66, 0x2d,
-	0x0d, 0xca, 0xd6, 0xb6, 0xd6, 0xac, 0x36, 0x71, 0xd2, 0x77, 0x28, 0xf4, 0x41, 0xf2, 0x20, 0xbd,
-	0x14, 0x72, 0x2c, 0x14, 0x4c, 0x51, 0xde, 0x22, 0xa7, 0xb2, 0x92, 0xa2, 0x28, 0x6d, 0x13, 0x50,
-	0x9a, 0xdc, 0x66, 0xc6, 0x3b, 0x3f, 0xdf, 0x37, 0xdf, 0x58, 0x40, 0x8e, 0x07, 0x93, 0x19, 0x0f,
-	0x06, 0x1e, 0xf7, 0xd1, 0xeb, 0x2f, 0x04, 0x97, 0x9c, 0x54, 0x54, 0xa4, 0xdd, 0x28, 0x84, 0xda,
-	0x5b, 0x01, 0x0f, 0x78, 0x62, 0x0e, 0x94, 0x95, 0x46, 0x9d, 0xef, 0x1a, 0x54, 0xf6, 0x23, 0x14,
-	0xe4, 0x39, 0xd4, 0xe7, 0x28, 0xa9, 0x4f, 0x25, 0xb5, 0xb4, 0xae, 0xd6, 0x6b, 0xbc, 0x7c, 0xd4,
-	0x5f, 0x22, 0x3d, 0xc2, 0xfe, 0x6e, 0x16, 0x76, 0xf3, 0x07, 0xc4, 0x06, 0x7d, 0x31, 0xb5, 0xf4,
-	0xae, 0xd6, 0x6b, 0x8e, 0x36, 0xe3, 0x55, 0x07, 0x3e, 0x0a, 0x36, 0xa7, 0xe2, 0xe4, 0x3d, 0x9e,
-	0xb8, 0xfa, 0x62, 0x4a, 0xda, 0x50, 0x3f, 0x8c, 0x50, 0x84, 0x74, 0x8e, 0x96, 0xd1, 0xd5, 0x7a,
-	0xa6, 0x9b, 0xfb, 0xa4, 0x05, 0xc6, 0x84, 0x71, 0xab, 0x92, 0x84, 0x95, 0x49, 0xde, 0xc1, 0x86,
-	0xc0, 0x80, 0x45, 0x12, 0x05, 0xfa, 0x63, 0x2a, 0xad, 0x6a, 0x57, 0xeb, 0x19, 0xa3, 0xa7, 0x17,
-	0xab, 0xce, 0xe3, 0x80, 0xc9, 0x83, 0xc3, 0x49, 0xdf, 0xe3, 0xf3, 0x01, 0xe3, 0x47, 0x2f, 0x78,
-	0x88, 0x83, 0x74, 0xaa, 0xfd, 0x90, 0x1d, 0xef, 0xb1, 0x39, 0xba, 0xcd, 0xab, 0xdc, 0xa1, 0x74,
-	0xbe, 0xe8, 0x50, 0x19, 0xcd, 0x78, 0x70, 0xbf, 0x78, 0xde, 0x40, 0x95, 0x2f, 0x43, 0x14, 0x09,
-	0x98, 0xe6, 0xe8, 0xc9, 0xc5, 0xaa, 0xd3, 0xbd, 0x71, 0xb2, 0xa1, 0xef, 0x0b, 0x8c, 0x22, 0x37,
-	0x4d, 0x21, 0x5b, 0x50, 0x95, 0x4c, 0xce, 0x30, 0x43, 0x9c, 0x3a, 0xa4, 0x0b, 0x0d, 0x1f, 0x23,
-	0x4f, 0xb0, 0x85, 0x64, 0x3c, 0x4c, 0x10, 0x9b, 0x6e, 0x31, 0x44, 0x76, 0x00, 0x3c, 0x81, 0x54,
-	0xa6, 0x94, 0xac, 0x97, 0xa1, 0xc4, 0xcc, 0x12, 0x87, 0xd2, 0x39, 0x35, 0xa0, 0x36, 0x14, 0x92,
-	0x79, 0x33, 0xbc, 0x5f, 0x4a, 0x9e, 0x41, 0x5d, 0x69, 0x6c, 0x3c, 0xc5, 0x93, 0x8c, 0x95, 0x46,
-	0xbc, 0xea, 0xd4, 0x14, 0xf7, 0xea, 0x49, 0x6d, 0x92, 0x1a, 0x57, 0xd4, 0x55, 0xfe, 0x83, 0xba,
-	0x6a, 0x91, 0x3a, 0x0b, 0x6a, 0x1e, 0x0f, 0x25, 0x86, 0x29, 0x2b, 0xa6, 0x7b, 0xe9, 0xfe, 0x41,
-	0x99, 0x79, 0x37, 0xca, 0xc8, 0x08, 0x4c, 0x1f, 0x67, 0x28, 0x51, 0x15, 0x81, 0x32, 0x45, 0xea,
-	0x69, 0xde, 0x50, 0x92, 0xd7, 0xb0, 0x99, 0xd5, 0x90, 0x34, 0x9a, 0x8e, 0x99, 0x6f, 0x35, 0x12,
-	0xf8, 0xad, 0x78, 0xd5, 0x69, 0xee, 0x24, 0xbf, 0xec, 0xd1, 0x68, 0xfa, 0x76, 0xc7, 0x6d, 0xfa,
-	0x57, 0x9e, 0xef, 0x7c, 0x82, 0x8d, 0xed, 0x64, 0x10, 0x75, 0x93, 0xbb, 0x51, 0x49, 0x19, 0x17,
-	0xcf, 0x4e, 0xff, 0xf7, 0xd9, 0x19, 0xf9, 0xd9, 0x39, 0xf2, 0xb2, 0x97, 0xda, 0x59, 0xe9, 0x5e,
-	0xf9, 0x6e, 0xf4, 0x5b, 0x64, 0x6d, 0xfc, 0x25, 0x6b, 0xe7, 0x54, 0x03, 0xb2, 0x7d, 0x40, 0xc3,
-	0x20, 0x69, 0xfb, 0x41, 0xed, 0xb9, 0x74, 0xef, 0xa2, 0xf6, 0xf4, 0x5b, 0xb4, 0x37, 0x04, 0x33,
-	0xc4, 0xe5, 0xb8, 0xfc, 0xe9, 0xd6, 0x43, 0x5c, 0x26, 0xa3, 0x39, 0x3f, 0x35, 0x68, 0xa5, 0x2c,
-	0x65, 0x57, 0xf4, 0x60, 0xc3, 0xe6, 0x84, 0x1a, 0x37, 0x88, 0xbd, 0x72, 0x5d, 0xec, 0xd7, 0x64,
-	0x5a, 0xbd, 0x93, 0x4c, 0x9d, 0x05, 0xb4, 0x52, 0x31, 0xde, 0x15, 0xdc, 0x00, 0x1a, 0x34, 0x4d,
-	0x2d, 0xe0, 0x4b, 0xfe, 0x2e, 0xb2, 0x8a, 0x0a, 0x22, 0xd0, 0xdc, 0x76, 0x3e, 0x43, 0x7b, 0x9b,
-	0x86, 0x1e, 0xce, 0xae, 0xf5, 0x55, 0xea, 0x7f, 0xf0, 0xde, 0x23, 0xeb, 0x5b, 0x6c, 0x6b, 0x67,
-	0xb1, 0xad, 0xfd, 0x8a, 0x6d, 0xed, 0xeb, 0xb9, 0xbd, 0x76, 0x76, 0x6e, 0xaf, 0xfd, 0x38, 0xb7,
-	0xd7, 0x26, 0xeb, 0xc9, 0xc7, 0xf0, 0xd5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0xdd, 0x28,
-	0x6f, 0x4b, 0x07, 0x00, 0x00,
+func (m *PageQuery) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
 }
 
-func (m *User) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// TimeRangeQuery is the data of a range query over articles of a single blog
+// created within a time range. Articles are ordered by their creation time.
+type TimeRangeQuery struct {
+	// BlogKey is the key of the blog that the articles belong to.
+	BlogKey []byte `protobuf:"bytes,1,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
+	// From is the inclusive lower bound of the article creation time.
+	From github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=from,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"from,omitempty"`
+	// To is the exclusive upper bound of the article creation time. If not
+	// set, the range is not bounded.
+	To github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=to,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"to,omitempty"`
+	// Limit is the maximum number of articles of a page. If not set, a default
+	// value is used. One more article is returned if the range holds more
+	// articles, so that clients can tell if there is a next page.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Descending returns the most recent articles first.
+	Descending bool `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	// After is the cursor of the article that the page starts after, as
+	// returned by NextTimeRangeCursor for the previous page. Empty value
+	// selects the first page.
+	After []byte `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
 }
 
-func (m *User) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *TimeRangeQuery) Reset()         { *m = TimeRangeQuery{} }
+func (m *TimeRangeQuery) String() string { return proto.CompactTextString(m) }
+func (*TimeRangeQuery) ProtoMessage()    {}
+func (*TimeRangeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{9}
+}
+func (m *TimeRangeQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeRangeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeRangeQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
-	}
-	if len(m.PrimaryKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
-		i += copy(dAtA[i:], m.PrimaryKey)
-	}
-	if len(m.Username) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Username)))
-		i += copy(dAtA[i:], m.Username)
-	}
-	if len(m.Bio) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Bio)))
-		i += copy(dAtA[i:], m.Bio)
-	}
-	if m.RegisteredAt != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.RegisteredAt))
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *TimeRangeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeRangeQuery.Merge(m, src)
+}
+func (m *TimeRangeQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeRangeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeRangeQuery.DiscardUnknown(m)
 }
 
-func (m *Blog) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TimeRangeQuery proto.InternalMessageInfo
+
+func (m *TimeRangeQuery) GetBlogKey() []byte {
+	if m != nil {
+		return m.BlogKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Blog) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if len(m.PrimaryKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
-		i += copy(dAtA[i:], m.PrimaryKey)
-	}
-	if len(m.Owner) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
-		i += copy(dAtA[i:], m.Owner)
+func (m *TimeRangeQuery) GetFrom() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.From
 	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
+	return 0
+}
+
+func (m *TimeRangeQuery) GetTo() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.To
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	return 0
+}
+
+func (m *TimeRangeQuery) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	if m.CreatedAt != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	return 0
+}
+
+func (m *TimeRangeQuery) GetDescending() bool {
+	if m != nil {
+		return m.Descending
 	}
-	return i, nil
+	return false
 }
 
-func (m *Article) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *TimeRangeQuery) GetAfter() []byte {
+	if m != nil {
+		return m.After
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Article) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+type CreateUserMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Username is user's alias
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// Bio is user information
+	Bio string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+}
+
+func (m *CreateUserMsg) Reset()         { *m = CreateUserMsg{} }
+func (m *CreateUserMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateUserMsg) ProtoMessage()    {}
+func (*CreateUserMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{10}
+}
+func (m *CreateUserMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateUserMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateUserMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
-	}
-	if len(m.PrimaryKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
-		i += copy(dAtA[i:], m.PrimaryKey)
-	}
-	if len(m.BlogKey) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
-		i += copy(dAtA[i:], m.BlogKey)
-	}
-	if len(m.Owner) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
-		i += copy(dAtA[i:], m.Owner)
-	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
-	}
-	if len(m.Content) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Content)))
-		i += copy(dAtA[i:], m.Content)
-	}
-	if m.CreatedAt != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+		return b[:n], nil
 	}
-	if m.DeleteAt != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+}
+func (m *CreateUserMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserMsg.Merge(m, src)
+}
+func (m *CreateUserMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateUserMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateUserMsg proto.InternalMessageInfo
+
+func (m *CreateUserMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.DeleteTaskID) > 0 {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.DeleteTaskID)))
-		i += copy(dAtA[i:], m.DeleteTaskID)
+	return nil
+}
+
+func (m *CreateUserMsg) GetUsername() string {
+	if m != nil {
+		return m.Username
 	}
-	return i, nil
+	return ""
 }
 
-func (m *CreateUserMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateUserMsg) GetBio() string {
+	if m != nil {
+		return m.Bio
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+type CreateBlogMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Title is title of the blog
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is description section of the blog
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *CreateBlogMsg) Reset()         { *m = CreateBlogMsg{} }
+func (m *CreateBlogMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateBlogMsg) ProtoMessage()    {}
+func (*CreateBlogMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{11}
+}
+func (m *CreateBlogMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateBlogMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateBlogMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n4
+		return b[:n], nil
 	}
-	if len(m.Username) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Username)))
-		i += copy(dAtA[i:], m.Username)
+}
+func (m *CreateBlogMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateBlogMsg.Merge(m, src)
+}
+func (m *CreateBlogMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateBlogMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateBlogMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateBlogMsg proto.InternalMessageInfo
+
+func (m *CreateBlogMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.Bio) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Bio)))
-		i += copy(dAtA[i:], m.Bio)
+	return nil
+}
+
+func (m *CreateBlogMsg) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	return i, nil
+	return ""
 }
 
-func (m *CreateBlogMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateBlogMsg) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateBlogMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+type ChangeBlogOwnerMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// BlogKey is the blog's primary key that is desired to change owner
+	BlogKey []byte `protobuf:"bytes,2,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
+	// Address of the new owner
+	NewOwner github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3,casttype=github.com/iov-one/weave.Address" json:"new_owner,omitempty"`
+}
+
+func (m *ChangeBlogOwnerMsg) Reset()         { *m = ChangeBlogOwnerMsg{} }
+func (m *ChangeBlogOwnerMsg) String() string { return proto.CompactTextString(m) }
+func (*ChangeBlogOwnerMsg) ProtoMessage()    {}
+func (*ChangeBlogOwnerMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{12}
+}
+func (m *ChangeBlogOwnerMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeBlogOwnerMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeBlogOwnerMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n5
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
+}
+func (m *ChangeBlogOwnerMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeBlogOwnerMsg.Merge(m, src)
+}
+func (m *ChangeBlogOwnerMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeBlogOwnerMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeBlogOwnerMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeBlogOwnerMsg proto.InternalMessageInfo
+
+func (m *ChangeBlogOwnerMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	return nil
+}
+
+func (m *ChangeBlogOwnerMsg) GetBlogKey() []byte {
+	if m != nil {
+		return m.BlogKey
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ChangeBlogOwnerMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ChangeBlogOwnerMsg) GetNewOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.NewOwner
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ChangeBlogOwnerMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+type CreateArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// BlogKey identifies blog that article is posted to
+	BlogKey []byte `protobuf:"bytes,2,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
+	// Title is title of the article
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Content is content of the blog
+	Content string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// DeleteAt defines deletion time of the article.
+	// Could be nil if there is not a time of deletion, or in future
+	DeleteAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=delete_at,json=deleteAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"delete_at,omitempty"`
+}
+
+func (m *CreateArticleMsg) Reset()         { *m = CreateArticleMsg{} }
+func (m *CreateArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateArticleMsg) ProtoMessage()    {}
+func (*CreateArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{13}
+}
+func (m *CreateArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n6
-	}
-	if len(m.BlogKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
-		i += copy(dAtA[i:], m.BlogKey)
+		return b[:n], nil
 	}
-	if len(m.NewOwner) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.NewOwner)))
-		i += copy(dAtA[i:], m.NewOwner)
+}
+func (m *CreateArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateArticleMsg.Merge(m, src)
+}
+func (m *CreateArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateArticleMsg proto.InternalMessageInfo
+
+func (m *CreateArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CreateArticleMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateArticleMsg) GetBlogKey() []byte {
+	if m != nil {
+		return m.BlogKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreateArticleMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n7, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+func (m *CreateArticleMsg) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	if len(m.BlogKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
-		i += copy(dAtA[i:], m.BlogKey)
-	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
-	}
-	if len(m.Content) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Content)))
-		i += copy(dAtA[i:], m.Content)
-	}
-	if m.DeleteAt != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	return ""
+}
+
+func (m *CreateArticleMsg) GetContent() string {
+	if m != nil {
+		return m.Content
 	}
-	return i, nil
+	return ""
 }
 
-func (m *DeleteArticleMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *CreateArticleMsg) GetDeleteAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.DeleteAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DeleteArticleMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+// DeleteArticleMsg message deletes the the article instantly
+type DeleteArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ArticleKey is the identifier of the article that is desired to be deleted
+	ArticleKey []byte `protobuf:"bytes,2,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+}
+
+func (m *DeleteArticleMsg) Reset()         { *m = DeleteArticleMsg{} }
+func (m *DeleteArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*DeleteArticleMsg) ProtoMessage()    {}
+func (*DeleteArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{14}
+}
+func (m *DeleteArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n8
+		return b[:n], nil
 	}
-	if len(m.ArticleKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
-		i += copy(dAtA[i:], m.ArticleKey)
+}
+func (m *DeleteArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteArticleMsg.Merge(m, src)
+}
+func (m *DeleteArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteArticleMsg proto.InternalMessageInfo
+
+func (m *DeleteArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CancelDeleteArticleTaskMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *DeleteArticleMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CancelDeleteArticleTaskMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+// CancelDeleteArticleTaskMsg message cancels scheduled article deletion
+type CancelDeleteArticleTaskMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ArticleKey is the identifier of the article
+	ArticleKey []byte `protobuf:"bytes,2,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+}
+
+func (m *CancelDeleteArticleTaskMsg) Reset()         { *m = CancelDeleteArticleTaskMsg{} }
+func (m *CancelDeleteArticleTaskMsg) String() string { return proto.CompactTextString(m) }
+func (*CancelDeleteArticleTaskMsg) ProtoMessage()    {}
+func (*CancelDeleteArticleTaskMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{15}
+}
+func (m *CancelDeleteArticleTaskMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelDeleteArticleTaskMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelDeleteArticleTaskMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n9
-	}
-	if len(m.ArticleKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
-		i += copy(dAtA[i:], m.ArticleKey)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *CancelDeleteArticleTaskMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelDeleteArticleTaskMsg.Merge(m, src)
+}
+func (m *CancelDeleteArticleTaskMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelDeleteArticleTaskMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelDeleteArticleTaskMsg.DiscardUnknown(m)
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CancelDeleteArticleTaskMsg proto.InternalMessageInfo
+
+func (m *CancelDeleteArticleTaskMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return nil
 }
-func (m *User) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+
+func (m *CancelDeleteArticleTaskMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
 	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+// HideArticleMsg message changes the visibility of an article. It can be
+// executed only by the moderators.
+type HideArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ArticleKey is the identifier of the article
+	ArticleKey []byte `protobuf:"bytes,2,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+	// Hidden is the new visibility state of the article. Use false to revert
+	// an earlier moderation decision.
+	Hidden bool `protobuf:"varint,3,opt,name=hidden,proto3" json:"hidden,omitempty"`
+}
+
+func (m *HideArticleMsg) Reset()         { *m = HideArticleMsg{} }
+func (m *HideArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*HideArticleMsg) ProtoMessage()    {}
+func (*HideArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{16}
+}
+func (m *HideArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HideArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HideArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Bio)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *HideArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HideArticleMsg.Merge(m, src)
+}
+func (m *HideArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *HideArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_HideArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HideArticleMsg proto.InternalMessageInfo
+
+func (m *HideArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.RegisteredAt != 0 {
-		n += 1 + sovCodec(uint64(m.RegisteredAt))
+	return nil
+}
+
+func (m *HideArticleMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
 	}
-	return n
+	return nil
 }
 
-func (m *Blog) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HideArticleMsg) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return false
+}
+
+// UpdateConfigurationMsg is used by the gconf extension to update the
+// configuration.
+type UpdateConfigurationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
+func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigurationMsg) ProtoMessage()    {}
+func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{17}
+}
+func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovCodec(uint64(m.CreatedAt))
-	}
-	return n
+}
+func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+}
+func (m *UpdateConfigurationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
 }
 
-func (m *Article) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.PrimaryKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.BlogKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovCodec(uint64(m.CreatedAt))
-	}
-	if m.DeleteAt != 0 {
-		n += 1 + sovCodec(uint64(m.DeleteAt))
-	}
-	l = len(m.DeleteTaskID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+
+func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return n
+	return nil
 }
 
-func (m *CreateUserMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Bio)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+	if m != nil {
+		return m.Patch
 	}
-	return n
+	return nil
 }
 
-func (m *CreateBlogMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	return n
+// CommissionArticleMsg message requests an article from a blog owner. Given
+// amount is taken from the main signer and locked until the commission is
+// fulfilled or timed out.
+type CommissionArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// BlogKey identifies blog that the article is commissioned from
+	BlogKey []byte `protobuf:"bytes,2,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
+	// Amount is the price of the commissioned article
+	Amount []*coin.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Description is what the requester wants the article to be about
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Timeout defines the time after which the requester is refunded if the
+	// commission was not fulfilled.
+	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
 }
 
-func (m *ChangeBlogOwnerMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommissionArticleMsg) Reset()         { *m = CommissionArticleMsg{} }
+func (m *CommissionArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*CommissionArticleMsg) ProtoMessage()    {}
+func (*CommissionArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{18}
+}
+func (m *CommissionArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *CommissionArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionArticleMsg.Merge(m, src)
+}
+func (m *CommissionArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionArticleMsg proto.InternalMessageInfo
+
+func (m *CommissionArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.BlogKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *CommissionArticleMsg) GetBlogKey() []byte {
+	if m != nil {
+		return m.BlogKey
 	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *CommissionArticleMsg) GetAmount() []*coin.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return nil
 }
 
-func (m *CreateArticleMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CommissionArticleMsg) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	return ""
+}
+
+func (m *CommissionArticleMsg) GetTimeout() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.Timeout
 	}
-	l = len(m.BlogKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return 0
+}
+
+// FulfillCommissionMsg message links an article to the commission and
+// releases the commissioned amount to the blog owner.
+type FulfillCommissionMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// CommissionKey is the identifier of the commission
+	CommissionKey []byte `protobuf:"bytes,2,opt,name=commission_key,json=commissionKey,proto3" json:"commission_key,omitempty"`
+	// ArticleKey is the identifier of the article that fulfills the commission
+	ArticleKey []byte `protobuf:"bytes,3,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+}
+
+func (m *FulfillCommissionMsg) Reset()         { *m = FulfillCommissionMsg{} }
+func (m *FulfillCommissionMsg) String() string { return proto.CompactTextString(m) }
+func (*FulfillCommissionMsg) ProtoMessage()    {}
+func (*FulfillCommissionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{19}
+}
+func (m *FulfillCommissionMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FulfillCommissionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FulfillCommissionMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *FulfillCommissionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FulfillCommissionMsg.Merge(m, src)
+}
+func (m *FulfillCommissionMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *FulfillCommissionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_FulfillCommissionMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FulfillCommissionMsg proto.InternalMessageInfo
+
+func (m *FulfillCommissionMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *FulfillCommissionMsg) GetCommissionKey() []byte {
+	if m != nil {
+		return m.CommissionKey
 	}
-	if m.DeleteAt != 0 {
-		n += 1 + sovCodec(uint64(m.DeleteAt))
+	return nil
+}
+
+func (m *FulfillCommissionMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
 	}
-	return n
+	return nil
 }
 
-func (m *DeleteArticleMsg) Size() (n int) {
-	if m == nil {
-		return 0
+// RefundCommissionMsg message returns the commissioned amount to the
+// requester. It is executed by the cron only, when the commission times out.
+type RefundCommissionMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// CommissionKey is the identifier of the commission
+	CommissionKey []byte `protobuf:"bytes,2,opt,name=commission_key,json=commissionKey,proto3" json:"commission_key,omitempty"`
+}
+
+func (m *RefundCommissionMsg) Reset()         { *m = RefundCommissionMsg{} }
+func (m *RefundCommissionMsg) String() string { return proto.CompactTextString(m) }
+func (*RefundCommissionMsg) ProtoMessage()    {}
+func (*RefundCommissionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{20}
+}
+func (m *RefundCommissionMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundCommissionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundCommissionMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+}
+func (m *RefundCommissionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundCommissionMsg.Merge(m, src)
+}
+func (m *RefundCommissionMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundCommissionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundCommissionMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundCommissionMsg proto.InternalMessageInfo
+
+func (m *RefundCommissionMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = len(m.ArticleKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+
+func (m *RefundCommissionMsg) GetCommissionKey() []byte {
+	if m != nil {
+		return m.CommissionKey
 	}
-	return n
+	return nil
+}
+
+// PinArticleMsg message appends an article to the pinned articles list of
+// its blog. It can be executed only by the blog owner.
+type PinArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ArticleKey is the identifier of the article
+	ArticleKey []byte `protobuf:"bytes,2,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+}
+
+func (m *PinArticleMsg) Reset()         { *m = PinArticleMsg{} }
+func (m *PinArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*PinArticleMsg) ProtoMessage()    {}
+func (*PinArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{21}
+}
+func (m *PinArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PinArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PinArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PinArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinArticleMsg.Merge(m, src)
+}
+func (m *PinArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *PinArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinArticleMsg proto.InternalMessageInfo
+
+func (m *PinArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *PinArticleMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
+	}
+	return nil
+}
+
+// UnpinArticleMsg message removes an article from the pinned articles list of
+// its blog. It can be executed only by the blog owner.
+type UnpinArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ArticleKey is the identifier of the article
+	ArticleKey []byte `protobuf:"bytes,2,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+}
+
+func (m *UnpinArticleMsg) Reset()         { *m = UnpinArticleMsg{} }
+func (m *UnpinArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*UnpinArticleMsg) ProtoMessage()    {}
+func (*UnpinArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{22}
+}
+func (m *UnpinArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnpinArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnpinArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnpinArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinArticleMsg.Merge(m, src)
+}
+func (m *UnpinArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnpinArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinArticleMsg proto.InternalMessageInfo
+
+func (m *UnpinArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *UnpinArticleMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
+	}
+	return nil
+}
+
+// CreateSeriesMsg message creates a new series on a blog. It can be executed
+// only by the blog owner.
+type CreateSeriesMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// BlogKey identifies blog that the series is created on
+	BlogKey []byte `protobuf:"bytes,2,opt,name=blog_key,json=blogKey,proto3" json:"blog_key,omitempty"`
+	// Title is title of the series
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// Description is description section of the series
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// ArticleKeys is an optional, ordered list of the series articles
+	ArticleKeys [][]byte `protobuf:"bytes,5,rep,name=article_keys,json=articleKeys,proto3" json:"article_keys,omitempty"`
+}
+
+func (m *CreateSeriesMsg) Reset()         { *m = CreateSeriesMsg{} }
+func (m *CreateSeriesMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateSeriesMsg) ProtoMessage()    {}
+func (*CreateSeriesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{23}
+}
+func (m *CreateSeriesMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateSeriesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateSeriesMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateSeriesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSeriesMsg.Merge(m, src)
+}
+func (m *CreateSeriesMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateSeriesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSeriesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateSeriesMsg proto.InternalMessageInfo
+
+func (m *CreateSeriesMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateSeriesMsg) GetBlogKey() []byte {
+	if m != nil {
+		return m.BlogKey
+	}
+	return nil
+}
+
+func (m *CreateSeriesMsg) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CreateSeriesMsg) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CreateSeriesMsg) GetArticleKeys() [][]byte {
+	if m != nil {
+		return m.ArticleKeys
+	}
+	return nil
+}
+
+// AppendSeriesArticleMsg message adds an article at the end of the series.
+type AppendSeriesArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// SeriesKey is the identifier of the series
+	SeriesKey []byte `protobuf:"bytes,2,opt,name=series_key,json=seriesKey,proto3" json:"series_key,omitempty"`
+	// ArticleKey is the identifier of the article
+	ArticleKey []byte `protobuf:"bytes,3,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+}
+
+func (m *AppendSeriesArticleMsg) Reset()         { *m = AppendSeriesArticleMsg{} }
+func (m *AppendSeriesArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*AppendSeriesArticleMsg) ProtoMessage()    {}
+func (*AppendSeriesArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{24}
+}
+func (m *AppendSeriesArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AppendSeriesArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AppendSeriesArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AppendSeriesArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppendSeriesArticleMsg.Merge(m, src)
+}
+func (m *AppendSeriesArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *AppendSeriesArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppendSeriesArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppendSeriesArticleMsg proto.InternalMessageInfo
+
+func (m *AppendSeriesArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *AppendSeriesArticleMsg) GetSeriesKey() []byte {
+	if m != nil {
+		return m.SeriesKey
+	}
+	return nil
+}
+
+func (m *AppendSeriesArticleMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
+	}
+	return nil
+}
+
+// ReorderSeriesMsg message changes the order of the series articles. Given
+// list must contain exactly the articles of the series.
+type ReorderSeriesMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// SeriesKey is the identifier of the series
+	SeriesKey []byte `protobuf:"bytes,2,opt,name=series_key,json=seriesKey,proto3" json:"series_key,omitempty"`
+	// ArticleKeys is the new order of the series articles
+	ArticleKeys [][]byte `protobuf:"bytes,3,rep,name=article_keys,json=articleKeys,proto3" json:"article_keys,omitempty"`
+}
+
+func (m *ReorderSeriesMsg) Reset()         { *m = ReorderSeriesMsg{} }
+func (m *ReorderSeriesMsg) String() string { return proto.CompactTextString(m) }
+func (*ReorderSeriesMsg) ProtoMessage()    {}
+func (*ReorderSeriesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{25}
+}
+func (m *ReorderSeriesMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReorderSeriesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReorderSeriesMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReorderSeriesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReorderSeriesMsg.Merge(m, src)
+}
+func (m *ReorderSeriesMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReorderSeriesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReorderSeriesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReorderSeriesMsg proto.InternalMessageInfo
+
+func (m *ReorderSeriesMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReorderSeriesMsg) GetSeriesKey() []byte {
+	if m != nil {
+		return m.SeriesKey
+	}
+	return nil
+}
+
+func (m *ReorderSeriesMsg) GetArticleKeys() [][]byte {
+	if m != nil {
+		return m.ArticleKeys
+	}
+	return nil
+}
+
+// RemoveSeriesArticleMsg message removes an article from the series. The
+// article itself is not deleted.
+type RemoveSeriesArticleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// SeriesKey is the identifier of the series
+	SeriesKey []byte `protobuf:"bytes,2,opt,name=series_key,json=seriesKey,proto3" json:"series_key,omitempty"`
+	// ArticleKey is the identifier of the article
+	ArticleKey []byte `protobuf:"bytes,3,opt,name=article_key,json=articleKey,proto3" json:"article_key,omitempty"`
+}
+
+func (m *RemoveSeriesArticleMsg) Reset()         { *m = RemoveSeriesArticleMsg{} }
+func (m *RemoveSeriesArticleMsg) String() string { return proto.CompactTextString(m) }
+func (*RemoveSeriesArticleMsg) ProtoMessage()    {}
+func (*RemoveSeriesArticleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{26}
+}
+func (m *RemoveSeriesArticleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveSeriesArticleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveSeriesArticleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveSeriesArticleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSeriesArticleMsg.Merge(m, src)
+}
+func (m *RemoveSeriesArticleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveSeriesArticleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSeriesArticleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSeriesArticleMsg proto.InternalMessageInfo
+
+func (m *RemoveSeriesArticleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RemoveSeriesArticleMsg) GetSeriesKey() []byte {
+	if m != nil {
+		return m.SeriesKey
+	}
+	return nil
+}
+
+func (m *RemoveSeriesArticleMsg) GetArticleKey() []byte {
+	if m != nil {
+		return m.ArticleKey
+	}
+	return nil
+}
+
+// ExpireRateLimitMsg message removes action times that are outside of the
+// rate limit window. It is executed by the cron only.
+type ExpireRateLimitMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Address is the rate limited address
+	Address github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+}
+
+func (m *ExpireRateLimitMsg) Reset()         { *m = ExpireRateLimitMsg{} }
+func (m *ExpireRateLimitMsg) String() string { return proto.CompactTextString(m) }
+func (*ExpireRateLimitMsg) ProtoMessage()    {}
+func (*ExpireRateLimitMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87ea59410c2fea68, []int{27}
+}
+func (m *ExpireRateLimitMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpireRateLimitMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpireRateLimitMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpireRateLimitMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpireRateLimitMsg.Merge(m, src)
+}
+func (m *ExpireRateLimitMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpireRateLimitMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpireRateLimitMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpireRateLimitMsg proto.InternalMessageInfo
+
+func (m *ExpireRateLimitMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ExpireRateLimitMsg) GetAddress() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*User)(nil), "blog.User")
+	proto.RegisterType((*Blog)(nil), "blog.Blog")
+	proto.RegisterType((*Article)(nil), "blog.Article")
+	proto.RegisterType((*Commission)(nil), "blog.Commission")
+	proto.RegisterType((*Series)(nil), "blog.Series")
+	proto.RegisterType((*ResolvedSeries)(nil), "blog.ResolvedSeries")
+	proto.RegisterType((*Configuration)(nil), "blog.Configuration")
+	proto.RegisterType((*RateLimit)(nil), "blog.RateLimit")
+	proto.RegisterType((*PageQuery)(nil), "blog.PageQuery")
+	proto.RegisterType((*TimeRangeQuery)(nil), "blog.TimeRangeQuery")
+	proto.RegisterType((*CreateUserMsg)(nil), "blog.CreateUserMsg")
+	proto.RegisterType((*CreateBlogMsg)(nil), "blog.CreateBlogMsg")
+	proto.RegisterType((*ChangeBlogOwnerMsg)(nil), "blog.ChangeBlogOwnerMsg")
+	proto.RegisterType((*CreateArticleMsg)(nil), "blog.CreateArticleMsg")
+	proto.RegisterType((*DeleteArticleMsg)(nil), "blog.DeleteArticleMsg")
+	proto.RegisterType((*CancelDeleteArticleTaskMsg)(nil), "blog.CancelDeleteArticleTaskMsg")
+	proto.RegisterType((*HideArticleMsg)(nil), "blog.HideArticleMsg")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "blog.UpdateConfigurationMsg")
+	proto.RegisterType((*CommissionArticleMsg)(nil), "blog.CommissionArticleMsg")
+	proto.RegisterType((*FulfillCommissionMsg)(nil), "blog.FulfillCommissionMsg")
+	proto.RegisterType((*RefundCommissionMsg)(nil), "blog.RefundCommissionMsg")
+	proto.RegisterType((*PinArticleMsg)(nil), "blog.PinArticleMsg")
+	proto.RegisterType((*UnpinArticleMsg)(nil), "blog.UnpinArticleMsg")
+	proto.RegisterType((*CreateSeriesMsg)(nil), "blog.CreateSeriesMsg")
+	proto.RegisterType((*AppendSeriesArticleMsg)(nil), "blog.AppendSeriesArticleMsg")
+	proto.RegisterType((*ReorderSeriesMsg)(nil), "blog.ReorderSeriesMsg")
+	proto.RegisterType((*RemoveSeriesArticleMsg)(nil), "blog.RemoveSeriesArticleMsg")
+	proto.RegisterType((*ExpireRateLimitMsg)(nil), "blog.ExpireRateLimitMsg")
+}
+
+func init() { proto.RegisterFile("x/blog/codec.proto", fileDescriptor_87ea59410c2fea68) }
+
+var fileDescriptor_87ea59410c2fea68 = []byte{
+	// 1366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x0e, 0x49, 0x3d, 0x8f, 0x24, 0x5b, 0xa6, 0x7d, 0x03, 0x5e, 0x2f, 0x24, 0x5d, 0x22, 0xb9,
+	0x50, 0x70, 0x73, 0x65, 0xc0, 0x45, 0x8b, 0xa6, 0x8b, 0x16, 0x92, 0x9d, 0x22, 0x6d, 0x1a, 0xd4,
+	0x9d, 0xc6, 0xc8, 0x52, 0x18, 0x8b, 0x63, 0x79, 0x6a, 0x91, 0xc3, 0x92, 0x23, 0x3f, 0x8a, 0x6e,
+	0xba, 0xe9, 0xba, 0x7f, 0xa1, 0x40, 0x97, 0x7d, 0xfc, 0x89, 0x2e, 0xba, 0x29, 0x10, 0x14, 0x5d,
+	0x14, 0x28, 0x20, 0x14, 0xca, 0xaa, 0xdb, 0x2e, 0xbd, 0x28, 0x8a, 0x99, 0xa1, 0x44, 0x4a, 0x69,
+	0x62, 0x53, 0x49, 0x9c, 0xee, 0xe6, 0xc1, 0x39, 0x73, 0xce, 0x77, 0xe6, 0x7c, 0xf3, 0x0d, 0xc1,
+	0x3c, 0xd9, 0xd8, 0x1b, 0xb0, 0xfe, 0x46, 0x8f, 0x39, 0xa4, 0xd7, 0xf2, 0x03, 0xc6, 0x99, 0x99,
+	0x11, 0x23, 0xeb, 0xa5, 0xc4, 0xd0, 0x7a, 0xb5, 0xc7, 0xa8, 0x97, 0xfc, 0x68, 0x7d, 0xad, 0xcf,
+	0xfa, 0x4c, 0x36, 0x37, 0x44, 0x4b, 0x8d, 0xda, 0x3f, 0x6a, 0x90, 0xd9, 0x0d, 0x49, 0x60, 0xfe,
+	0x0f, 0x0a, 0x2e, 0xe1, 0xd8, 0xc1, 0x1c, 0x5b, 0x5a, 0x43, 0x6b, 0x96, 0x36, 0x97, 0x5b, 0xc7,
+	0x04, 0x1f, 0x91, 0xd6, 0xbd, 0x68, 0x18, 0x4d, 0x3f, 0x30, 0x6b, 0xa0, 0xfb, 0x87, 0x96, 0xde,
+	0xd0, 0x9a, 0xe5, 0xce, 0xd2, 0x78, 0x54, 0x87, 0x9d, 0x80, 0xba, 0x38, 0x38, 0xbd, 0x4b, 0x4e,
+	0x91, 0xee, 0x1f, 0x9a, 0xeb, 0x50, 0x18, 0x86, 0x24, 0xf0, 0xb0, 0x4b, 0x2c, 0xa3, 0xa1, 0x35,
+	0x8b, 0x68, 0xda, 0x37, 0xab, 0x60, 0xec, 0x51, 0x66, 0x65, 0xe4, 0xb0, 0x68, 0x9a, 0xef, 0x42,
+	0x25, 0x20, 0x7d, 0x1a, 0x72, 0x12, 0x10, 0xa7, 0x8b, 0xb9, 0x95, 0x6d, 0x68, 0x4d, 0xa3, 0x73,
+	0xfd, 0x6c, 0x54, 0xff, 0x4f, 0x9f, 0xf2, 0x83, 0xe1, 0x5e, 0xab, 0xc7, 0xdc, 0x0d, 0xca, 0x8e,
+	0xfe, 0xcf, 0x3c, 0xb2, 0xa1, 0xbc, 0xda, 0xf5, 0xe8, 0xc9, 0x7d, 0xea, 0x12, 0x54, 0x8e, 0xd7,
+	0xb6, 0xb9, 0xfd, 0xb3, 0x0e, 0x99, 0xce, 0x80, 0xf5, 0x9f, 0x6f, 0x3c, 0x6f, 0x40, 0x96, 0x1d,
+	0x7b, 0x24, 0x90, 0xc1, 0x94, 0x3b, 0xd7, 0xce, 0x46, 0xf5, 0xc6, 0x13, 0x3d, 0x6b, 0x3b, 0x4e,
+	0x40, 0xc2, 0x10, 0xa9, 0x25, 0xe6, 0x1a, 0x64, 0x39, 0xe5, 0x03, 0x12, 0x45, 0xac, 0x3a, 0x66,
+	0x03, 0x4a, 0x0e, 0x09, 0x7b, 0x01, 0xf5, 0x39, 0x65, 0x9e, 0x8c, 0xb8, 0x88, 0x92, 0x43, 0xe6,
+	0x36, 0x40, 0x2f, 0x20, 0x98, 0x2b, 0x48, 0x72, 0x69, 0x20, 0x29, 0x46, 0x0b, 0xdb, 0xdc, 0xbc,
+	0x0d, 0xab, 0x3e, 0xf5, 0x3c, 0x61, 0x24, 0xe0, 0xb4, 0x37, 0x20, 0xdd, 0x43, 0x72, 0x1a, 0x5a,
+	0xf9, 0x86, 0xd1, 0x2c, 0x77, 0xfe, 0x35, 0x1e, 0xd5, 0x57, 0x76, 0xe4, 0x74, 0x5b, 0xcd, 0xde,
+	0x25, 0xa7, 0x21, 0x5a, 0xf1, 0xe7, 0x87, 0xec, 0xef, 0x0d, 0xc8, 0x47, 0xfd, 0xe7, 0x8b, 0xec,
+	0x7f, 0xa1, 0x20, 0x0e, 0xaf, 0xf0, 0x2a, 0x02, 0xb7, 0x34, 0x1e, 0xd5, 0xf3, 0x22, 0x85, 0xe2,
+	0x93, 0xfc, 0x9e, 0x6a, 0xc4, 0x19, 0xc8, 0x3c, 0x43, 0x06, 0xb2, 0xc9, 0x0c, 0x58, 0x90, 0xef,
+	0x31, 0x8f, 0x13, 0x4f, 0x81, 0x5b, 0x44, 0x93, 0xee, 0x1c, 0xf2, 0xc5, 0x05, 0x91, 0xef, 0x40,
+	0xd1, 0x21, 0x03, 0xc2, 0x89, 0x30, 0x02, 0x69, 0x8c, 0x14, 0xd4, 0xba, 0x36, 0x37, 0x5f, 0x83,
+	0xa5, 0xc8, 0x06, 0xc7, 0xe1, 0x61, 0x97, 0x3a, 0x56, 0x49, 0x86, 0x5f, 0x1d, 0x8f, 0xea, 0xe5,
+	0x6d, 0x39, 0x73, 0x1f, 0x87, 0x87, 0xef, 0x6c, 0xa3, 0xb2, 0x13, 0xf7, 0x1c, 0xf3, 0x2a, 0xe4,
+	0x0e, 0xa8, 0xe3, 0x10, 0xcf, 0x2a, 0x37, 0xb4, 0x66, 0x01, 0x45, 0x3d, 0xfb, 0x4f, 0x03, 0x60,
+	0x8b, 0xb9, 0x2e, 0x0d, 0x43, 0x71, 0xc4, 0x5e, 0x4a, 0x26, 0x3b, 0x50, 0x0c, 0xc8, 0xc7, 0x43,
+	0x12, 0xf2, 0x94, 0xd9, 0x8c, 0x97, 0x99, 0x36, 0xe4, 0xb0, 0xcb, 0x86, 0x9e, 0xa0, 0x0a, 0xa3,
+	0x59, 0xda, 0x84, 0x96, 0xa0, 0xbb, 0xd6, 0x16, 0xa3, 0x1e, 0x8a, 0x66, 0xe6, 0x2b, 0x2c, 0x77,
+	0x5e, 0x85, 0xe5, 0x17, 0xcc, 0xf3, 0x5b, 0x90, 0xe7, 0xd4, 0x25, 0x6c, 0xc8, 0xad, 0x42, 0x1a,
+	0x13, 0x93, 0x55, 0xe6, 0x2d, 0x58, 0x8e, 0x9a, 0xd3, 0x2c, 0x17, 0x25, 0x2c, 0x2b, 0xe3, 0x51,
+	0xbd, 0x72, 0x5f, 0x4d, 0x45, 0x69, 0xae, 0xf0, 0x44, 0xd7, 0x31, 0x37, 0xa0, 0x94, 0x28, 0x6b,
+	0x0b, 0xe2, 0xe4, 0xc4, 0xc5, 0x8b, 0x00, 0x4f, 0xdb, 0xf6, 0x77, 0x3a, 0xe4, 0x3e, 0x24, 0x01,
+	0x25, 0xe1, 0xcb, 0x49, 0xfe, 0xa2, 0x64, 0xb8, 0x09, 0xe5, 0x19, 0xfe, 0xca, 0x49, 0xfe, 0x5a,
+	0x1e, 0x8f, 0xea, 0xa5, 0x24, 0x73, 0x95, 0xe2, 0x50, 0xc3, 0xe7, 0x93, 0x5e, 0x1b, 0xc3, 0x12,
+	0x22, 0x21, 0x1b, 0x1c, 0x11, 0x27, 0x02, 0xee, 0x1a, 0xe4, 0x42, 0xd9, 0x8a, 0x60, 0x2b, 0xb7,
+	0x44, 0x74, 0x2d, 0x35, 0x8b, 0xa2, 0x39, 0xf3, 0x06, 0x14, 0x22, 0x67, 0x42, 0x4b, 0x97, 0x87,
+	0xb4, 0xa2, 0xbe, 0x8b, 0xfc, 0x45, 0xd3, 0x69, 0xfb, 0x1b, 0x1d, 0x2a, 0x5b, 0xcc, 0xdb, 0xa7,
+	0xfd, 0x61, 0x80, 0x79, 0xea, 0xc2, 0x9c, 0x52, 0xa3, 0x9e, 0x9e, 0x1a, 0x5b, 0xb0, 0xea, 0xe2,
+	0x93, 0xee, 0xec, 0x15, 0x11, 0xca, 0x14, 0x66, 0xd1, 0x8a, 0x8b, 0x4f, 0x66, 0x6e, 0x87, 0xd0,
+	0xbc, 0x09, 0xe6, 0x24, 0x0f, 0x01, 0xe6, 0xa4, 0x3b, 0xa0, 0x2e, 0xe5, 0x32, 0x99, 0x59, 0x54,
+	0x8d, 0x66, 0x10, 0xe6, 0xe4, 0x3d, 0x31, 0x6e, 0xee, 0xc2, 0x4a, 0xfc, 0x55, 0xf7, 0x98, 0x7a,
+	0x0e, 0x3b, 0x96, 0xd9, 0xcd, 0x76, 0x6e, 0x9c, 0x8d, 0xea, 0xd7, 0x9f, 0x9a, 0x88, 0xed, 0x08,
+	0x0c, 0xb4, 0x1c, 0x4c, 0x0c, 0x3e, 0x90, 0x16, 0xec, 0x33, 0x0d, 0x8a, 0xf1, 0x26, 0xa9, 0xb0,
+	0x7a, 0x13, 0xf2, 0x58, 0x21, 0x90, 0x0a, 0xad, 0xc9, 0x22, 0xf3, 0x0e, 0x94, 0x71, 0x4f, 0x78,
+	0xd5, 0x15, 0x85, 0x28, 0x80, 0x32, 0x2e, 0x7e, 0xaa, 0x4a, 0x6a, 0xa9, 0x68, 0x87, 0x82, 0xda,
+	0xc9, 0x89, 0x4f, 0x83, 0x98, 0xda, 0x33, 0x31, 0xb5, 0xdf, 0x96, 0x33, 0x13, 0x6a, 0x27, 0x71,
+	0xcf, 0xb1, 0x1f, 0x40, 0x71, 0x07, 0xf7, 0xc9, 0x07, 0x43, 0x12, 0xc8, 0x72, 0xc2, 0xfb, 0x82,
+	0x47, 0x45, 0xe0, 0x65, 0xa4, 0x3a, 0x62, 0x54, 0xe5, 0x45, 0x84, 0x58, 0x41, 0xaa, 0x63, 0xd6,
+	0x00, 0x44, 0x45, 0x11, 0xcf, 0xa1, 0x5e, 0x5f, 0x66, 0xb8, 0x80, 0x12, 0x23, 0xf6, 0xef, 0x1a,
+	0x2c, 0x49, 0x37, 0xb1, 0x37, 0x31, 0xff, 0xef, 0x44, 0x55, 0xab, 0x1d, 0xa6, 0x85, 0x7c, 0x0b,
+	0x32, 0xfb, 0x01, 0x73, 0xe5, 0x16, 0x17, 0x06, 0x40, 0x2e, 0x31, 0x5f, 0x05, 0x9d, 0x33, 0xcb,
+	0x48, 0xb3, 0x50, 0xe7, 0x2c, 0x8e, 0x2a, 0xf3, 0xe4, 0xa8, 0xb2, 0xf3, 0x51, 0xc5, 0x08, 0xe5,
+	0x12, 0x08, 0xd9, 0x1f, 0x41, 0x65, 0x4b, 0x56, 0xb8, 0x90, 0xbe, 0xf7, 0xc2, 0x94, 0x6a, 0x31,
+	0xa9, 0x6e, 0xf5, 0xbf, 0x57, 0xb7, 0xc6, 0x54, 0xdd, 0xda, 0x7c, 0xb2, 0x97, 0x20, 0xc3, 0xd4,
+	0x7b, 0x4d, 0x09, 0x53, 0x7f, 0x0a, 0x61, 0x1a, 0x8f, 0x11, 0xa6, 0xfd, 0xb5, 0x06, 0xe6, 0xd6,
+	0x81, 0x48, 0xa5, 0xd8, 0xf6, 0x7d, 0x51, 0xec, 0xa9, 0xf7, 0x4e, 0x92, 0xba, 0xfe, 0x14, 0x52,
+	0x6f, 0x43, 0xd1, 0x23, 0xc7, 0xdd, 0xf4, 0x0a, 0xb9, 0xe0, 0x91, 0x63, 0xe9, 0x9a, 0xfd, 0xab,
+	0x06, 0x55, 0x85, 0x52, 0x44, 0x35, 0x2f, 0xcc, 0xd9, 0x29, 0xa0, 0xc6, 0x13, 0xc4, 0x60, 0x66,
+	0x56, 0x0c, 0xce, 0xc8, 0xb8, 0xec, 0x42, 0x32, 0xce, 0xf6, 0xa1, 0xaa, 0xc4, 0xda, 0xa2, 0xc1,
+	0xcd, 0xdd, 0xf3, 0xfa, 0xb9, 0xf7, 0xfc, 0x27, 0xb0, 0xbe, 0x85, 0xbd, 0x1e, 0x19, 0xcc, 0xec,
+	0x2b, 0x28, 0xe4, 0xc5, 0xef, 0xfd, 0xb9, 0x06, 0x4b, 0x77, 0xa8, 0x73, 0x69, 0xc1, 0x26, 0xd4,
+	0xae, 0x31, 0xa3, 0x76, 0x7d, 0xb8, 0xba, 0xeb, 0x3b, 0x98, 0x93, 0x99, 0xcb, 0x35, 0xb5, 0x3f,
+	0x37, 0x20, 0xeb, 0x63, 0xde, 0x3b, 0x90, 0x9e, 0x94, 0x36, 0x57, 0xd5, 0x35, 0x3e, 0x63, 0x13,
+	0xa9, 0x2f, 0xec, 0x3f, 0x34, 0x58, 0x8b, 0xf5, 0xf5, 0x8b, 0x3e, 0xca, 0xb1, 0x0a, 0x36, 0x2e,
+	0xaa, 0x82, 0x33, 0x8f, 0x4b, 0xab, 0x84, 0x7e, 0xcd, 0x2e, 0xa2, 0x5f, 0xed, 0x6f, 0x35, 0x58,
+	0x7b, 0x7b, 0x38, 0xd8, 0xa7, 0x83, 0x41, 0x1c, 0x7b, 0xea, 0xa0, 0x5f, 0x87, 0xa5, 0xde, 0x74,
+	0x75, 0x22, 0x74, 0x29, 0x82, 0x63, 0xbb, 0x02, 0x80, 0x4a, 0x2f, 0xd9, 0x9d, 0x3f, 0x2f, 0xc6,
+	0xb9, 0x07, 0xf4, 0x53, 0x58, 0x45, 0x64, 0x7f, 0xe8, 0x39, 0x2f, 0xc3, 0x5d, 0xdb, 0x85, 0xca,
+	0x0e, 0xf5, 0x2e, 0x8d, 0x09, 0x18, 0x2c, 0xef, 0x7a, 0xfe, 0x25, 0x6e, 0xf8, 0x93, 0x06, 0xcb,
+	0x8a, 0xca, 0x95, 0x22, 0xbe, 0x64, 0x26, 0x3f, 0xff, 0xc0, 0xcf, 0xbf, 0x25, 0xb2, 0xe7, 0xbf,
+	0x25, 0xec, 0xaf, 0x34, 0xb8, 0xda, 0xf6, 0x7d, 0xe2, 0x45, 0x8f, 0x80, 0x45, 0xd1, 0xbc, 0x09,
+	0xa0, 0xde, 0x07, 0x89, 0xe8, 0x2a, 0xe3, 0x51, 0xbd, 0xa8, 0xcc, 0x8a, 0xf8, 0x8a, 0xe1, 0xa4,
+	0x99, 0xfe, 0x64, 0x7f, 0xa9, 0x41, 0x15, 0x11, 0x16, 0x38, 0x24, 0x58, 0x10, 0xfc, 0x74, 0x0e,
+	0xce, 0x43, 0x69, 0x5c, 0x10, 0x4a, 0x44, 0x5c, 0x76, 0x44, 0xfe, 0xd1, 0x50, 0x7e, 0xa6, 0x81,
+	0xa9, 0x64, 0xf8, 0xf4, 0xa9, 0x91, 0xda, 0xc5, 0x67, 0x7c, 0x6d, 0x74, 0xac, 0x1f, 0xc6, 0x35,
+	0xed, 0xe1, 0xb8, 0xa6, 0xfd, 0x36, 0xae, 0x69, 0x5f, 0x3c, 0xaa, 0x5d, 0x79, 0xf8, 0xa8, 0x76,
+	0xe5, 0x97, 0x47, 0xb5, 0x2b, 0x7b, 0x39, 0xf9, 0xf7, 0xf6, 0x95, 0xbf, 0x06, 0x00, 0xa2, 0x20,
+	0x40, 0xd5, 0x0e, 0x16, 0x00, 0x00,
+}
+
+func (m *User) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *User) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if len(m.Bio) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Bio)))
+		i += copy(dAtA[i:], m.Bio)
+	}
+	if m.RegisteredAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RegisteredAt))
+	}
+	return i, nil
+}
+
+func (m *Blog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Blog) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	if len(m.PinnedArticleKeys) > 0 {
+		for _, b := range m.PinnedArticleKeys {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *Article) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Article) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Content) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Content)))
+		i += copy(dAtA[i:], m.Content)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.DeleteAt != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	}
+	if len(m.DeleteTaskID) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DeleteTaskID)))
+		i += copy(dAtA[i:], m.DeleteTaskID)
+	}
+	if m.Hidden {
+		dAtA[i] = 0x60
+		i++
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Commission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Commission) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if len(m.Requester) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Requester)))
+		i += copy(dAtA[i:], m.Requester)
+	}
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
+	}
+	if len(m.TimeoutTaskID) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TimeoutTaskID)))
+		i += copy(dAtA[i:], m.TimeoutTaskID)
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *Series) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Series) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.PrimaryKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.PrimaryKey)))
+		i += copy(dAtA[i:], m.PrimaryKey)
+	}
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.ArticleKeys) > 0 {
+		for _, b := range m.ArticleKeys {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.CreatedAt != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CreatedAt))
+	}
+	return i, nil
+}
+
+func (m *ResolvedSeries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolvedSeries) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Series != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Series.Size()))
+		n6, err := m.Series.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Articles) > 0 {
+		for _, msg := range m.Articles {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	if m.MaxPinnedArticles != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MaxPinnedArticles))
+	}
+	if m.ArticleRateLimit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArticleRateLimit))
+	}
+	if m.RateLimitWindow != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RateLimitWindow))
+	}
+	return i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.ActionTimes) > 0 {
+		dAtA10 := make([]byte, len(m.ActionTimes)*10)
+		var j9 int
+		for _, num1 := range m.ActionTimes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(j9))
+		i += copy(dAtA[i:], dAtA10[:j9])
+	}
+	if len(m.ExpireTaskID) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExpireTaskID)))
+		i += copy(dAtA[i:], m.ExpireTaskID)
+	}
+	return i, nil
+}
+
+func (m *PageQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PageQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.After) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.After)))
+		i += copy(dAtA[i:], m.After)
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Limit))
+	}
+	if m.Descending {
+		dAtA[i] = 0x18
+		i++
+		if m.Descending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TimeRangeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeRangeQuery) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if m.From != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.From))
+	}
+	if m.To != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.To))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Limit))
+	}
+	if m.Descending {
+		dAtA[i] = 0x28
+		i++
+		if m.Descending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.After) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.After)))
+		i += copy(dAtA[i:], m.After)
+	}
+	return i, nil
+}
+
+func (m *CreateUserMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateUserMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if len(m.Username) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Username)))
+		i += copy(dAtA[i:], m.Username)
+	}
+	if len(m.Bio) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Bio)))
+		i += copy(dAtA[i:], m.Bio)
+	}
+	return i, nil
+}
+
+func (m *CreateBlogMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateBlogMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	return i, nil
+}
+
+func (m *ChangeBlogOwnerMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeBlogOwnerMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n13, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if len(m.NewOwner) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.NewOwner)))
+		i += copy(dAtA[i:], m.NewOwner)
+	}
+	return i, nil
+}
+
+func (m *CreateArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n14, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Content) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Content)))
+		i += copy(dAtA[i:], m.Content)
+	}
+	if m.DeleteAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DeleteAt))
+	}
+	return i, nil
+}
+
+func (m *DeleteArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n15, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *CancelDeleteArticleTaskMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelDeleteArticleTaskMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n16, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *HideArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HideArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n17, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	if m.Hidden {
+		dAtA[i] = 0x18
+		i++
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n18, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if m.Patch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
+		n19, err := m.Patch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	return i, nil
+}
+
+func (m *CommissionArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
+	}
+	return i, nil
+}
+
+func (m *FulfillCommissionMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillCommissionMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.CommissionKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CommissionKey)))
+		i += copy(dAtA[i:], m.CommissionKey)
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *RefundCommissionMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundCommissionMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n22, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.CommissionKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.CommissionKey)))
+		i += copy(dAtA[i:], m.CommissionKey)
+	}
+	return i, nil
+}
+
+func (m *PinArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PinArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n23, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *UnpinArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnpinArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n24, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *CreateSeriesMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n25, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	if len(m.BlogKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.BlogKey)))
+		i += copy(dAtA[i:], m.BlogKey)
+	}
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.ArticleKeys) > 0 {
+		for _, b := range m.ArticleKeys {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *AppendSeriesArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AppendSeriesArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n26, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if len(m.SeriesKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesKey)))
+		i += copy(dAtA[i:], m.SeriesKey)
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *ReorderSeriesMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReorderSeriesMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n27, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if len(m.SeriesKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesKey)))
+		i += copy(dAtA[i:], m.SeriesKey)
+	}
+	if len(m.ArticleKeys) > 0 {
+		for _, b := range m.ArticleKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *RemoveSeriesArticleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveSeriesArticleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n28, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if len(m.SeriesKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.SeriesKey)))
+		i += copy(dAtA[i:], m.SeriesKey)
+	}
+	if len(m.ArticleKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ArticleKey)))
+		i += copy(dAtA[i:], m.ArticleKey)
+	}
+	return i, nil
+}
+
+func (m *ExpireRateLimitMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpireRateLimitMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n29, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *User) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Bio)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.RegisteredAt != 0 {
+		n += 1 + sovCodec(uint64(m.RegisteredAt))
+	}
+	return n
+}
+
+func (m *Blog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	if len(m.PinnedArticleKeys) > 0 {
+		for _, b := range m.PinnedArticleKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Article) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovCodec(uint64(m.DeleteAt))
+	}
+	l = len(m.DeleteTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Hidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *Commission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCodec(uint64(m.Timeout))
+	}
+	l = len(m.TimeoutTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Series) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.PrimaryKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.ArticleKeys) > 0 {
+		for _, b := range m.ArticleKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovCodec(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *ResolvedSeries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Series != nil {
+		l = m.Series.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Articles) > 0 {
+		for _, e := range m.Articles {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.MaxPinnedArticles != 0 {
+		n += 1 + sovCodec(uint64(m.MaxPinnedArticles))
+	}
+	if m.ArticleRateLimit != 0 {
+		n += 1 + sovCodec(uint64(m.ArticleRateLimit))
+	}
+	if m.RateLimitWindow != 0 {
+		n += 1 + sovCodec(uint64(m.RateLimitWindow))
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.ActionTimes) > 0 {
+		l = 0
+		for _, e := range m.ActionTimes {
+			l += sovCodec(uint64(e))
+		}
+		n += 1 + sovCodec(uint64(l)) + l
+	}
+	l = len(m.ExpireTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *PageQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCodec(uint64(m.Limit))
+	}
+	if m.Descending {
+		n += 2
+	}
+	return n
+}
+
+func (m *TimeRangeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovCodec(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovCodec(uint64(m.To))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovCodec(uint64(m.Limit))
+	}
+	if m.Descending {
+		n += 2
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateUserMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Bio)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateBlogMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ChangeBlogOwnerMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.DeleteAt != 0 {
+		n += 1 + sovCodec(uint64(m.DeleteAt))
+	}
+	return n
+}
+
+func (m *DeleteArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
 }
 
 func (m *CancelDeleteArticleTaskMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *HideArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Hidden {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CommissionArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCodec(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *FulfillCommissionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CommissionKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *RefundCommissionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.CommissionKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *PinArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UnpinArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.BlogKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.ArticleKeys) > 0 {
+		for _, b := range m.ArticleKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AppendSeriesArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SeriesKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ReorderSeriesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SeriesKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.ArticleKeys) > 0 {
+		for _, b := range m.ArticleKeys {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveSeriesArticleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.SeriesKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ArticleKey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ExpireRateLimitMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *User) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: User: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+			}
+			m.RegisteredAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Blog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Blog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Blog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedArticleKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinnedArticleKeys = append(m.PinnedArticleKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PinnedArticleKeys[len(m.PinnedArticleKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Article) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Article: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Article: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteTaskID = append(m.DeleteTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.DeleteTaskID == nil {
+				m.DeleteTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = append(m.Requester[:0], dAtA[iNdEx:postIndex]...)
+			if m.Requester == nil {
+				m.Requester = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutTaskID = append(m.TimeoutTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TimeoutTaskID == nil {
+				m.TimeoutTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Series) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Series: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Series: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrimaryKey == nil {
+				m.PrimaryKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArticleKeys = append(m.ArticleKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ArticleKeys[len(m.ArticleKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolvedSeries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolvedSeries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolvedSeries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Series", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Series == nil {
+				m.Series = &Series{}
+			}
+			if err := m.Series.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Articles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Articles = append(m.Articles, &Article{})
+			if err := m.Articles[len(m.Articles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPinnedArticles", wireType)
+			}
+			m.MaxPinnedArticles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPinnedArticles |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleRateLimit", wireType)
+			}
+			m.ArticleRateLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArticleRateLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			m.RateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitWindow |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v github_com_iov_one_weave.UnixTime
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionTimes = append(m.ActionTimes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCodec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCodec
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ActionTimes) == 0 {
+					m.ActionTimes = make([]github_com_iov_one_weave.UnixTime, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v github_com_iov_one_weave.UnixTime
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCodec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionTimes = append(m.ActionTimes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionTimes", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpireTaskID = append(m.ExpireTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExpireTaskID == nil {
+				m.ExpireTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PageQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PageQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PageQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = append(m.After[:0], dAtA[iNdEx:postIndex]...)
+			if m.After == nil {
+				m.After = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeRangeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeRangeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeRangeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Descending = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = append(m.After[:0], dAtA[iNdEx:postIndex]...)
+			if m.After == nil {
+				m.After = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateUserMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateUserMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateBlogMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateBlogMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateBlogMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeBlogOwnerMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeBlogOwnerMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeBlogOwnerMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateArticleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateArticleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
+			}
+			m.DeleteAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.ArticleKey)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return nil
+}
+func (m *DeleteArticleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteArticleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *User) Unmarshal(dAtA []byte) error {
+func (m *CancelDeleteArticleTaskMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1437,10 +7382,10 @@ func (m *User) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: User: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelDeleteArticleTaskMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: User: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelDeleteArticleTaskMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1481,7 +7426,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1508,16 +7453,69 @@ func (m *User) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrimaryKey == nil {
-				m.PrimaryKey = []byte{}
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HideArticleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HideArticleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HideArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1527,29 +7525,33 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1559,29 +7561,31 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bio = string(dAtA[iNdEx:postIndex])
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
 			}
-			m.RegisteredAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1591,11 +7595,12 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RegisteredAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Hidden = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1620,7 +7625,7 @@ func (m *User) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Blog) Unmarshal(dAtA []byte) error {
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1643,10 +7648,10 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Blog: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Blog: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1687,9 +7692,98 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
+			}
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionArticleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionArticleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1699,29 +7793,31 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrimaryKey == nil {
-				m.PrimaryKey = []byte{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1748,16 +7844,16 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1767,25 +7863,27 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -1817,11 +7915,11 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			m.CreatedAt = 0
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1831,7 +7929,7 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1860,7 +7958,7 @@ func (m *Blog) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Article) Unmarshal(dAtA []byte) error {
+func (m *FulfillCommissionMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1883,10 +7981,10 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Article: wiretype end group for non-group")
+			return fmt.Errorf("proto: FulfillCommissionMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Article: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FulfillCommissionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1927,7 +8025,7 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1954,14 +8052,14 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PrimaryKey = append(m.PrimaryKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PrimaryKey == nil {
-				m.PrimaryKey = []byte{}
+			m.CommissionKey = append(m.CommissionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommissionKey == nil {
+				m.CommissionKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1988,16 +8086,69 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlogKey == nil {
-				m.BlogKey = []byte{}
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundCommissionMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundCommissionMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundCommissionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2007,31 +8158,33 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
-			if m.Owner == nil {
-				m.Owner = []byte{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2041,29 +8194,84 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.CommissionKey = append(m.CommissionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.CommissionKey == nil {
+				m.CommissionKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PinArticleMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PinArticleMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PinArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2073,65 +8281,31 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-			}
-			m.CreatedAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreatedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
-			}
-			m.DeleteAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
 			}
-		case 11:
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteTaskID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,9 +8332,9 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeleteTaskID = append(m.DeleteTaskID[:0], dAtA[iNdEx:postIndex]...)
-			if m.DeleteTaskID == nil {
-				m.DeleteTaskID = []byte{}
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2187,7 +8361,7 @@ func (m *Article) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
+func (m *UnpinArticleMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2210,10 +8384,10 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateUserMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnpinArticleMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateUserMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnpinArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2254,9 +8428,9 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2266,55 +8440,25 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bio", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
 			}
-			m.Bio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2340,7 +8484,7 @@ func (m *CreateUserMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateBlogMsg) Unmarshal(dAtA []byte) error {
+func (m *CreateSeriesMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2363,10 +8507,10 @@ func (m *CreateBlogMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateBlogMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateSeriesMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateBlogMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateSeriesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2406,6 +8550,40 @@ func (m *CreateBlogMsg) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlogKey == nil {
+				m.BlogKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
@@ -2437,7 +8615,7 @@ func (m *CreateBlogMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -2469,6 +8647,38 @@ func (m *CreateBlogMsg) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArticleKeys = append(m.ArticleKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ArticleKeys[len(m.ArticleKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2493,7 +8703,7 @@ func (m *CreateBlogMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeBlogOwnerMsg) Unmarshal(dAtA []byte) error {
+func (m *AppendSeriesArticleMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2516,10 +8726,10 @@ func (m *ChangeBlogOwnerMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeBlogOwnerMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppendSeriesArticleMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeBlogOwnerMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppendSeriesArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2560,7 +8770,7 @@ func (m *ChangeBlogOwnerMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2587,14 +8797,14 @@ func (m *ChangeBlogOwnerMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlogKey == nil {
-				m.BlogKey = []byte{}
+			m.SeriesKey = append(m.SeriesKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesKey == nil {
+				m.SeriesKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2621,9 +8831,9 @@ func (m *ChangeBlogOwnerMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewOwner == nil {
-				m.NewOwner = []byte{}
+			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ArticleKey == nil {
+				m.ArticleKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2650,7 +8860,7 @@ func (m *ChangeBlogOwnerMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateArticleMsg) Unmarshal(dAtA []byte) error {
+func (m *ReorderSeriesMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2673,10 +8883,10 @@ func (m *CreateArticleMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateArticleMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReorderSeriesMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReorderSeriesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2717,7 +8927,7 @@ func (m *CreateArticleMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlogKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2744,48 +8954,16 @@ func (m *CreateArticleMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlogKey = append(m.BlogKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlogKey == nil {
-				m.BlogKey = []byte{}
+			m.SeriesKey = append(m.SeriesKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesKey == nil {
+				m.SeriesKey = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2795,43 +8973,24 @@ func (m *CreateArticleMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.ArticleKeys = append(m.ArticleKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ArticleKeys[len(m.ArticleKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteAt", wireType)
-			}
-			m.DeleteAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeleteAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2856,7 +9015,7 @@ func (m *CreateArticleMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteArticleMsg) Unmarshal(dAtA []byte) error {
+func (m *RemoveSeriesArticleMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2879,10 +9038,10 @@ func (m *DeleteArticleMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteArticleMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveSeriesArticleMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveSeriesArticleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2922,6 +9081,40 @@ func (m *DeleteArticleMsg) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesKey = append(m.SeriesKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesKey == nil {
+				m.SeriesKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
 			}
@@ -2979,7 +9172,7 @@ func (m *DeleteArticleMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelDeleteArticleTaskMsg) Unmarshal(dAtA []byte) error {
+func (m *ExpireRateLimitMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3002,10 +9195,10 @@ func (m *CancelDeleteArticleTaskMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelDeleteArticleTaskMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExpireRateLimitMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelDeleteArticleTaskMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExpireRateLimitMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3046,7 +9239,7 @@ func (m *CancelDeleteArticleTaskMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArticleKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3073,9 +9266,9 @@ func (m *CancelDeleteArticleTaskMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ArticleKey = append(m.ArticleKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ArticleKey == nil {
-				m.ArticleKey = []byte{}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -0,0 +1,63 @@
+package blog
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/x"
+)
+
+var _ gconf.OwnedConfig = (*Configuration)(nil)
+
+// Validate validates configuration's fields
+func (c *Configuration) Validate() error {
+	// owner field is optional, without an owner moderation is disabled
+	if len(c.Owner) != 0 {
+		if err := c.Owner.Validate(); err != nil {
+			return errors.Wrap(err, "owner address")
+		}
+	}
+	if c.MaxPinnedArticles < 0 {
+		return errors.Wrap(errors.ErrInput, "max pinned articles must not be negative")
+	}
+	if c.ArticleRateLimit < 0 {
+		return errors.Wrap(errors.ErrInput, "article rate limit must not be negative")
+	}
+	if c.RateLimitWindow < 0 {
+		return errors.Wrap(errors.ErrInput, "rate limit window must not be negative")
+	}
+	if c.ArticleRateLimit > 0 && c.RateLimitWindow == 0 {
+		return errors.Wrap(errors.ErrInput, "rate limit window is required")
+	}
+	return nil
+}
+
+// maxPinnedArticles returns the number of articles that can be pinned on a
+// single blog.
+func (c *Configuration) maxPinnedArticles() int {
+	if c.MaxPinnedArticles == 0 {
+		return defaultMaxPinnedArticles
+	}
+	return int(c.MaxPinnedArticles)
+}
+
+// loadConf returns the blog configuration. If the configuration was never
+// initialized, an empty configuration is returned and moderation is
+// disabled.
+func loadConf(db gconf.Store) (*Configuration, error) {
+	var conf Configuration
+	switch err := gconf.Load(db, packageName, &conf); {
+	case err == nil:
+		return &conf, nil
+	case errors.ErrNotFound.Is(err):
+		return &Configuration{Metadata: &weave.Metadata{Schema: 1}}, nil
+	default:
+		return nil, errors.Wrap(err, "load configuration")
+	}
+}
+
+// isModerator returns true if the moderators authorized the current
+// transaction.
+func isModerator(ctx weave.Context, auth x.Authenticator, conf *Configuration) bool {
+	return len(conf.Owner) != 0 && auth.HasAddress(ctx, conf.Owner)
+}
@@ -0,0 +1,11 @@
+package blog
+
+import (
+	"github.com/iov-one/weave/errors"
+)
+
+var (
+	// ErrRateLimited is returned when an address executed too many rate
+	// limited actions within the configured window.
+	ErrRateLimited = errors.Register(140, "rate limit exceeded")
+)
@@ -1,9 +1,14 @@
 package blog
 
 import (
+	"bytes"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x"
+	"github.com/iov-one/weave/x/cash"
 )
 
 const (
@@ -15,17 +20,22 @@ const (
 	newArticleCost  int64 = 1
 	articleCostUnit int64 = 1000 // first 1000 chars are free then pay 1 per mille
 	newCommentCost  int64 = 1
+
+	newCommissionCost int64 = 1
+	newSeriesCost     int64 = 1
+
+	defaultMaxPinnedArticles = 3
 )
 
 // RegisterQuery registers buckets for querying.
 func RegisterQuery(qr weave.QueryRouter) {
-	NewUserBucket().Register("users", qr)
-	NewBlogBucket().Register("blogs", qr)
-	NewArticleBucket().Register("articles", qr)
+	for _, p := range QueryPaths() {
+		qr.Register(p.Path, p.handler)
+	}
 }
 
 // RegisterRoutes registers handlers for message processing.
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Scheduler) {
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, ctrl cash.CoinMover, scheduler weave.Scheduler) {
 	//r = migration.SchemaMigratingRegistry(packageName, r)
 	r.Handle(&CreateUserMsg{}, NewCreateUserHandler(auth))
 	r.Handle(&CreateBlogMsg{}, NewCreateBlogHandler(auth))
@@ -33,6 +43,25 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Sche
 	r.Handle(&CreateArticleMsg{}, NewCreateArticleHandler(auth, scheduler))
 	r.Handle(&DeleteArticleMsg{}, NewDeleteArticleHandler(auth))
 	r.Handle(&CancelDeleteArticleTaskMsg{}, NewCancelDeleteArticleTaskHandler(auth, scheduler))
+	r.Handle(&HideArticleMsg{}, NewHideArticleHandler(auth))
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+	r.Handle(&CommissionArticleMsg{}, NewCommissionArticleHandler(auth, ctrl, scheduler))
+	r.Handle(&FulfillCommissionMsg{}, NewFulfillCommissionHandler(auth, ctrl, scheduler))
+	r.Handle(&PinArticleMsg{}, NewPinArticleHandler(auth))
+	r.Handle(&UnpinArticleMsg{}, NewUnpinArticleHandler(auth))
+	r.Handle(&CreateSeriesMsg{}, NewCreateSeriesHandler(auth))
+	r.Handle(&AppendSeriesArticleMsg{}, NewAppendSeriesArticleHandler(auth))
+	r.Handle(&ReorderSeriesMsg{}, NewReorderSeriesHandler(auth))
+	r.Handle(&RemoveSeriesArticleMsg{}, NewRemoveSeriesArticleHandler(auth))
+}
+
+// RegisterProposalRoutes registers handlers for messages that can be executed
+// as a result of a governance vote. Given authenticator is expected to
+// authenticate the governance election rule.
+func RegisterProposalRoutes(r weave.Registry, auth x.Authenticator) {
+	r.Handle(&ChangeBlogOwnerMsg{}, NewChangeBlogOwnerHandler(auth))
+	r.Handle(&HideArticleMsg{}, NewHideArticleHandler(auth))
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
 }
 
 // RegisterCronRoutes registers routes that are not exposed to
@@ -40,8 +69,12 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, scheduler weave.Sche
 func RegisterCronRoutes(
 	r weave.Registry,
 	auth x.Authenticator,
+	ctrl cash.CoinMover,
+	scheduler weave.Scheduler,
 ) {
 	r.Handle(&DeleteArticleMsg{}, newCronDeleteArticleHandler(auth))
+	r.Handle(&RefundCommissionMsg{}, newCronRefundCommissionHandler(auth, ctrl))
+	r.Handle(&ExpireRateLimitMsg{}, newCronExpireRateLimitHandler(scheduler))
 }
 
 // ------------------- CreateUserHandler -------------------
@@ -99,7 +132,7 @@ func (h CreateUserHandler) Check(ctx weave.Context, store weave.KVStore, tx weav
 
 // Deliver creates an custom state and saves if all preconditions are met
 func (h CreateUserHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	_, user, err := h.validate(ctx, store, tx)
+	msg, user, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +143,10 @@ func (h CreateUserHandler) Deliver(ctx weave.Context, store weave.KVStore, tx we
 	}
 
 	// Returns generated user PrimaryKey as response
-	return &weave.DeliverResult{Data: user.PrimaryKey}, nil
+	return &weave.DeliverResult{
+		Data: user.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Username: user.Username}),
+	}, nil
 }
 
 // ------------------- CreateBlogHandler -------------------
@@ -169,7 +205,7 @@ func (h CreateBlogHandler) Check(ctx weave.Context, store weave.KVStore, tx weav
 
 // Deliver creates an custom state and saves if all preconditions are met
 func (h CreateBlogHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	_, blog, err := h.validate(ctx, store, tx)
+	msg, blog, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +216,10 @@ func (h CreateBlogHandler) Deliver(ctx weave.Context, store weave.KVStore, tx we
 	}
 
 	// Returns generated blog PrimaryKey as response
-	return &weave.DeliverResult{Data: blog.PrimaryKey}, nil
+	return &weave.DeliverResult{
+		Data: blog.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: blog.PrimaryKey, Owner: blog.Owner}),
+	}, nil
 }
 
 // ------------------- ChangeBlogOwnerHandler -------------------
@@ -214,16 +253,24 @@ func (h ChangeBlogOwnerHandler) validate(ctx weave.Context, store weave.KVStore,
 		return nil, nil, errors.Wrapf(err, "cannot retrieve blog with id %s from database", msg.BlogKey)
 	}
 
-	if !h.auth.HasAddress(ctx, blog.Owner) {
-		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner can blog owner")
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !h.auth.HasAddress(ctx, blog.Owner) && !isModerator(ctx, h.auth, conf) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner or moderators can change blog owner")
 	}
 
 	newBlog := &Blog{
 		Metadata:    blog.Metadata,
+		PrimaryKey:  blog.PrimaryKey,
 		Owner:       msg.NewOwner,
 		Title:       blog.Title,
 		Description: blog.Description,
 		CreatedAt:   blog.CreatedAt,
+
+		PinnedArticleKeys: blog.PinnedArticleKeys,
 	}
 
 	return &msg, newBlog, nil
@@ -242,7 +289,7 @@ func (h ChangeBlogOwnerHandler) Check(ctx weave.Context, store weave.KVStore, tx
 
 // Deliver creates an custom state and saves if all preconditions are met
 func (h ChangeBlogOwnerHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	_, blog, err := h.validate(ctx, store, tx)
+	msg, blog, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -253,7 +300,10 @@ func (h ChangeBlogOwnerHandler) Deliver(ctx weave.Context, store weave.KVStore,
 	}
 
 	// Returns generated blog PrimaryKey as response
-	return &weave.DeliverResult{Data: blog.PrimaryKey}, nil
+	return &weave.DeliverResult{
+		Data: blog.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: blog.PrimaryKey, Owner: blog.Owner}),
+	}, nil
 }
 
 // ------------------- CreateArticleHandler -------------------
@@ -264,6 +314,7 @@ type CreateArticleHandler struct {
 	ab        *ArticleBucket
 	bb        *BlogBucket
 	scheduler weave.Scheduler
+	limiter   rateLimiter
 }
 
 var _ weave.Handler = CreateArticleHandler{}
@@ -275,6 +326,7 @@ func NewCreateArticleHandler(auth x.Authenticator, scheduler weave.Scheduler) we
 		ab:        NewArticleBucket(),
 		bb:        NewBlogBucket(),
 		scheduler: scheduler,
+		limiter:   newRateLimiter(scheduler),
 	}
 }
 
@@ -295,6 +347,14 @@ func (h CreateArticleHandler) validate(ctx weave.Context, store weave.KVStore, t
 		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner can post an article under a blog")
 	}
 
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := h.limiter.check(ctx, store, conf, blog.Owner); err != nil {
+		return nil, nil, err
+	}
+
 	blockTime, err := weave.BlockTime(ctx)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "no block time in header")
@@ -320,12 +380,21 @@ func (h CreateArticleHandler) validate(ctx weave.Context, store weave.KVStore, t
 }
 
 // Check just verifies it is properly formed and returns
-// the cost of executing it.
+// the cost of executing it. The article is recorded by the rate limiter, so
+// that the mempool does not accept more articles than can be delivered.
 func (h CreateArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	msg, _, err := h.validate(ctx, store, tx)
+	msg, article, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := loadConf(store)
 	if err != nil {
 		return nil, err
 	}
+	if err := h.limiter.record(ctx, store, conf, article.Owner); err != nil {
+		return nil, err
+	}
 
 	// Calculate gas cost
 	gasCost := int64(len(msg.Content)) * newArticleCost / articleCostUnit
@@ -340,6 +409,11 @@ func (h CreateArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx
 		return nil, err
 	}
 
+	// The primary key is assigned on the first save.
+	if err := h.ab.Save(store, article); err != nil {
+		return nil, errors.Wrap(err, "cannot store article")
+	}
+
 	// schedule delete task
 	if msg.DeleteAt != 0 {
 		deleteArticleMsg := &DeleteArticleMsg{
@@ -353,13 +427,24 @@ func (h CreateArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx
 		}
 
 		article.DeleteTaskID = taskID
+		if err := h.ab.Save(store, article); err != nil {
+			return nil, errors.Wrap(err, "cannot store article")
+		}
 	}
 
-	if err := h.ab.Save(store, article); err != nil {
-		return nil, errors.Wrap(err, "cannot store article")
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.limiter.record(ctx, store, conf, article.Owner); err != nil {
+		return nil, err
 	}
+
 	// Returns generated article PrimaryKey as response
-	return &weave.DeliverResult{Data: article.PrimaryKey}, nil
+	return &weave.DeliverResult{
+		Data: article.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: article.BlogKey, Article: article.PrimaryKey, Owner: article.Owner}),
+	}, nil
 }
 
 // ------------------- DeleteArticleHandler -------------------
@@ -368,6 +453,8 @@ func (h CreateArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx
 type DeleteArticleHandler struct {
 	auth x.Authenticator
 	b    *ArticleBucket
+	bb   *BlogBucket
+	sb   *SeriesBucket
 }
 
 var _ weave.Handler = DeleteArticleHandler{}
@@ -377,6 +464,8 @@ func NewDeleteArticleHandler(auth x.Authenticator) weave.Handler {
 	return DeleteArticleHandler{
 		auth: auth,
 		b:    NewArticleBucket(),
+		bb:   NewBlogBucket(),
+		sb:   NewSeriesBucket(),
 	}
 }
 
@@ -414,16 +503,24 @@ func (h DeleteArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx w
 
 // Deliver creates an custom state and saves if all preconditions are met
 func (h DeleteArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	_, article, err := h.validate(ctx, store, tx)
+	msg, article, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := unpinArticle(store, h.bb, article); err != nil {
+		return nil, err
+	}
+
+	if err := removeSeriesArticle(store, h.sb, article); err != nil {
+		return nil, err
+	}
+
 	if err := h.b.Delete(store, article.PrimaryKey); err != nil {
 		return nil, errors.Wrapf(err, "cannot delete article with PrimaryKey %s", article.PrimaryKey)
 	}
 
-	return &weave.DeliverResult{}, nil
+	return &weave.DeliverResult{Tags: resultTags(msg, entityTags{Blog: article.BlogKey, Article: article.PrimaryKey, Owner: article.Owner})}, nil
 }
 
 // ------------------- CancelDeleteArticleTaskHandler -------------------
@@ -484,7 +581,7 @@ func (h CancelDeleteArticleTaskHandler) Check(ctx weave.Context, store weave.KVS
 
 // Deliver cancels delete task if conditions are met
 func (h CancelDeleteArticleTaskHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	_, article, err := h.validate(ctx, store, tx)
+	msg, article, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -498,60 +595,1082 @@ func (h CancelDeleteArticleTaskHandler) Deliver(ctx weave.Context, store weave.K
 		return nil, errors.Wrapf(err, "cannot update article %s ", article.PrimaryKey)
 	}
 
-	return &weave.DeliverResult{}, nil
+	return &weave.DeliverResult{Tags: resultTags(msg, entityTags{Blog: article.BlogKey, Article: article.PrimaryKey, Owner: article.Owner})}, nil
 }
 
-// ------------------- CronDeleteArticleHandler -------------------
+// ------------------- HideArticleHandler -------------------
 
-// CronDeleteArticleHandler will handle scheduled DeleteArticleMsg
-type CronDeleteArticleHandler struct {
+// HideArticleHandler will handle HideArticleMsg
+type HideArticleHandler struct {
 	auth x.Authenticator
 	b    *ArticleBucket
 }
 
-var _ weave.Handler = CronDeleteArticleHandler{}
+var _ weave.Handler = HideArticleHandler{}
 
-// newCronDeleteArticleHandler creates a article message handler
-func newCronDeleteArticleHandler(auth x.Authenticator) weave.Handler {
-	return CronDeleteArticleHandler{
+// NewHideArticleHandler creates a hide article message handler
+func NewHideArticleHandler(auth x.Authenticator) weave.Handler {
+	return HideArticleHandler{
 		auth: auth,
 		b:    NewArticleBucket(),
 	}
 }
 
 // validate does all common pre-processing between Check and Deliver
-func (h CronDeleteArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*DeleteArticleMsg, error) {
-	var msg DeleteArticleMsg
+func (h HideArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*HideArticleMsg, *Article, error) {
+	var msg HideArticleMsg
 
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, errors.Wrap(err, "load msg")
+		return nil, nil, errors.Wrap(err, "load msg")
 	}
 
-	return &msg, nil
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isModerator(ctx, h.auth, conf) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the moderators can hide an article")
+	}
+
+	var article Article
+	if err := h.b.ByID(store, msg.ArticleKey, &article); err != nil {
+		return nil, nil, errors.Wrapf(err, "article with key %s not found", msg.ArticleKey)
+	}
+
+	return &msg, &article, nil
 }
 
 // Check just verifies it is properly formed and returns
 // the cost of executing it.
-func (h CronDeleteArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	_, err := h.validate(ctx, store, tx)
+func (h HideArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	// Deleting is free of charge
+	// Moderation is free of charge
 	return &weave.CheckResult{}, nil
 }
 
-// Deliver stages a scheduled deletion if all preconditions are met
-func (h CronDeleteArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, err := h.validate(ctx, store, tx)
+// Deliver updates the article visibility if conditions are met
+func (h HideArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, article, err := h.validate(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := h.b.Delete(store, msg.ArticleKey); err != nil {
-		return nil, errors.Wrapf(err, "cannot delete article with PrimaryKey %s", msg.ArticleKey)
+	article.Hidden = msg.Hidden
+	if err := h.b.Save(store, article); err != nil {
+		return nil, errors.Wrapf(err, "cannot update article %s", article.PrimaryKey)
+	}
+
+	return &weave.DeliverResult{
+		Data: article.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: article.BlogKey, Article: article.PrimaryKey, Owner: article.Owner}),
+	}, nil
+}
+
+// ------------------- UpdateConfigurationHandler -------------------
+
+// NewConfigHandler creates a blog configuration update message handler.
+// Until the configuration is created, the migration admin is allowed to
+// initialize it.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler(packageName, &conf, auth, migration.CurrentAdmin)
+}
+
+// ------------------- CommissionArticleHandler -------------------
+
+// CommissionArticleHandler will handle CommissionArticleMsg
+type CommissionArticleHandler struct {
+	auth      x.Authenticator
+	cb        *CommissionBucket
+	bb        *BlogBucket
+	ctrl      cash.CoinMover
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = CommissionArticleHandler{}
+
+// NewCommissionArticleHandler creates a commission article message handler
+func NewCommissionArticleHandler(auth x.Authenticator, ctrl cash.CoinMover, scheduler weave.Scheduler) weave.Handler {
+	return CommissionArticleHandler{
+		auth:      auth,
+		cb:        NewCommissionBucket(),
+		bb:        NewBlogBucket(),
+		ctrl:      ctrl,
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CommissionArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CommissionArticleMsg, *Commission, error) {
+	var msg CommissionArticleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	if err := h.bb.Has(store, msg.BlogKey); err != nil {
+		return nil, nil, errors.Wrapf(err, "blog id with %s does not exist", msg.BlogKey)
+	}
+
+	requester := x.AnySigner(ctx, h.auth)
+	if requester == nil {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "commission must be signed")
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+
+	if weave.IsExpired(ctx, msg.Timeout) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "timeout is in the past")
+	}
+
+	commission := &Commission{
+		Metadata:    &weave.Metadata{Schema: 1},
+		BlogKey:     msg.BlogKey,
+		Requester:   requester.Address(),
+		Amount:      msg.Amount,
+		Description: msg.Description,
+		CreatedAt:   weave.AsUnixTime(blockTime),
+		Timeout:     msg.Timeout,
+	}
+
+	return &msg, commission, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CommissionArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{GasAllocated: newCommissionCost}, nil
+}
+
+// Deliver creates a commission, locks the commissioned amount and schedules
+// the refund if all preconditions are met
+func (h CommissionArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, commission, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save first to get the commission PrimaryKey, which is part of the
+	// address the funds are locked on.
+	if err := h.cb.Save(store, commission); err != nil {
+		return nil, errors.Wrap(err, "cannot store commission")
+	}
+
+	if err := cash.MoveCoins(store, h.ctrl, commission.Requester, commission.Address(), commission.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot lock commissioned amount")
+	}
+
+	refundMsg := &RefundCommissionMsg{
+		Metadata:      &weave.Metadata{Schema: 1},
+		CommissionKey: commission.PrimaryKey,
+	}
+	taskID, err := h.scheduler.Schedule(store, commission.Timeout.Time(), nil, refundMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule refund task")
+	}
+
+	commission.TimeoutTaskID = taskID
+	if err := h.cb.Save(store, commission); err != nil {
+		return nil, errors.Wrap(err, "cannot store commission")
+	}
+
+	// Returns generated commission PrimaryKey as response
+	return &weave.DeliverResult{
+		Data: commission.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: commission.BlogKey, Owner: commission.Requester}),
+	}, nil
+}
+
+// ------------------- FulfillCommissionHandler -------------------
+
+// FulfillCommissionHandler will handle FulfillCommissionMsg
+type FulfillCommissionHandler struct {
+	auth      x.Authenticator
+	cb        *CommissionBucket
+	bb        *BlogBucket
+	ab        *ArticleBucket
+	ctrl      cash.CoinMover
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = FulfillCommissionHandler{}
+
+// NewFulfillCommissionHandler creates a fulfill commission message handler
+func NewFulfillCommissionHandler(auth x.Authenticator, ctrl cash.CoinMover, scheduler weave.Scheduler) weave.Handler {
+	return FulfillCommissionHandler{
+		auth:      auth,
+		cb:        NewCommissionBucket(),
+		bb:        NewBlogBucket(),
+		ab:        NewArticleBucket(),
+		ctrl:      ctrl,
+		scheduler: scheduler,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h FulfillCommissionHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*FulfillCommissionMsg, *Commission, *Blog, error) {
+	var msg FulfillCommissionMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var commission Commission
+	if err := h.cb.ByID(store, msg.CommissionKey, &commission); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "commission with key %s not found", msg.CommissionKey)
+	}
+
+	if len(commission.ArticleKey) != 0 {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "commission already fulfilled")
+	}
+
+	if weave.IsExpired(ctx, commission.Timeout) {
+		return nil, nil, nil, errors.Wrap(errors.ErrExpired, "commission timed out")
+	}
+
+	var blog Blog
+	if err := h.bb.ByID(store, commission.BlogKey, &blog); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "blog id with %s does not exist", commission.BlogKey)
+	}
+
+	if !h.auth.HasAddress(ctx, blog.Owner) {
+		return nil, nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner can fulfill a commission")
+	}
+
+	var article Article
+	if err := h.ab.ByID(store, msg.ArticleKey, &article); err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "article with key %s not found", msg.ArticleKey)
+	}
+
+	if !bytes.Equal(article.BlogKey, commission.BlogKey) {
+		return nil, nil, nil, errors.Wrap(errors.ErrInput, "article is not posted to the commissioned blog")
+	}
+
+	if article.CreatedAt < commission.CreatedAt {
+		return nil, nil, nil, errors.Wrap(errors.ErrInput, "article was created before the commission")
+	}
+
+	var fulfilled []Commission
+	if err := h.cb.ByIndex(store, "article", msg.ArticleKey, &fulfilled); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot query commissions by article")
+	}
+	if len(fulfilled) != 0 {
+		return nil, nil, nil, errors.Wrap(errors.ErrDuplicate, "article already fulfills a commission")
+	}
+
+	return &msg, &commission, &blog, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h FulfillCommissionHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fulfilling is free of charge
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver links the article to the commission and releases the commissioned
+// amount to the blog owner if all preconditions are met
+func (h FulfillCommissionHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, commission, blog, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cash.MoveCoins(store, h.ctrl, commission.Address(), blog.Owner, commission.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot release commissioned amount")
+	}
+
+	if err := h.scheduler.Delete(store, commission.TimeoutTaskID); err != nil {
+		return nil, errors.Wrapf(err, "cannot deschedule with task id %s", commission.TimeoutTaskID)
+	}
+
+	commission.ArticleKey = msg.ArticleKey
+	commission.TimeoutTaskID = nil
+	if err := h.cb.Save(store, commission); err != nil {
+		return nil, errors.Wrapf(err, "cannot update commission %s", commission.PrimaryKey)
+	}
+
+	return &weave.DeliverResult{
+		Data: commission.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: commission.BlogKey, Article: msg.ArticleKey, Owner: commission.Requester}),
+	}, nil
+}
+
+// ------------------- PinArticleHandler -------------------
+
+// PinArticleHandler will handle PinArticleMsg
+type PinArticleHandler struct {
+	auth x.Authenticator
+	ab   *ArticleBucket
+	bb   *BlogBucket
+}
+
+var _ weave.Handler = PinArticleHandler{}
+
+// NewPinArticleHandler creates a pin article message handler
+func NewPinArticleHandler(auth x.Authenticator) weave.Handler {
+	return PinArticleHandler{
+		auth: auth,
+		ab:   NewArticleBucket(),
+		bb:   NewBlogBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h PinArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*PinArticleMsg, *Blog, error) {
+	var msg PinArticleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var article Article
+	if err := h.ab.ByID(store, msg.ArticleKey, &article); err != nil {
+		return nil, nil, errors.Wrapf(err, "article with key %s not found", msg.ArticleKey)
+	}
+
+	var blog Blog
+	if err := h.bb.ByID(store, article.BlogKey, &blog); err != nil {
+		return nil, nil, errors.Wrapf(err, "blog id with %s does not exist", article.BlogKey)
+	}
+
+	if !h.auth.HasAddress(ctx, blog.Owner) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner can pin an article")
+	}
+
+	if _, ok := indexOfKey(blog.PinnedArticleKeys, article.PrimaryKey); ok {
+		return nil, nil, errors.Wrap(errors.ErrDuplicate, "article already pinned")
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if max := conf.maxPinnedArticles(); len(blog.PinnedArticleKeys) >= max {
+		return nil, nil, errors.Wrapf(errors.ErrState, "cannot pin more than %d articles", max)
+	}
+
+	return &msg, &blog, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h PinArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pinning is free of charge
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver appends the article to the pinned list if all preconditions are met
+func (h PinArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, blog, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	blog.PinnedArticleKeys = append(blog.PinnedArticleKeys, msg.ArticleKey)
+	if err := h.bb.Save(store, blog); err != nil {
+		return nil, errors.Wrap(err, "cannot update blog")
+	}
+
+	return &weave.DeliverResult{
+		Data: blog.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: blog.PrimaryKey, Article: msg.ArticleKey, Owner: blog.Owner}),
+	}, nil
+}
+
+// ------------------- UnpinArticleHandler -------------------
+
+// UnpinArticleHandler will handle UnpinArticleMsg
+type UnpinArticleHandler struct {
+	auth x.Authenticator
+	ab   *ArticleBucket
+	bb   *BlogBucket
+}
+
+var _ weave.Handler = UnpinArticleHandler{}
+
+// NewUnpinArticleHandler creates an unpin article message handler
+func NewUnpinArticleHandler(auth x.Authenticator) weave.Handler {
+	return UnpinArticleHandler{
+		auth: auth,
+		ab:   NewArticleBucket(),
+		bb:   NewBlogBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h UnpinArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*UnpinArticleMsg, *Article, error) {
+	var msg UnpinArticleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var article Article
+	if err := h.ab.ByID(store, msg.ArticleKey, &article); err != nil {
+		return nil, nil, errors.Wrapf(err, "article with key %s not found", msg.ArticleKey)
+	}
+
+	var blog Blog
+	if err := h.bb.ByID(store, article.BlogKey, &blog); err != nil {
+		return nil, nil, errors.Wrapf(err, "blog id with %s does not exist", article.BlogKey)
+	}
+
+	if !h.auth.HasAddress(ctx, blog.Owner) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner can unpin an article")
+	}
+
+	if _, ok := indexOfKey(blog.PinnedArticleKeys, article.PrimaryKey); !ok {
+		return nil, nil, errors.Wrap(errors.ErrNotFound, "article is not pinned")
+	}
+
+	return &msg, &article, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h UnpinArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unpinning is free of charge
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver removes the article from the pinned list if all preconditions are met
+func (h UnpinArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, article, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := unpinArticle(store, h.bb, article); err != nil {
+		return nil, err
+	}
+
+	return &weave.DeliverResult{
+		Data: article.BlogKey,
+		Tags: resultTags(msg, entityTags{Blog: article.BlogKey, Article: article.PrimaryKey, Owner: article.Owner}),
+	}, nil
+}
+
+// unpinArticle removes the article from the pinned articles list of its blog.
+// It is a no-op if the article is not pinned or the blog does not exist.
+func unpinArticle(store weave.KVStore, bb *BlogBucket, article *Article) error {
+	var blog Blog
+	switch err := bb.ByID(store, article.BlogKey, &blog); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return errors.Wrapf(err, "cannot retrieve blog with id %s", article.BlogKey)
+	}
+
+	i, ok := indexOfKey(blog.PinnedArticleKeys, article.PrimaryKey)
+	if !ok {
+		return nil
+	}
+	blog.PinnedArticleKeys = append(blog.PinnedArticleKeys[:i], blog.PinnedArticleKeys[i+1:]...)
+	if err := bb.Save(store, &blog); err != nil {
+		return errors.Wrap(err, "cannot update blog pinned articles")
+	}
+	return nil
+}
+
+// ------------------- CreateSeriesHandler -------------------
+
+// CreateSeriesHandler will handle CreateSeriesMsg
+type CreateSeriesHandler struct {
+	auth x.Authenticator
+	sb   *SeriesBucket
+	ab   *ArticleBucket
+	bb   *BlogBucket
+}
+
+var _ weave.Handler = CreateSeriesHandler{}
+
+// NewCreateSeriesHandler creates a series message handler
+func NewCreateSeriesHandler(auth x.Authenticator) weave.Handler {
+	return CreateSeriesHandler{
+		auth: auth,
+		sb:   NewSeriesBucket(),
+		ab:   NewArticleBucket(),
+		bb:   NewBlogBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CreateSeriesHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CreateSeriesMsg, *Series, error) {
+	var msg CreateSeriesMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var blog Blog
+	if err := h.bb.ByID(store, msg.BlogKey, &blog); err != nil {
+		return nil, nil, errors.Wrapf(err, "blog id with %s does not exist", msg.BlogKey)
+	}
+
+	if !h.auth.HasAddress(ctx, blog.Owner) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner can create a series")
+	}
+
+	for _, key := range msg.ArticleKeys {
+		if err := ensureBlogArticle(store, h.ab, msg.BlogKey, key); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "no block time in header")
+	}
+	now := weave.AsUnixTime(blockTime)
+
+	series := &Series{
+		Metadata:    &weave.Metadata{Schema: 1},
+		BlogKey:     msg.BlogKey,
+		Title:       msg.Title,
+		Description: msg.Description,
+		ArticleKeys: msg.ArticleKeys,
+		CreatedAt:   now,
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CreateSeriesHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{GasAllocated: newSeriesCost}, nil
+}
+
+// Deliver creates a series and saves if all preconditions are met
+func (h CreateSeriesHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.sb.Save(store, series); err != nil {
+		return nil, errors.Wrap(err, "cannot store series")
+	}
+
+	// Returns generated series PrimaryKey as response
+	return &weave.DeliverResult{
+		Data: series.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: series.BlogKey}),
+	}, nil
+}
+
+// ------------------- AppendSeriesArticleHandler -------------------
+
+// AppendSeriesArticleHandler will handle AppendSeriesArticleMsg
+type AppendSeriesArticleHandler struct {
+	auth x.Authenticator
+	sb   *SeriesBucket
+	ab   *ArticleBucket
+	bb   *BlogBucket
+}
+
+var _ weave.Handler = AppendSeriesArticleHandler{}
+
+// NewAppendSeriesArticleHandler creates an append series article message handler
+func NewAppendSeriesArticleHandler(auth x.Authenticator) weave.Handler {
+	return AppendSeriesArticleHandler{
+		auth: auth,
+		sb:   NewSeriesBucket(),
+		ab:   NewArticleBucket(),
+		bb:   NewBlogBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h AppendSeriesArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*AppendSeriesArticleMsg, *Series, error) {
+	var msg AppendSeriesArticleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	series, err := loadOwnedSeries(ctx, store, h.auth, h.sb, h.bb, msg.SeriesKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := indexOfKey(series.ArticleKeys, msg.ArticleKey); ok {
+		return nil, nil, errors.Wrap(errors.ErrDuplicate, "article already in the series")
+	}
+
+	if err := ensureBlogArticle(store, h.ab, series.BlogKey, msg.ArticleKey); err != nil {
+		return nil, nil, err
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h AppendSeriesArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver appends the article to the series if all preconditions are met
+func (h AppendSeriesArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	series.ArticleKeys = append(series.ArticleKeys, msg.ArticleKey)
+	if err := h.sb.Save(store, series); err != nil {
+		return nil, errors.Wrap(err, "cannot update series")
+	}
+
+	return &weave.DeliverResult{
+		Data: series.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: series.BlogKey, Article: msg.ArticleKey}),
+	}, nil
+}
+
+// ------------------- ReorderSeriesHandler -------------------
+
+// ReorderSeriesHandler will handle ReorderSeriesMsg
+type ReorderSeriesHandler struct {
+	auth x.Authenticator
+	sb   *SeriesBucket
+	bb   *BlogBucket
+}
+
+var _ weave.Handler = ReorderSeriesHandler{}
+
+// NewReorderSeriesHandler creates a reorder series message handler
+func NewReorderSeriesHandler(auth x.Authenticator) weave.Handler {
+	return ReorderSeriesHandler{
+		auth: auth,
+		sb:   NewSeriesBucket(),
+		bb:   NewBlogBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h ReorderSeriesHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ReorderSeriesMsg, *Series, error) {
+	var msg ReorderSeriesMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	series, err := loadOwnedSeries(ctx, store, h.auth, h.sb, h.bb, msg.SeriesKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Message validation ensures there are no duplicates, so it is enough
+	// to compare the length and check that each key is part of the series.
+	if len(msg.ArticleKeys) != len(series.ArticleKeys) {
+		return nil, nil, errors.Wrap(errors.ErrInput, "new order must contain all series articles")
+	}
+	for _, key := range msg.ArticleKeys {
+		if _, ok := indexOfKey(series.ArticleKeys, key); !ok {
+			return nil, nil, errors.Wrapf(errors.ErrInput, "article %s is not part of the series", key)
+		}
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h ReorderSeriesHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver updates the series articles order if all preconditions are met
+func (h ReorderSeriesHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	series.ArticleKeys = msg.ArticleKeys
+	if err := h.sb.Save(store, series); err != nil {
+		return nil, errors.Wrap(err, "cannot update series")
+	}
+
+	return &weave.DeliverResult{
+		Data: series.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: series.BlogKey}),
+	}, nil
+}
+
+// ------------------- RemoveSeriesArticleHandler -------------------
+
+// RemoveSeriesArticleHandler will handle RemoveSeriesArticleMsg
+type RemoveSeriesArticleHandler struct {
+	auth x.Authenticator
+	sb   *SeriesBucket
+	bb   *BlogBucket
+}
+
+var _ weave.Handler = RemoveSeriesArticleHandler{}
+
+// NewRemoveSeriesArticleHandler creates a remove series article message handler
+func NewRemoveSeriesArticleHandler(auth x.Authenticator) weave.Handler {
+	return RemoveSeriesArticleHandler{
+		auth: auth,
+		sb:   NewSeriesBucket(),
+		bb:   NewBlogBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h RemoveSeriesArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*RemoveSeriesArticleMsg, *Series, error) {
+	var msg RemoveSeriesArticleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	series, err := loadOwnedSeries(ctx, store, h.auth, h.sb, h.bb, msg.SeriesKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := indexOfKey(series.ArticleKeys, msg.ArticleKey); !ok {
+		return nil, nil, errors.Wrap(errors.ErrNotFound, "article is not part of the series")
+	}
+
+	return &msg, series, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h RemoveSeriesArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver removes the article from the series if all preconditions are met
+func (h RemoveSeriesArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, series, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	i, _ := indexOfKey(series.ArticleKeys, msg.ArticleKey)
+	series.ArticleKeys = append(series.ArticleKeys[:i], series.ArticleKeys[i+1:]...)
+	if err := h.sb.Save(store, series); err != nil {
+		return nil, errors.Wrap(err, "cannot update series")
+	}
+
+	return &weave.DeliverResult{
+		Data: series.PrimaryKey,
+		Tags: resultTags(msg, entityTags{Blog: series.BlogKey, Article: msg.ArticleKey}),
+	}, nil
+}
+
+// loadOwnedSeries returns the series with given key, ensuring that the
+// transaction is signed by the owner of the blog the series belongs to.
+func loadOwnedSeries(
+	ctx weave.Context,
+	store weave.KVStore,
+	auth x.Authenticator,
+	sb *SeriesBucket,
+	bb *BlogBucket,
+	seriesKey []byte,
+) (*Series, error) {
+	var series Series
+	if err := sb.ByID(store, seriesKey, &series); err != nil {
+		return nil, errors.Wrapf(err, "series with key %s not found", seriesKey)
+	}
+
+	var blog Blog
+	if err := bb.ByID(store, series.BlogKey, &blog); err != nil {
+		return nil, errors.Wrapf(err, "blog id with %s does not exist", series.BlogKey)
+	}
+
+	if !auth.HasAddress(ctx, blog.Owner) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "only the blog owner can modify a series")
+	}
+
+	return &series, nil
+}
+
+// ensureBlogArticle returns an error if the article does not exist or it
+// does not belong to the blog with given key.
+func ensureBlogArticle(store weave.KVStore, ab *ArticleBucket, blogKey, articleKey []byte) error {
+	var article Article
+	if err := ab.ByID(store, articleKey, &article); err != nil {
+		return errors.Wrapf(err, "article with key %s not found", articleKey)
+	}
+	if !bytes.Equal(article.BlogKey, blogKey) {
+		return errors.Wrapf(errors.ErrInput, "article %s does not belong to the series blog", articleKey)
+	}
+	return nil
+}
+
+// removeSeriesArticle removes the article from all series of its blog.
+func removeSeriesArticle(store weave.KVStore, sb *SeriesBucket, article *Article) error {
+	var series []*Series
+	if err := sb.ByIndex(store, "blog", article.BlogKey, &series); err != nil {
+		return errors.Wrapf(err, "cannot retrieve series of blog %s", article.BlogKey)
+	}
+
+	for _, s := range series {
+		i, ok := indexOfKey(s.ArticleKeys, article.PrimaryKey)
+		if !ok {
+			continue
+		}
+		s.ArticleKeys = append(s.ArticleKeys[:i], s.ArticleKeys[i+1:]...)
+		if err := sb.Save(store, s); err != nil {
+			return errors.Wrapf(err, "cannot update series %s", s.PrimaryKey)
+		}
+	}
+	return nil
+}
+
+// ------------------- CronDeleteArticleHandler -------------------
+
+// CronDeleteArticleHandler will handle scheduled DeleteArticleMsg
+type CronDeleteArticleHandler struct {
+	auth x.Authenticator
+	b    *ArticleBucket
+	bb   *BlogBucket
+	sb   *SeriesBucket
+}
+
+var _ weave.Handler = CronDeleteArticleHandler{}
+
+// newCronDeleteArticleHandler creates a article message handler
+func newCronDeleteArticleHandler(auth x.Authenticator) weave.Handler {
+	return CronDeleteArticleHandler{
+		auth: auth,
+		b:    NewArticleBucket(),
+		bb:   NewBlogBucket(),
+		sb:   NewSeriesBucket(),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CronDeleteArticleHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*DeleteArticleMsg, error) {
+	var msg DeleteArticleMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	return &msg, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CronDeleteArticleHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting is free of charge
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stages a scheduled deletion if all preconditions are met
+func (h CronDeleteArticleHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var article Article
+	if err := h.b.ByID(store, msg.ArticleKey, &article); err != nil {
+		return nil, errors.Wrapf(err, "cannot retrieve article with PrimaryKey %s", msg.ArticleKey)
+	}
+
+	if err := unpinArticle(store, h.bb, &article); err != nil {
+		return nil, err
+	}
+
+	if err := removeSeriesArticle(store, h.sb, &article); err != nil {
+		return nil, err
+	}
+
+	if err := h.b.Delete(store, msg.ArticleKey); err != nil {
+		return nil, errors.Wrapf(err, "cannot delete article with PrimaryKey %s", msg.ArticleKey)
+	}
+
+	return &weave.DeliverResult{Tags: resultTags(msg, entityTags{Blog: article.BlogKey, Article: msg.ArticleKey, Owner: article.Owner})}, nil
+}
+
+// ------------------- CronRefundCommissionHandler -------------------
+
+// CronRefundCommissionHandler will handle scheduled RefundCommissionMsg
+type CronRefundCommissionHandler struct {
+	auth x.Authenticator
+	b    *CommissionBucket
+	ctrl cash.CoinMover
+}
+
+var _ weave.Handler = CronRefundCommissionHandler{}
+
+// newCronRefundCommissionHandler creates a refund commission message handler
+func newCronRefundCommissionHandler(auth x.Authenticator, ctrl cash.CoinMover) weave.Handler {
+	return CronRefundCommissionHandler{
+		auth: auth,
+		b:    NewCommissionBucket(),
+		ctrl: ctrl,
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CronRefundCommissionHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*RefundCommissionMsg, *Commission, error) {
+	var msg RefundCommissionMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var commission Commission
+	if err := h.b.ByID(store, msg.CommissionKey, &commission); err != nil {
+		return nil, nil, errors.Wrapf(err, "commission with key %s not found", msg.CommissionKey)
+	}
+
+	if len(commission.ArticleKey) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "commission already fulfilled")
+	}
+
+	return &msg, &commission, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CronRefundCommissionHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refunding is free of charge
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver returns the commissioned amount to the requester and removes the
+// commission if all preconditions are met
+func (h CronRefundCommissionHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, commission, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cash.MoveCoins(store, h.ctrl, commission.Address(), commission.Requester, commission.Amount); err != nil {
+		return nil, errors.Wrap(err, "cannot refund commissioned amount")
+	}
+
+	if err := h.b.Delete(store, commission.PrimaryKey); err != nil {
+		return nil, errors.Wrapf(err, "cannot delete commission with PrimaryKey %s", commission.PrimaryKey)
+	}
+
+	return &weave.DeliverResult{Tags: resultTags(msg, entityTags{Blog: commission.BlogKey, Owner: commission.Requester})}, nil
+}
+
+// ------------------- CronExpireRateLimitHandler -------------------
+
+// CronExpireRateLimitHandler will handle scheduled ExpireRateLimitMsg
+type CronExpireRateLimitHandler struct {
+	limiter rateLimiter
+}
+
+var _ weave.Handler = CronExpireRateLimitHandler{}
+
+// newCronExpireRateLimitHandler creates a rate limit expiration message handler
+func newCronExpireRateLimitHandler(scheduler weave.Scheduler) weave.Handler {
+	return CronExpireRateLimitHandler{
+		limiter: newRateLimiter(scheduler),
+	}
+}
+
+// validate does all common pre-processing between Check and Deliver
+func (h CronExpireRateLimitHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ExpireRateLimitMsg, error) {
+	var msg ExpireRateLimitMsg
+
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	return &msg, nil
+}
+
+// Check just verifies it is properly formed and returns
+// the cost of executing it.
+func (h CronExpireRateLimitHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver removes expired action times of the rate limit
+func (h CronExpireRateLimitHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, err := loadConf(store)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.limiter.expire(ctx, store, conf, msg.Address); err != nil {
+		return nil, err
 	}
 
-	return &weave.DeliverResult{}, nil
+	return &weave.DeliverResult{Tags: resultTags(msg, entityTags{Owner: msg.Address})}, nil
 }
@@ -1,17 +1,23 @@
 package blog
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
 
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 func TestCreateUser(t *testing.T) {
@@ -106,7 +112,7 @@ func TestCreateUser(t *testing.T) {
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
 
 			kv := store.MemStore()
 			bucket := NewUserBucket()
@@ -288,7 +294,7 @@ func TestCreateBlog(t *testing.T) {
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
 
 			kv := store.MemStore()
 			bucket := NewBlogBucket()
@@ -332,6 +338,7 @@ func TestCreateBlog(t *testing.T) {
 func TestChangeOwner(t *testing.T) {
 	owner := weavetest.NewCondition()
 	newOwner := weavetest.NewCondition()
+	moderator := weavetest.NewCondition()
 
 	blogID := weavetest.SequenceID(1)
 
@@ -376,6 +383,31 @@ func TestChangeOwner(t *testing.T) {
 				"NewOwner": nil,
 			},
 		},
+		"success moderator": {
+			msg: &ChangeBlogOwnerMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				BlogKey:   blogID,
+				NewOwner: newOwner.Address(),
+			},
+			owner: moderator,
+			expected: &Blog{
+				Metadata:    &weave.Metadata{Schema: 1},
+				PrimaryKey:  blogID,
+				Owner:       newOwner.Address(),
+				Title:       "insanely good title",
+				Description: "best description in the existence",
+			},
+			wantCheckErrs: map[string]*errors.Error{
+				"Metadata": nil,
+				"BlogKey":   nil,
+				"NewOwner": nil,
+			},
+			wantDeliverErrs: map[string]*errors.Error{
+				"Metadata": nil,
+				"BlogKey":   nil,
+				"NewOwner": nil,
+			},
+		},
 		"failure missing metadata": {
 			msg: &ChangeBlogOwnerMsg{
 				BlogKey:   blogID,
@@ -479,7 +511,7 @@ func TestChangeOwner(t *testing.T) {
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
 
 			kv := store.MemStore()
 			bucket := NewBlogBucket()
@@ -487,6 +519,13 @@ func TestChangeOwner(t *testing.T) {
 			err := bucket.Save(kv, blog)
 			assert.Nil(t, err)
 
+			conf := &Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    moderator.Address(),
+			}
+			err = gconf.Save(kv, packageName, conf)
+			assert.Nil(t, err)
+
 			tx := &weavetest.Tx{Msg: tc.msg}
 
 			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
@@ -889,7 +928,7 @@ func TestCreateArticle(t *testing.T) {
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
 
 			kv := store.MemStore()
 
@@ -1078,7 +1117,7 @@ func TestDeleteArticle(t *testing.T) {
 			rt := app.NewRouter()
 
 			scheduler := &weavetest.Cron{}
-			RegisterRoutes(rt, auth, scheduler)
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
 
 			kv := store.MemStore()
 
@@ -1116,6 +1155,145 @@ func TestDeleteArticle(t *testing.T) {
 	}
 }
 
+func TestHideArticle(t *testing.T) {
+	moderator := weavetest.NewCondition()
+	signer := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now())
+
+	articleID := weavetest.SequenceID(1)
+	article := &Article{
+		Metadata:   &weave.Metadata{Schema: 1},
+		PrimaryKey: articleID,
+		BlogKey:    weavetest.SequenceID(1),
+		Owner:      signer.Address(),
+		Title:      "Best hacker's blog",
+		Content:    "Best description ever",
+		CreatedAt:  now,
+	}
+
+	cases := map[string]struct {
+		msg             weave.Msg
+		signer          weave.Condition
+		expected        *Article
+		wantCheckErrs   map[string]*errors.Error
+		wantDeliverErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &HideArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: articleID,
+				Hidden:     true,
+			},
+			signer: moderator,
+			expected: &Article{
+				Metadata:   &weave.Metadata{Schema: 1},
+				PrimaryKey: articleID,
+				BlogKey:    weavetest.SequenceID(1),
+				Owner:      signer.Address(),
+				Title:      "Best hacker's blog",
+				Content:    "Best description ever",
+				CreatedAt:  now,
+				Hidden:     true,
+			},
+			wantCheckErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": nil,
+			},
+			wantDeliverErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": nil,
+			},
+		},
+		"failure missing metadata": {
+			msg: &HideArticleMsg{
+				ArticleKey: articleID,
+				Hidden:     true,
+			},
+			signer:   moderator,
+			expected: nil,
+			wantCheckErrs: map[string]*errors.Error{
+				"Metadata":   errors.ErrMetadata,
+				"ArticleKey": nil,
+			},
+			wantDeliverErrs: map[string]*errors.Error{
+				"Metadata":   errors.ErrMetadata,
+				"ArticleKey": nil,
+			},
+		},
+		"failure article owner is not a moderator": {
+			msg: &HideArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: articleID,
+				Hidden:     true,
+			},
+			signer:   signer,
+			expected: nil,
+			wantCheckErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": nil,
+			},
+			wantDeliverErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": nil,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: tc.signer,
+			}
+
+			// initalize environment
+			rt := app.NewRouter()
+
+			scheduler := &weavetest.Cron{}
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
+
+			kv := store.MemStore()
+
+			conf := &Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    moderator.Address(),
+			}
+			err := gconf.Save(kv, packageName, conf)
+			assert.Nil(t, err)
+
+			articleBucket := NewArticleBucket()
+			err = articleBucket.Save(kv, article)
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+
+			if _, err := rt.Check(ctx, kv, tx); err != nil {
+				for field, wantErr := range tc.wantCheckErrs {
+					assert.FieldError(t, err, field, wantErr)
+				}
+			}
+
+			_, err = rt.Deliver(ctx, kv, tx)
+			if tc.expected == nil {
+				if err == nil {
+					t.Fatal("expected deliver to fail")
+				}
+				for field, wantErr := range tc.wantDeliverErrs {
+					assert.FieldError(t, err, field, wantErr)
+				}
+				return
+			}
+			assert.Nil(t, err)
+
+			var stored Article
+			err = articleBucket.ByID(kv, articleID, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, &stored)
+		})
+	}
+}
+
 func TestCronDeleteArticle(t *testing.T) {
 	now := weave.AsUnixTime(time.Now())
 	future := now.Add(time.Hour)
@@ -1227,7 +1405,7 @@ func TestCronDeleteArticle(t *testing.T) {
 
 			// initalize environment
 			rt := app.NewRouter()
-			RegisterCronRoutes(rt, auth)
+			RegisterCronRoutes(rt, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
 			kv := store.MemStore()
 
 			// initalize article bucket and save articles
@@ -1260,3 +1438,1309 @@ func TestCronDeleteArticle(t *testing.T) {
 		})
 	}
 }
+
+func TestCommissionArticle(t *testing.T) {
+	requester := weavetest.NewCondition()
+	blogOwner := weavetest.NewCondition()
+
+	now := time.Now().Round(time.Second)
+
+	blogID := weavetest.SequenceID(1)
+	blog := &Blog{
+		Metadata:    &weave.Metadata{Schema: 1},
+		PrimaryKey:  blogID,
+		Owner:       blogOwner.Address(),
+		Title:       "insanely good title",
+		Description: "best description in the existence",
+		CreatedAt:   weave.AsUnixTime(now),
+	}
+
+	cases := map[string]struct {
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+		expected       *Commission
+	}{
+		"success": {
+			msg: &CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     blogID,
+				Amount:      []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+				Description: "write about weave",
+				Timeout:     weave.AsUnixTime(now.Add(time.Hour)),
+			},
+			expected: &Commission{
+				Metadata:    &weave.Metadata{Schema: 1},
+				PrimaryKey:  weavetest.SequenceID(1),
+				BlogKey:     blogID,
+				Requester:   requester.Address(),
+				Amount:      []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+				Description: "write about weave",
+				CreatedAt:   weave.AsUnixTime(now),
+				Timeout:     weave.AsUnixTime(now.Add(time.Hour)),
+			},
+		},
+		"failure blog does not exist": {
+			msg: &CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(2),
+				Amount:      []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+				Description: "write about weave",
+				Timeout:     weave.AsUnixTime(now.Add(time.Hour)),
+			},
+			wantCheckErr:   errors.ErrNotFound,
+			wantDeliverErr: errors.ErrNotFound,
+		},
+		"failure timeout in the past": {
+			msg: &CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     blogID,
+				Amount:      []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+				Description: "write about weave",
+				Timeout:     weave.AsUnixTime(now.Add(-time.Hour)),
+			},
+			wantCheckErr:   errors.ErrInput,
+			wantDeliverErr: errors.ErrInput,
+		},
+		"failure insufficient funds": {
+			msg: &CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     blogID,
+				Amount:      []*coin.Coin{coin.NewCoinp(50, 0, "IOV")},
+				Description: "write about weave",
+				Timeout:     weave.AsUnixTime(now.Add(time.Hour)),
+			},
+			wantDeliverErr: errors.ErrAmount,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: requester,
+			}
+
+			rt := app.NewRouter()
+			ctrl := cash.NewController(cash.NewBucket())
+			scheduler := &weavetest.Cron{}
+			RegisterRoutes(rt, auth, ctrl, scheduler)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			err := NewBlogBucket().Save(kv, blog)
+			assert.Nil(t, err)
+			err = ctrl.CoinMint(kv, requester.Address(), coin.NewCoin(10, 0, "IOV"))
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+			ctx := weave.WithBlockTime(context.Background(), now)
+
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			res, err := rt.Deliver(ctx, kv, tx)
+			if !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if tc.expected == nil {
+				return
+			}
+
+			var stored Commission
+			err = NewCommissionBucket().ByID(kv, res.Data, &stored)
+			assert.Nil(t, err)
+			if len(stored.TimeoutTaskID) == 0 {
+				t.Fatal("refund task was not scheduled")
+			}
+			// task ID is random
+			tc.expected.TimeoutTaskID = stored.TimeoutTaskID
+			assert.Equal(t, tc.expected, &stored)
+
+			assertBalance(t, kv, ctrl, requester.Address(), coin.NewCoin(5, 0, "IOV"))
+			assertBalance(t, kv, ctrl, stored.Address(), coin.NewCoin(5, 0, "IOV"))
+		})
+	}
+}
+
+func TestFulfillCommission(t *testing.T) {
+	requester := weavetest.NewCondition()
+	blogOwner := weavetest.NewCondition()
+
+	now := time.Now().Round(time.Second)
+
+	blogID := weavetest.SequenceID(1)
+	blog := &Blog{
+		Metadata:    &weave.Metadata{Schema: 1},
+		PrimaryKey:  blogID,
+		Owner:       blogOwner.Address(),
+		Title:       "insanely good title",
+		Description: "best description in the existence",
+		CreatedAt:   weave.AsUnixTime(now.Add(-2 * time.Hour)),
+	}
+
+	newArticle := func(id, blogID uint64, createdAt time.Time) *Article {
+		return &Article{
+			Metadata:   &weave.Metadata{Schema: 1},
+			PrimaryKey: weavetest.SequenceID(id),
+			BlogKey:    weavetest.SequenceID(blogID),
+			Owner:      blogOwner.Address(),
+			Title:      "Best hacker's blog",
+			Content:    "Best description ever",
+			CreatedAt:  weave.AsUnixTime(createdAt),
+		}
+	}
+	articles := []*Article{
+		newArticle(1, 1, now),
+		newArticle(2, 2, now),
+		newArticle(3, 1, now.Add(-2*time.Hour)),
+	}
+
+	newCommission := func(timeout time.Time, articleKey []byte) *Commission {
+		return &Commission{
+			Metadata:    &weave.Metadata{Schema: 1},
+			PrimaryKey:  weavetest.SequenceID(1),
+			BlogKey:     blogID,
+			Requester:   requester.Address(),
+			Amount:      []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+			Description: "write about weave",
+			CreatedAt:   weave.AsUnixTime(now.Add(-time.Hour)),
+			Timeout:     weave.AsUnixTime(timeout),
+			ArticleKey:  articleKey,
+		}
+	}
+
+	cases := map[string]struct {
+		commission *Commission
+		signer     weave.Condition
+		msg        weave.Msg
+		wantErr    *errors.Error
+	}{
+		"success": {
+			commission: newCommission(now.Add(time.Hour), nil),
+			signer:     blogOwner,
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(1),
+				ArticleKey:    weavetest.SequenceID(1),
+			},
+		},
+		"failure signer does not own the blog": {
+			commission: newCommission(now.Add(time.Hour), nil),
+			signer:     requester,
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(1),
+				ArticleKey:    weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"failure article posted to another blog": {
+			commission: newCommission(now.Add(time.Hour), nil),
+			signer:     blogOwner,
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(1),
+				ArticleKey:    weavetest.SequenceID(2),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"failure article created before the commission": {
+			commission: newCommission(now.Add(time.Hour), nil),
+			signer:     blogOwner,
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(1),
+				ArticleKey:    weavetest.SequenceID(3),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"failure commission timed out": {
+			commission: newCommission(now, nil),
+			signer:     blogOwner,
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(1),
+				ArticleKey:    weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrExpired,
+		},
+		"failure commission already fulfilled": {
+			commission: newCommission(now.Add(time.Hour), weavetest.SequenceID(1)),
+			signer:     blogOwner,
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(1),
+				ArticleKey:    weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrState,
+		},
+		"failure commission does not exist": {
+			commission: newCommission(now.Add(time.Hour), nil),
+			signer:     blogOwner,
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(2),
+				ArticleKey:    weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: tc.signer,
+			}
+
+			rt := app.NewRouter()
+			ctrl := cash.NewController(cash.NewBucket())
+			scheduler := &weavetest.Cron{}
+			RegisterRoutes(rt, auth, ctrl, scheduler)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			err := NewBlogBucket().Save(kv, blog)
+			assert.Nil(t, err)
+			for _, a := range articles {
+				err := NewArticleBucket().Save(kv, a)
+				assert.Nil(t, err)
+			}
+			taskID, err := scheduler.Schedule(kv, tc.commission.Timeout.Time(), nil, &RefundCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: tc.commission.PrimaryKey,
+			})
+			assert.Nil(t, err)
+			tc.commission.TimeoutTaskID = taskID
+
+			commissionBucket := NewCommissionBucket()
+			err = commissionBucket.Save(kv, tc.commission)
+			assert.Nil(t, err)
+			err = ctrl.CoinMint(kv, tc.commission.Address(), coin.NewCoin(5, 0, "IOV"))
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+			ctx := weave.WithBlockTime(context.Background(), now)
+
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			if _, err := rt.Deliver(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var stored Commission
+			err = commissionBucket.ByID(kv, tc.commission.PrimaryKey, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, weavetest.SequenceID(1), stored.ArticleKey)
+			assert.Equal(t, 0, len(stored.TimeoutTaskID))
+
+			assertBalance(t, kv, ctrl, blogOwner.Address(), coin.NewCoin(5, 0, "IOV"))
+			assertBalance(t, kv, ctrl, stored.Address(), coin.Coin{})
+		})
+	}
+}
+
+func TestCronRefundCommission(t *testing.T) {
+	requester := weavetest.NewCondition()
+
+	now := time.Now().Round(time.Second)
+
+	newCommission := func(articleKey []byte) *Commission {
+		return &Commission{
+			Metadata:    &weave.Metadata{Schema: 1},
+			PrimaryKey:  weavetest.SequenceID(1),
+			BlogKey:     weavetest.SequenceID(1),
+			Requester:   requester.Address(),
+			Amount:      []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+			Description: "write about weave",
+			CreatedAt:   weave.AsUnixTime(now.Add(-time.Hour)),
+			Timeout:     weave.AsUnixTime(now),
+			ArticleKey:  articleKey,
+		}
+	}
+
+	cases := map[string]struct {
+		commission *Commission
+		wantErr    *errors.Error
+	}{
+		"success": {
+			commission: newCommission(nil),
+		},
+		"failure commission already fulfilled": {
+			commission: newCommission(weavetest.SequenceID(1)),
+			wantErr:    errors.ErrState,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{}
+
+			rt := app.NewRouter()
+			ctrl := cash.NewController(cash.NewBucket())
+			RegisterCronRoutes(rt, auth, ctrl, &weavetest.Cron{})
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			commissionBucket := NewCommissionBucket()
+			err := commissionBucket.Save(kv, tc.commission)
+			assert.Nil(t, err)
+			err = ctrl.CoinMint(kv, tc.commission.Address(), coin.NewCoin(5, 0, "IOV"))
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: &RefundCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: tc.commission.PrimaryKey,
+			}}
+			ctx := weave.WithBlockTime(context.Background(), now)
+
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			if _, err := rt.Deliver(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			if err := commissionBucket.Has(kv, tc.commission.PrimaryKey); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("commission was not deleted: %v", err)
+			}
+			assertBalance(t, kv, ctrl, requester.Address(), coin.NewCoin(5, 0, "IOV"))
+			assertBalance(t, kv, ctrl, tc.commission.Address(), coin.Coin{})
+		})
+	}
+}
+
+// assertBalance ensures that given address holds exactly the wanted amount.
+// Zero value coin means the address is expected to be empty.
+func assertBalance(t testing.TB, kv weave.KVStore, ctrl cash.Balancer, addr weave.Address, want coin.Coin) {
+	t.Helper()
+
+	balance, err := ctrl.Balance(kv, addr)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		t.Fatalf("cannot get balance: %s", err)
+	}
+	if want.IsZero() {
+		if !balance.IsEmpty() {
+			t.Fatalf("want empty balance, got %v", balance)
+		}
+		return
+	}
+	if !balance.Equals(coin.Coins{&want}) {
+		t.Fatalf("want %v balance, got %v", want, balance)
+	}
+}
+
+func TestPinArticle(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now())
+
+	blogID := weavetest.SequenceID(1)
+	newBlog := func(pinned ...[]byte) *Blog {
+		return &Blog{
+			Metadata:          &weave.Metadata{Schema: 1},
+			PrimaryKey:        blogID,
+			Owner:             owner.Address(),
+			Title:             "insanely good title",
+			Description:       "best description in the existence",
+			CreatedAt:         now,
+			PinnedArticleKeys: pinned,
+		}
+	}
+
+	articles := make([]*Article, 5)
+	for i := range articles {
+		articles[i] = &Article{
+			Metadata:   &weave.Metadata{Schema: 1},
+			PrimaryKey: weavetest.SequenceID(uint64(i + 1)),
+			BlogKey:    blogID,
+			Owner:      owner.Address(),
+			Title:      "Best hacker's blog",
+			Content:    "Best description ever",
+			CreatedAt:  now,
+		}
+	}
+
+	cases := map[string]struct {
+		blog       *Blog
+		signer     weave.Condition
+		msg        weave.Msg
+		wantErr    *errors.Error
+		wantPinned [][]byte
+	}{
+		"success pin": {
+			blog:   newBlog(weavetest.SequenceID(2)),
+			signer: owner,
+			msg: &PinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantPinned: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(1)},
+		},
+		"failure pin by not the blog owner": {
+			blog:   newBlog(),
+			signer: weavetest.NewCondition(),
+			msg: &PinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"failure pin already pinned article": {
+			blog:   newBlog(weavetest.SequenceID(1)),
+			signer: owner,
+			msg: &PinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrDuplicate,
+		},
+		"failure pin over the limit": {
+			blog:   newBlog(weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(3)),
+			signer: owner,
+			msg: &PinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(4),
+			},
+			wantErr: errors.ErrState,
+		},
+		"failure pin not existing article": {
+			blog:   newBlog(),
+			signer: owner,
+			msg: &PinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(6),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		"success unpin": {
+			blog:   newBlog(weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(3)),
+			signer: owner,
+			msg: &UnpinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(2),
+			},
+			wantPinned: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(3)},
+		},
+		"failure unpin not pinned article": {
+			blog:   newBlog(weavetest.SequenceID(1)),
+			signer: owner,
+			msg: &UnpinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(2),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		"failure unpin by not the blog owner": {
+			blog:   newBlog(weavetest.SequenceID(1)),
+			signer: weavetest.NewCondition(),
+			msg: &UnpinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: tc.signer,
+			}
+
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
+
+			kv := store.MemStore()
+
+			blogBucket := NewBlogBucket()
+			err := blogBucket.Save(kv, tc.blog)
+			assert.Nil(t, err)
+			for _, a := range articles {
+				err := NewArticleBucket().Save(kv, a)
+				assert.Nil(t, err)
+			}
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			if _, err := rt.Deliver(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var stored Blog
+			err = blogBucket.ByID(kv, blogID, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantPinned, stored.PinnedArticleKeys)
+		})
+	}
+}
+
+func TestDeleteArticleUnpins(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now())
+
+	blogID := weavetest.SequenceID(1)
+	blog := &Blog{
+		Metadata:          &weave.Metadata{Schema: 1},
+		PrimaryKey:        blogID,
+		Owner:             owner.Address(),
+		Title:             "insanely good title",
+		Description:       "best description in the existence",
+		CreatedAt:         now,
+		PinnedArticleKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+	}
+
+	msg := &DeleteArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ArticleKey: weavetest.SequenceID(1),
+	}
+
+	cases := map[string]struct {
+		register func(weave.Registry, *weavetest.Auth)
+	}{
+		"delete by the owner": {
+			register: func(r weave.Registry, auth *weavetest.Auth) {
+				RegisterRoutes(r, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
+			},
+		},
+		"delete by the cron": {
+			register: func(r weave.Registry, auth *weavetest.Auth) {
+				RegisterCronRoutes(r, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: owner,
+			}
+
+			rt := app.NewRouter()
+			tc.register(rt, auth)
+
+			kv := store.MemStore()
+
+			blogBucket := NewBlogBucket()
+			err := blogBucket.Save(kv, blog)
+			assert.Nil(t, err)
+			err = NewArticleBucket().Save(kv, &Article{
+				Metadata:   &weave.Metadata{Schema: 1},
+				PrimaryKey: weavetest.SequenceID(1),
+				BlogKey:    blogID,
+				Owner:      owner.Address(),
+				Title:      "Best hacker's blog",
+				Content:    "Best description ever",
+				CreatedAt:  now,
+			})
+			assert.Nil(t, err)
+
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+			_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: msg})
+			assert.Nil(t, err)
+
+			var stored Blog
+			err = blogBucket.ByID(kv, blogID, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{weavetest.SequenceID(2)}, stored.PinnedArticleKeys)
+		})
+	}
+}
+
+func TestCreateArticleSchedulesDelete(t *testing.T) {
+	owner := weavetest.NewCondition()
+	auth := &weavetest.Auth{Signer: owner}
+	now := time.Now().Round(time.Second)
+
+	scheduler := &recordingScheduler{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
+	cronRt := app.NewRouter()
+	RegisterCronRoutes(cronRt, auth, cash.NewController(cash.NewBucket()), scheduler)
+
+	kv := store.MemStore()
+	blogID := weavetest.SequenceID(1)
+	err := NewBlogBucket().Save(kv, &Blog{
+		Metadata:    &weave.Metadata{Schema: 1},
+		PrimaryKey:  blogID,
+		Owner:       owner.Address(),
+		Title:       "insanely good title",
+		Description: "best description in the existence",
+		CreatedAt:   weave.AsUnixTime(now),
+	})
+	assert.Nil(t, err)
+
+	ctx := weave.WithBlockTime(context.Background(), now)
+	res, err := rt.Deliver(ctx, kv, &weavetest.Tx{Msg: &CreateArticleMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		BlogKey:  blogID,
+		Title:    "Best hacker's blog",
+		Content:  "Best description ever",
+		DeleteAt: weave.AsUnixTime(now.Add(time.Hour)),
+	}})
+	assert.Nil(t, err)
+
+	articleTags := []common.KVPair{
+		{Key: []byte(TagBlog), Value: []byte("1")},
+		{Key: []byte(TagArticle), Value: []byte("1")},
+		{Key: []byte(TagOwner), Value: []byte(owner.Address().String())},
+	}
+	assert.Equal(t, append([]common.KVPair{{Key: []byte(TagAction), Value: []byte("blog/create_article")}}, articleTags...), res.Tags)
+
+	// The task must reference the created article.
+	assert.Equal(t, 1, len(scheduler.msgs))
+	task, ok := scheduler.msgs[0].(*DeleteArticleMsg)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, res.Data, task.ArticleKey)
+
+	articles := NewArticleBucket()
+	var stored Article
+	assert.Nil(t, articles.ByID(kv, res.Data, &stored))
+	assert.Equal(t, true, len(stored.DeleteTaskID) != 0)
+
+	ctx = weave.WithBlockTime(context.Background(), now.Add(time.Hour))
+	cronRes, err := cronRt.Deliver(ctx, kv, &weavetest.Tx{Msg: task})
+	assert.Nil(t, err)
+	assert.Equal(t, append([]common.KVPair{{Key: []byte(TagAction), Value: []byte("blog/delete_article")}}, articleTags...), cronRes.Tags)
+	err = articles.ByID(kv, res.Data, &stored)
+	assert.IsErr(t, errors.ErrNotFound, err)
+}
+
+// recordingScheduler records the messages of scheduled tasks.
+type recordingScheduler struct {
+	weavetest.Cron
+	msgs []weave.Msg
+}
+
+func (s *recordingScheduler) Schedule(db weave.KVStore, runAt time.Time, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	s.msgs = append(s.msgs, msg)
+	return s.Cron.Schedule(db, runAt, auth, msg)
+}
+
+func TestSeries(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now())
+
+	blogs := []*Blog{
+		{
+			Metadata:    &weave.Metadata{Schema: 1},
+			PrimaryKey:  weavetest.SequenceID(1),
+			Owner:       owner.Address(),
+			Title:       "insanely good title",
+			Description: "best description in the existence",
+			CreatedAt:   now,
+		},
+		{
+			Metadata:    &weave.Metadata{Schema: 1},
+			PrimaryKey:  weavetest.SequenceID(2),
+			Owner:       owner.Address(),
+			Title:       "another good title",
+			Description: "another description in the existence",
+			CreatedAt:   now,
+		},
+	}
+
+	// Articles 1-4 belong to the first blog, article 5 to the second one.
+	articles := make([]*Article, 5)
+	for i := range articles {
+		blogID := weavetest.SequenceID(1)
+		if i == 4 {
+			blogID = weavetest.SequenceID(2)
+		}
+		articles[i] = &Article{
+			Metadata:   &weave.Metadata{Schema: 1},
+			PrimaryKey: weavetest.SequenceID(uint64(i + 1)),
+			BlogKey:    blogID,
+			Owner:      owner.Address(),
+			Title:      "Best hacker's blog",
+			Content:    "Best description ever",
+			CreatedAt:  now,
+		}
+	}
+
+	// Series is stored using the bucket sequence, so it is always the
+	// first one.
+	seriesID := weavetest.SequenceID(1)
+	newSeries := func() *Series {
+		return &Series{
+			Metadata:    &weave.Metadata{Schema: 1},
+			BlogKey:     weavetest.SequenceID(1),
+			Title:       "Weave tutorial",
+			Description: "All you need to know",
+			ArticleKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2)},
+			CreatedAt:   now,
+		}
+	}
+
+	cases := map[string]struct {
+		signer       weave.Condition
+		msg          weave.Msg
+		wantErr      *errors.Error
+		wantSeries   []byte
+		wantArticles [][]byte
+	}{
+		"success create": {
+			signer: owner,
+			msg: &CreateSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Title:       "Another tutorial",
+				Description: "All you need to know again",
+				ArticleKeys: [][]byte{weavetest.SequenceID(3), weavetest.SequenceID(1)},
+			},
+			wantSeries:   weavetest.SequenceID(2),
+			wantArticles: [][]byte{weavetest.SequenceID(3), weavetest.SequenceID(1)},
+		},
+		"success create empty": {
+			signer: owner,
+			msg: &CreateSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Title:       "Another tutorial",
+				Description: "All you need to know again",
+			},
+			wantSeries: weavetest.SequenceID(2),
+		},
+		"failure create by not the blog owner": {
+			signer: weavetest.NewCondition(),
+			msg: &CreateSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Title:       "Another tutorial",
+				Description: "All you need to know again",
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"failure create with article of another blog": {
+			signer: owner,
+			msg: &CreateSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Title:       "Another tutorial",
+				Description: "All you need to know again",
+				ArticleKeys: [][]byte{weavetest.SequenceID(5)},
+			},
+			wantErr: errors.ErrInput,
+		},
+		"success append": {
+			signer: owner,
+			msg: &AppendSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesID,
+				ArticleKey: weavetest.SequenceID(4),
+			},
+			wantSeries:   seriesID,
+			wantArticles: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(2), weavetest.SequenceID(4)},
+		},
+		"failure append article of another blog": {
+			signer: owner,
+			msg: &AppendSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesID,
+				ArticleKey: weavetest.SequenceID(5),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"failure append article already in the series": {
+			signer: owner,
+			msg: &AppendSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesID,
+				ArticleKey: weavetest.SequenceID(2),
+			},
+			wantErr: errors.ErrDuplicate,
+		},
+		"failure append not existing article": {
+			signer: owner,
+			msg: &AppendSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesID,
+				ArticleKey: weavetest.SequenceID(6),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		"failure append by not the blog owner": {
+			signer: weavetest.NewCondition(),
+			msg: &AppendSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesID,
+				ArticleKey: weavetest.SequenceID(4),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"success reorder": {
+			signer: owner,
+			msg: &ReorderSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesKey:   seriesID,
+				ArticleKeys: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(1)},
+			},
+			wantSeries:   seriesID,
+			wantArticles: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(1)},
+		},
+		"failure reorder with missing article": {
+			signer: owner,
+			msg: &ReorderSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesKey:   seriesID,
+				ArticleKeys: [][]byte{weavetest.SequenceID(2)},
+			},
+			wantErr: errors.ErrInput,
+		},
+		"failure reorder with article not in the series": {
+			signer: owner,
+			msg: &ReorderSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesKey:   seriesID,
+				ArticleKeys: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(3)},
+			},
+			wantErr: errors.ErrInput,
+		},
+		"success remove": {
+			signer: owner,
+			msg: &RemoveSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesID,
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantSeries:   seriesID,
+			wantArticles: [][]byte{weavetest.SequenceID(2)},
+		},
+		"failure remove article not in the series": {
+			signer: owner,
+			msg: &RemoveSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  seriesID,
+				ArticleKey: weavetest.SequenceID(3),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		"failure remove from not existing series": {
+			signer: owner,
+			msg: &RemoveSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  weavetest.SequenceID(9),
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: tc.signer,
+			}
+
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
+
+			kv := store.MemStore()
+
+			for _, b := range blogs {
+				err := NewBlogBucket().Save(kv, b)
+				assert.Nil(t, err)
+			}
+			for _, a := range articles {
+				err := NewArticleBucket().Save(kv, a)
+				assert.Nil(t, err)
+			}
+			seriesBucket := NewSeriesBucket()
+			err := seriesBucket.Save(kv, newSeries())
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+
+			if _, err := rt.Check(ctx, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			res, err := rt.Deliver(ctx, kv, tx)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			assert.Equal(t, tc.wantSeries, res.Data)
+
+			var stored Series
+			err = seriesBucket.ByID(kv, tc.wantSeries, &stored)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantArticles, stored.ArticleKeys)
+		})
+	}
+}
+
+func TestDeleteArticleUpdatesSeries(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := weave.AsUnixTime(time.Now())
+
+	blogID := weavetest.SequenceID(1)
+
+	msg := &DeleteArticleMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ArticleKey: weavetest.SequenceID(1),
+	}
+
+	cases := map[string]struct {
+		register func(weave.Registry, *weavetest.Auth)
+	}{
+		"delete by the owner": {
+			register: func(r weave.Registry, auth *weavetest.Auth) {
+				RegisterRoutes(r, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
+			},
+		},
+		"delete by the cron": {
+			register: func(r weave.Registry, auth *weavetest.Auth) {
+				RegisterCronRoutes(r, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: owner,
+			}
+
+			rt := app.NewRouter()
+			tc.register(rt, auth)
+
+			kv := store.MemStore()
+
+			err := NewBlogBucket().Save(kv, &Blog{
+				Metadata:    &weave.Metadata{Schema: 1},
+				PrimaryKey:  blogID,
+				Owner:       owner.Address(),
+				Title:       "insanely good title",
+				Description: "best description in the existence",
+				CreatedAt:   now,
+			})
+			assert.Nil(t, err)
+			for i := uint64(1); i <= 2; i++ {
+				err := NewArticleBucket().Save(kv, &Article{
+					Metadata:   &weave.Metadata{Schema: 1},
+					PrimaryKey: weavetest.SequenceID(i),
+					BlogKey:    blogID,
+					Owner:      owner.Address(),
+					Title:      "Best hacker's blog",
+					Content:    "Best description ever",
+					CreatedAt:  now,
+				})
+				assert.Nil(t, err)
+			}
+
+			// Two series containing the deleted article and one that does not.
+			seriesBucket := NewSeriesBucket()
+			seriesArticles := [][][]byte{
+				{weavetest.SequenceID(2), weavetest.SequenceID(1)},
+				{weavetest.SequenceID(1)},
+				{weavetest.SequenceID(2)},
+			}
+			for i, keys := range seriesArticles {
+				err := seriesBucket.Save(kv, &Series{
+					Metadata:    &weave.Metadata{Schema: 1},
+					PrimaryKey:  weavetest.SequenceID(uint64(i + 1)),
+					BlogKey:     blogID,
+					Title:       "Weave tutorial",
+					Description: "All you need to know",
+					ArticleKeys: keys,
+					CreatedAt:   now,
+				})
+				assert.Nil(t, err)
+			}
+
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+			_, err = rt.Deliver(ctx, kv, &weavetest.Tx{Msg: msg})
+			assert.Nil(t, err)
+
+			wantArticles := [][][]byte{
+				{weavetest.SequenceID(2)},
+				{},
+				{weavetest.SequenceID(2)},
+			}
+			for i, want := range wantArticles {
+				var stored Series
+				err := seriesBucket.ByID(kv, weavetest.SequenceID(uint64(i+1)), &stored)
+				assert.Nil(t, err)
+				if len(want) == 0 {
+					want = nil
+				}
+				assert.Equal(t, want, stored.ArticleKeys)
+			}
+		})
+	}
+}
+
+func TestCreateArticleRateLimit(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := time.Now().Round(time.Second)
+
+	cases := map[string]struct {
+		conf  *Configuration
+		steps []struct {
+			at      time.Duration
+			wantErr *errors.Error
+		}
+		wantActions int
+	}{
+		"rate limiting disabled": {
+			conf: &Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			steps: []struct {
+				at      time.Duration
+				wantErr *errors.Error
+			}{
+				{at: 0},
+				{at: time.Second},
+				{at: 2 * time.Second},
+			},
+			wantActions: 0,
+		},
+		"limit exceeded within the window": {
+			conf: &Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				ArticleRateLimit: 2,
+				RateLimitWindow:  weave.AsUnixDuration(time.Hour),
+			},
+			steps: []struct {
+				at      time.Duration
+				wantErr *errors.Error
+			}{
+				{at: 0},
+				{at: time.Minute},
+				{at: 2 * time.Minute, wantErr: ErrRateLimited},
+				{at: 59 * time.Minute, wantErr: ErrRateLimited},
+			},
+			wantActions: 2,
+		},
+		"window is sliding": {
+			conf: &Configuration{
+				Metadata:         &weave.Metadata{Schema: 1},
+				ArticleRateLimit: 2,
+				RateLimitWindow:  weave.AsUnixDuration(time.Hour),
+			},
+			steps: []struct {
+				at      time.Duration
+				wantErr *errors.Error
+			}{
+				{at: 0},
+				{at: 30 * time.Minute},
+				{at: time.Hour},
+				{at: time.Hour + time.Minute, wantErr: ErrRateLimited},
+				{at: 90 * time.Minute},
+			},
+			wantActions: 2,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{
+				Signer: owner,
+			}
+
+			rt := app.NewRouter()
+			scheduler := &weavetest.Cron{}
+			RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), scheduler)
+
+			// Check and deliver are executed on separate states, the
+			// same way the node does.
+			checkKv := store.MemStore()
+			kv := store.MemStore()
+			blogID := weavetest.SequenceID(1)
+			for _, db := range []weave.KVStore{checkKv, kv} {
+				saveRateLimitedBlog(t, db, tc.conf, blogID, owner.Address(), now)
+			}
+
+			tx := &weavetest.Tx{Msg: &CreateArticleMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				BlogKey:  blogID,
+				Title:    "Best hacker's blog",
+				Content:  "Best description ever",
+			}}
+
+			for i, step := range tc.steps {
+				ctx := weave.WithBlockTime(context.Background(), now.Add(step.at))
+				if _, err := rt.Check(ctx, checkKv, tx); !step.wantErr.Is(err) {
+					t.Fatalf("step %d: unexpected check error: %s", i, err)
+				}
+				if _, err := rt.Deliver(ctx, kv, tx); !step.wantErr.Is(err) {
+					t.Fatalf("step %d: unexpected deliver error: %s", i, err)
+				}
+			}
+
+			var rl RateLimit
+			err := NewRateLimitBucket().One(kv, owner.Address(), &rl)
+			if tc.wantActions == 0 {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want no rate limit, got %v", err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantActions, len(rl.ActionTimes))
+			if len(rl.ExpireTaskID) == 0 {
+				t.Fatal("expire task not scheduled")
+			}
+		})
+	}
+}
+
+func TestCheckCreateArticleRateLimit(t *testing.T) {
+	owner := weavetest.NewCondition()
+	auth := &weavetest.Auth{Signer: owner}
+
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, cash.NewController(cash.NewBucket()), &weavetest.Cron{})
+
+	now := time.Now().Round(time.Second)
+	conf := &Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		ArticleRateLimit: 1,
+		RateLimitWindow:  weave.AsUnixDuration(time.Hour),
+	}
+	kv := store.MemStore()
+	blogID := weavetest.SequenceID(1)
+	saveRateLimitedBlog(t, kv, conf, blogID, owner.Address(), now)
+
+	tx := &weavetest.Tx{Msg: &CreateArticleMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		BlogKey:  blogID,
+		Title:    "Best hacker's blog",
+		Content:  "Best description ever",
+	}}
+
+	// Articles waiting in the mempool count towards the limit.
+	ctx := weave.WithBlockTime(context.Background(), now)
+	if _, err := rt.Check(ctx, kv, tx); err != nil {
+		t.Fatalf("first check: %s", err)
+	}
+	if _, err := rt.Check(ctx, kv, tx); !ErrRateLimited.Is(err) {
+		t.Fatalf("want rate limited second check, got %v", err)
+	}
+}
+
+// saveRateLimitedBlog stores given configuration and a blog of given owner.
+func saveRateLimitedBlog(t testing.TB, db weave.KVStore, conf *Configuration, blogID []byte, owner weave.Address, now time.Time) {
+	t.Helper()
+
+	err := gconf.Save(db, packageName, conf)
+	assert.Nil(t, err)
+	err = NewBlogBucket().Save(db, &Blog{
+		Metadata:    &weave.Metadata{Schema: 1},
+		PrimaryKey:  blogID,
+		Owner:       owner,
+		Title:       "insanely good title",
+		Description: "best description in the existence",
+		CreatedAt:   weave.AsUnixTime(now),
+	})
+	assert.Nil(t, err)
+}
+
+func TestCronExpireRateLimit(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	now := time.Now().Round(time.Second)
+
+	conf := &Configuration{
+		Metadata:         &weave.Metadata{Schema: 1},
+		ArticleRateLimit: 2,
+		RateLimitWindow:  weave.AsUnixDuration(time.Hour),
+	}
+
+	cases := map[string]struct {
+		conf        *Configuration
+		actionTimes []weave.UnixTime
+		at          time.Duration
+		wantTimes   []weave.UnixTime
+		wantDeleted bool
+	}{
+		"expired action times are removed": {
+			conf: conf,
+			actionTimes: []weave.UnixTime{
+				weave.AsUnixTime(now),
+				weave.AsUnixTime(now.Add(30 * time.Minute)),
+			},
+			at:        time.Hour,
+			wantTimes: []weave.UnixTime{weave.AsUnixTime(now.Add(30 * time.Minute))},
+		},
+		"rate limit without action times is deleted": {
+			conf: conf,
+			actionTimes: []weave.UnixTime{
+				weave.AsUnixTime(now),
+				weave.AsUnixTime(now.Add(30 * time.Minute)),
+			},
+			at:          2 * time.Hour,
+			wantDeleted: true,
+		},
+		"rate limit is deleted when rate limiting is disabled": {
+			conf: &Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			actionTimes: []weave.UnixTime{
+				weave.AsUnixTime(now),
+			},
+			at:          0,
+			wantDeleted: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			rt := app.NewRouter()
+			scheduler := &weavetest.Cron{}
+			RegisterCronRoutes(rt, &weavetest.Auth{}, cash.NewController(cash.NewBucket()), scheduler)
+
+			kv := store.MemStore()
+
+			err := gconf.Save(kv, packageName, tc.conf)
+			assert.Nil(t, err)
+
+			bucket := NewRateLimitBucket()
+			_, err = bucket.Put(kv, owner.Address(), &RateLimit{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Address:      owner.Address(),
+				ActionTimes:  tc.actionTimes,
+				ExpireTaskID: weavetest.SequenceID(1),
+			})
+			assert.Nil(t, err)
+
+			tx := &weavetest.Tx{Msg: &ExpireRateLimitMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Address:  owner.Address(),
+			}}
+			ctx := weave.WithBlockTime(context.Background(), now.Add(tc.at))
+			_, err = rt.Deliver(ctx, kv, tx)
+			assert.Nil(t, err)
+
+			var rl RateLimit
+			err = bucket.One(kv, owner.Address(), &rl)
+			if tc.wantDeleted {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want rate limit deleted, got %v", err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantTimes, rl.ActionTimes)
+			if len(rl.ExpireTaskID) == 0 || bytes.Equal(rl.ExpireTaskID, weavetest.SequenceID(1)) {
+				t.Fatal("next expire task not scheduled")
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package blog
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file.
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+
+// FromGenesis will parse the blog configuration from genesis and save it in
+// the database. Configuration is optional, without it moderation is disabled.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	err := gconf.InitConfig(kv, opts, packageName, &Configuration{})
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "init config")
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package blog
 
 import (
+	"bytes"
 	"regexp"
 	"time"
 
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 )
@@ -55,8 +57,25 @@ func (m *Blog) SetPrimaryKey(pk []byte) error {
 	return nil
 }
 
-var validBlogTitle = regexp.MustCompile(`^[a-zA-Z0-9$@$!%*?&#'^;-_. +]{4,32}$`).MatchString
-var validBlogDescription = regexp.MustCompile(`^[a-zA-Z0-9$@$!%*?&#'^;-_. +]{4,1000}$`).MatchString
+// textChars is the class of characters allowed in titles, descriptions and
+// article contents.
+const textChars = `[a-zA-Z0-9$@$!%*?&#'^;-_. +]`
+
+var validBlogTitle = regexp.MustCompile(`^` + textChars + `{4,32}$`).MatchString
+var validBlogDescription = regexp.MustCompile(`^` + textChars + `{4,1000}$`).MatchString
+var validTextChar = regexp.MustCompile(`^` + textChars + `$`).MatchString
+
+// InvalidTextIndex returns the byte index of the first character of given
+// text that is not allowed in a title, a description or an article content,
+// or -1 if all characters are allowed. The text length is not checked.
+func InvalidTextIndex(text string) int {
+	for i, r := range text {
+		if !validTextChar(string(r)) {
+			return i
+		}
+	}
+	return -1
+}
 
 // Validate validates blog's fields
 func (m *Blog) Validate() error {
@@ -79,9 +98,35 @@ func (m *Blog) Validate() error {
 		errs = errors.AppendField(errs, "CreatedAt", errors.ErrEmpty)
 	}
 
+	errs = errors.AppendField(errs, "PinnedArticleKeys", validateKeyList(m.PinnedArticleKeys))
+
 	return errs
 }
 
+// validateKeyList ensures that all keys are valid sequences and that none of
+// them is present more than once.
+func validateKeyList(keys [][]byte) error {
+	for i, key := range keys {
+		if err := orm.ValidateSequence(key); err != nil {
+			return errors.Wrapf(err, "key %d", i)
+		}
+		if _, ok := indexOfKey(keys[:i], key); ok {
+			return errors.Wrapf(errors.ErrDuplicate, "key %d", i)
+		}
+	}
+	return nil
+}
+
+// indexOfKey returns the position of the key in given list.
+func indexOfKey(keys [][]byte, key []byte) (int, bool) {
+	for i, k := range keys {
+		if bytes.Equal(k, key) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
 var _ orm.SerialModel = (*Blog)(nil)
 
 // SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
@@ -124,3 +169,125 @@ func (m *Article) Validate() error {
 	return errs
 }
 
+// Published returns true if the article can be displayed at given time.
+// Hidden articles and articles that reached their deletion time are not
+// published, even if the scheduled task did not delete them yet.
+func (m *Article) Published(now weave.UnixTime) bool {
+	return !m.Hidden && (m.DeleteAt == 0 || m.DeleteAt > now)
+}
+
+var _ orm.SerialModel = (*Commission)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Commission) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates commission's fields
+func (m *Commission) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "BlogKey", orm.ValidateSequence(m.BlogKey))
+	errs = errors.AppendField(errs, "Requester", m.Requester.Validate())
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+
+	if !validBlogDescription(m.Description) {
+		errs = errors.AppendField(errs, "Description", errors.ErrModel)
+	}
+
+	if err := m.CreatedAt.Validate(); err != nil {
+		errs = errors.AppendField(errs, "CreatedAt", err)
+	} else if m.CreatedAt == 0 {
+		errs = errors.AppendField(errs, "CreatedAt", errors.ErrEmpty)
+	}
+
+	if err := m.Timeout.Validate(); err != nil {
+		errs = errors.AppendField(errs, "Timeout", err)
+	} else if m.Timeout == 0 {
+		errs = errors.AppendField(errs, "Timeout", errors.ErrEmpty)
+	}
+
+	if len(m.ArticleKey) != 0 {
+		errs = errors.AppendField(errs, "ArticleKey", orm.ValidateSequence(m.ArticleKey))
+	}
+
+	return errs
+}
+
+// Address returns the address that holds the commissioned funds until the
+// commission is fulfilled or refunded.
+func (m *Commission) Address() weave.Address {
+	return CommissionCondition(m.PrimaryKey).Address()
+}
+
+// CommissionCondition returns the condition of a commission with given key.
+// Funds locked by the commission are kept on this condition's address.
+func CommissionCondition(key []byte) weave.Condition {
+	return weave.NewCondition(packageName, "commission", key)
+}
+
+var _ orm.SerialModel = (*Series)(nil)
+
+// SetPrimaryKey is a minimal implementation, useful when the PrimaryKey is a separate protobuf field
+func (m *Series) SetPrimaryKey(pk []byte) error {
+	m.PrimaryKey = pk
+	return nil
+}
+
+// Validate validates series fields
+func (m *Series) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "PrimaryKey", orm.ValidateSequence(m.PrimaryKey))
+	errs = errors.AppendField(errs, "BlogKey", orm.ValidateSequence(m.BlogKey))
+
+	if !validBlogTitle(m.Title) {
+		errs = errors.AppendField(errs, "Title", errors.ErrModel)
+	}
+	if !validBlogDescription(m.Description) {
+		errs = errors.AppendField(errs, "Description", errors.ErrModel)
+	}
+
+	errs = errors.AppendField(errs, "ArticleKeys", validateKeyList(m.ArticleKeys))
+
+	if err := m.CreatedAt.Validate(); err != nil {
+		errs = errors.AppendField(errs, "CreatedAt", err)
+	} else if m.CreatedAt == 0 {
+		errs = errors.AppendField(errs, "CreatedAt", errors.ErrEmpty)
+	}
+
+	return errs
+}
+
+var _ orm.Model = (*RateLimit)(nil)
+
+// Validate validates rate limit fields
+func (m *RateLimit) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+
+	for i, t := range m.ActionTimes {
+		if err := t.Validate(); err != nil {
+			errs = errors.AppendField(errs, "ActionTimes", err)
+		} else if i > 0 && t < m.ActionTimes[i-1] {
+			errs = errors.AppendField(errs, "ActionTimes", errors.Wrap(errors.ErrInput, "not ordered"))
+		}
+	}
+
+	return errs
+}
+
+// expire removes all action times that are not after given time.
+func (m *RateLimit) expire(since weave.UnixTime) {
+	n := 0
+	for n < len(m.ActionTimes) && m.ActionTimes[n] <= since {
+		n++
+	}
+	m.ActionTimes = m.ActionTimes[n:]
+}
@@ -247,6 +247,40 @@ func TestValidateBlog(t *testing.T) {
 				"CreatedAt":   errors.ErrEmpty,
 			},
 		},
+		"failure duplicated pinned article": {
+			model: &Blog{
+				Metadata:          &weave.Metadata{Schema: 1},
+				PrimaryKey:        weavetest.SequenceID(1),
+				Owner:             weavetest.NewCondition().Address(),
+				Title:             "Best hacker's blog",
+				Description:       "Best description ever",
+				CreatedAt:         now,
+				PinnedArticleKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(1)},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":          nil,
+				"PrimaryKey":        nil,
+				"CreatedAt":         nil,
+				"PinnedArticleKeys": errors.ErrDuplicate,
+			},
+		},
+		"failure invalid pinned article": {
+			model: &Blog{
+				Metadata:          &weave.Metadata{Schema: 1},
+				PrimaryKey:        weavetest.SequenceID(1),
+				Owner:             weavetest.NewCondition().Address(),
+				Title:             "Best hacker's blog",
+				Description:       "Best description ever",
+				CreatedAt:         now,
+				PinnedArticleKeys: [][]byte{{0, 0}},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":          nil,
+				"PrimaryKey":        nil,
+				"CreatedAt":         nil,
+				"PinnedArticleKeys": errors.ErrInput,
+			},
+		},
 	}
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -482,3 +516,114 @@ func TestValidateArticle(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSeries(t *testing.T) {
+	now := weave.AsUnixTime(time.Now())
+
+	cases := map[string]struct {
+		model    orm.Model
+		wantErrs map[string]*errors.Error
+	}{
+		"valid series": {
+			model: &Series{
+				Metadata:    &weave.Metadata{Schema: 1},
+				PrimaryKey:  weavetest.SequenceID(1),
+				BlogKey:     weavetest.SequenceID(1),
+				Title:       "Weave tutorial",
+				Description: "All you need to know",
+				ArticleKeys: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(1)},
+				CreatedAt:   now,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"PrimaryKey":  nil,
+				"BlogKey":     nil,
+				"Title":       nil,
+				"Description": nil,
+				"ArticleKeys": nil,
+				"CreatedAt":   nil,
+			},
+		},
+		"failure missing blog key and created at": {
+			model: &Series{
+				Metadata:    &weave.Metadata{Schema: 1},
+				PrimaryKey:  weavetest.SequenceID(1),
+				Title:       "Weave tutorial",
+				Description: "All you need to know",
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"PrimaryKey":  nil,
+				"BlogKey":     errors.ErrEmpty,
+				"Title":       nil,
+				"Description": nil,
+				"ArticleKeys": nil,
+				"CreatedAt":   errors.ErrEmpty,
+			},
+		},
+		"failure duplicated article": {
+			model: &Series{
+				Metadata:    &weave.Metadata{Schema: 1},
+				PrimaryKey:  weavetest.SequenceID(1),
+				BlogKey:     weavetest.SequenceID(1),
+				Title:       "Weave tutorial",
+				Description: "All you need to know",
+				ArticleKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(1)},
+				CreatedAt:   now,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"ArticleKeys": errors.ErrDuplicate,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.model.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestInvalidTextIndex(t *testing.T) {
+	cases := map[string]struct {
+		text string
+		want int
+	}{
+		"empty":                {text: "", want: -1},
+		"valid":                {text: "Best content in the existence!", want: -1},
+		"new line":             {text: "first line\nsecond line", want: 10},
+		"comma":                {text: "one, two", want: 3},
+		"multi byte character": {text: "café au lait", want: 3},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := InvalidTextIndex(tc.text); got != tc.want {
+				t.Fatalf("want %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestArticlePublished(t *testing.T) {
+	now := weave.AsUnixTime(time.Now())
+
+	cases := map[string]struct {
+		article Article
+		want    bool
+	}{
+		"no deletion time":     {article: Article{}, want: true},
+		"deletion in future":   {article: Article{DeleteAt: now + 1}, want: true},
+		"deletion time passed": {article: Article{DeleteAt: now}, want: false},
+		"hidden":               {article: Article{Hidden: true}, want: false},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := tc.article.Published(now); got != tc.want {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package blog
 
 import (
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -13,6 +14,18 @@ func init() {
 	migration.MustRegister(1, &ChangeBlogOwnerMsg{}, migration.NoModification)
 	migration.MustRegister(1, &CreateArticleMsg{}, migration.NoModification)
 	migration.MustRegister(1, &DeleteArticleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &HideArticleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CommissionArticleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &FulfillCommissionMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RefundCommissionMsg{}, migration.NoModification)
+	migration.MustRegister(1, &PinArticleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UnpinArticleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateSeriesMsg{}, migration.NoModification)
+	migration.MustRegister(1, &AppendSeriesArticleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReorderSeriesMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RemoveSeriesArticleMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExpireRateLimitMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateUserMsg)(nil)
@@ -144,3 +157,250 @@ func (m CancelDeleteArticleTaskMsg) Validate() error {
 
 	return errs
 }
+
+var _ weave.Msg = (*HideArticleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (HideArticleMsg) Path() string {
+	return "blog/hide_article"
+}
+
+// Validate ensures the HideArticleMsg is valid
+func (m HideArticleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ArticleKey", orm.ValidateSequence(m.ArticleKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Path returns the routing path for this message.
+func (*UpdateConfigurationMsg) Path() string {
+	return "blog/update_configuration"
+}
+
+// Validate will skip any zero fields and validate the set ones.
+func (m *UpdateConfigurationMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if m.Patch == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	if len(m.Patch.Owner) != 0 {
+		errs = errors.AppendField(errs, "Patch.Owner", m.Patch.Owner.Validate())
+	}
+	if m.Patch.ArticleRateLimit < 0 {
+		errs = errors.AppendField(errs, "Patch.ArticleRateLimit", errors.ErrInput)
+	}
+	if m.Patch.RateLimitWindow < 0 {
+		errs = errors.AppendField(errs, "Patch.RateLimitWindow", errors.ErrInput)
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*CommissionArticleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CommissionArticleMsg) Path() string {
+	return "blog/commission_article"
+}
+
+// Validate ensures the CommissionArticleMsg is valid
+func (m CommissionArticleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "BlogKey", orm.ValidateSequence(m.BlogKey))
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+
+	if !validBlogDescription(m.Description) {
+		errs = errors.AppendField(errs, "Description", errors.ErrModel)
+	}
+
+	if m.Timeout == 0 {
+		errs = errors.AppendField(errs, "Timeout", errors.ErrEmpty)
+	} else {
+		errs = errors.AppendField(errs, "Timeout", m.Timeout.Validate())
+	}
+
+	return errs
+}
+
+// validateAmount ensures given coins are well formed and positive.
+func validateAmount(amount coin.Coins) error {
+	if !amount.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "must be positive")
+	}
+	return amount.Validate()
+}
+
+var _ weave.Msg = (*FulfillCommissionMsg)(nil)
+
+// Path returns the routing path for this message.
+func (FulfillCommissionMsg) Path() string {
+	return "blog/fulfill_commission"
+}
+
+// Validate ensures the FulfillCommissionMsg is valid
+func (m FulfillCommissionMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "CommissionKey", orm.ValidateSequence(m.CommissionKey))
+	errs = errors.AppendField(errs, "ArticleKey", orm.ValidateSequence(m.ArticleKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*RefundCommissionMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RefundCommissionMsg) Path() string {
+	return "blog/refund_commission"
+}
+
+// Validate ensures the RefundCommissionMsg is valid
+func (m RefundCommissionMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "CommissionKey", orm.ValidateSequence(m.CommissionKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*PinArticleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (PinArticleMsg) Path() string {
+	return "blog/pin_article"
+}
+
+// Validate ensures the PinArticleMsg is valid
+func (m PinArticleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ArticleKey", orm.ValidateSequence(m.ArticleKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*UnpinArticleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UnpinArticleMsg) Path() string {
+	return "blog/unpin_article"
+}
+
+// Validate ensures the UnpinArticleMsg is valid
+func (m UnpinArticleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ArticleKey", orm.ValidateSequence(m.ArticleKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*CreateSeriesMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CreateSeriesMsg) Path() string {
+	return "blog/create_series"
+}
+
+// Validate ensures the CreateSeriesMsg is valid
+func (m CreateSeriesMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "BlogKey", orm.ValidateSequence(m.BlogKey))
+
+	if !validBlogTitle(m.Title) {
+		errs = errors.AppendField(errs, "Title", errors.ErrModel)
+	}
+	if !validBlogDescription(m.Description) {
+		errs = errors.AppendField(errs, "Description", errors.ErrModel)
+	}
+
+	errs = errors.AppendField(errs, "ArticleKeys", validateKeyList(m.ArticleKeys))
+
+	return errs
+}
+
+var _ weave.Msg = (*AppendSeriesArticleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (AppendSeriesArticleMsg) Path() string {
+	return "blog/append_series_article"
+}
+
+// Validate ensures the AppendSeriesArticleMsg is valid
+func (m AppendSeriesArticleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "SeriesKey", orm.ValidateSequence(m.SeriesKey))
+	errs = errors.AppendField(errs, "ArticleKey", orm.ValidateSequence(m.ArticleKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*ReorderSeriesMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ReorderSeriesMsg) Path() string {
+	return "blog/reorder_series"
+}
+
+// Validate ensures the ReorderSeriesMsg is valid
+func (m ReorderSeriesMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "SeriesKey", orm.ValidateSequence(m.SeriesKey))
+	errs = errors.AppendField(errs, "ArticleKeys", validateKeyList(m.ArticleKeys))
+
+	return errs
+}
+
+var _ weave.Msg = (*RemoveSeriesArticleMsg)(nil)
+
+// Path returns the routing path for this message.
+func (RemoveSeriesArticleMsg) Path() string {
+	return "blog/remove_series_article"
+}
+
+// Validate ensures the RemoveSeriesArticleMsg is valid
+func (m RemoveSeriesArticleMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "SeriesKey", orm.ValidateSequence(m.SeriesKey))
+	errs = errors.AppendField(errs, "ArticleKey", orm.ValidateSequence(m.ArticleKey))
+
+	return errs
+}
+
+var _ weave.Msg = (*ExpireRateLimitMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ExpireRateLimitMsg) Path() string {
+	return "blog/expire_rate_limit"
+}
+
+// Validate ensures the ExpireRateLimitMsg is valid
+func (m ExpireRateLimitMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+
+	return errs
+}
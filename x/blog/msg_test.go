@@ -2,8 +2,10 @@ package blog
 
 import (
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
@@ -326,3 +328,427 @@ func TestCancelDeleteArticleTask(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateHideArticleMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &HideArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(1),
+				Hidden:     true,
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": nil,
+			},
+		},
+		"failure missing metadata": {
+			msg: &HideArticleMsg{
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   errors.ErrMetadata,
+				"ArticleKey": nil,
+			},
+		},
+		"failure invalid article id": {
+			msg: &HideArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: []byte{0, 0},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateConfigurationMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					Metadata: &weave.Metadata{Schema: 1},
+					Owner:    weavetest.NewCondition().Address(),
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"Patch":       nil,
+				"Patch.Owner": nil,
+			},
+		},
+		"failure missing patch": {
+			msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata": nil,
+				"Patch":    errors.ErrEmpty,
+			},
+		},
+		"failure invalid owner": {
+			msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					Owner: []byte{0, 0},
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"Patch.Owner": errors.ErrInput,
+			},
+		},
+		"failure negative rate limit": {
+			msg: &UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					ArticleRateLimit: -1,
+					RateLimitWindow:  -1,
+				},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":               nil,
+				"Patch.ArticleRateLimit": errors.ErrInput,
+				"Patch.RateLimitWindow":  errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCommissionArticleMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Amount:      []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+				Description: "write about weave",
+				Timeout:     weave.AsUnixTime(time.Now()),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"BlogKey":     nil,
+				"Amount":      nil,
+				"Description": nil,
+				"Timeout":     nil,
+			},
+		},
+		"failure missing amount and timeout": {
+			msg: &CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Description: "write about weave",
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"BlogKey":     nil,
+				"Amount":      errors.ErrAmount,
+				"Description": nil,
+				"Timeout":     errors.ErrEmpty,
+			},
+		},
+		"failure negative amount": {
+			msg: &CommissionArticleMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Amount:      []*coin.Coin{coin.NewCoinp(-5, 0, "IOV")},
+				Description: "write about weave",
+				Timeout:     weave.AsUnixTime(time.Now()),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"BlogKey":     nil,
+				"Amount":      errors.ErrAmount,
+				"Description": nil,
+				"Timeout":     nil,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFulfillCommissionMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &FulfillCommissionMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				CommissionKey: weavetest.SequenceID(1),
+				ArticleKey:    weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":      nil,
+				"CommissionKey": nil,
+				"ArticleKey":    nil,
+			},
+		},
+		"failure missing keys": {
+			msg: &FulfillCommissionMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":      nil,
+				"CommissionKey": errors.ErrEmpty,
+				"ArticleKey":    errors.ErrEmpty,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePinArticleMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &PinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": nil,
+			},
+		},
+		"failure missing metadata and article key": {
+			msg: &PinArticleMsg{},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   errors.ErrMetadata,
+				"ArticleKey": errors.ErrEmpty,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUnpinArticleMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &UnpinArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"ArticleKey": nil,
+			},
+		},
+		"failure missing metadata and article key": {
+			msg: &UnpinArticleMsg{},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   errors.ErrMetadata,
+				"ArticleKey": errors.ErrEmpty,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCreateSeriesMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &CreateSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Title:       "Weave tutorial",
+				Description: "All you need to know",
+				ArticleKeys: [][]byte{weavetest.SequenceID(1)},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"BlogKey":     nil,
+				"Title":       nil,
+				"Description": nil,
+				"ArticleKeys": nil,
+			},
+		},
+		"failure missing title and invalid article key": {
+			msg: &CreateSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				BlogKey:     weavetest.SequenceID(1),
+				Description: "All you need to know",
+				ArticleKeys: [][]byte{{1}},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"BlogKey":     nil,
+				"Title":       errors.ErrModel,
+				"Description": nil,
+				"ArticleKeys": errors.ErrInput,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateReorderSeriesMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success": {
+			msg: &ReorderSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesKey:   weavetest.SequenceID(1),
+				ArticleKeys: [][]byte{weavetest.SequenceID(2), weavetest.SequenceID(1)},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"SeriesKey":   nil,
+				"ArticleKeys": nil,
+			},
+		},
+		"failure duplicated article": {
+			msg: &ReorderSeriesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				SeriesKey:   weavetest.SequenceID(1),
+				ArticleKeys: [][]byte{weavetest.SequenceID(1), weavetest.SequenceID(1)},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":    nil,
+				"SeriesKey":   nil,
+				"ArticleKeys": errors.ErrDuplicate,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateSeriesArticleMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg      weave.Msg
+		wantErrs map[string]*errors.Error
+	}{
+		"success append": {
+			msg: &AppendSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  weavetest.SequenceID(1),
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"SeriesKey":  nil,
+				"ArticleKey": nil,
+			},
+		},
+		"failure append missing keys": {
+			msg: &AppendSeriesArticleMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"SeriesKey":  errors.ErrEmpty,
+				"ArticleKey": errors.ErrEmpty,
+			},
+		},
+		"success remove": {
+			msg: &RemoveSeriesArticleMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				SeriesKey:  weavetest.SequenceID(1),
+				ArticleKey: weavetest.SequenceID(1),
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"SeriesKey":  nil,
+				"ArticleKey": nil,
+			},
+		},
+		"failure remove missing keys": {
+			msg: &RemoveSeriesArticleMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			wantErrs: map[string]*errors.Error{
+				"Metadata":   nil,
+				"SeriesKey":  errors.ErrEmpty,
+				"ArticleKey": errors.ErrEmpty,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.msg.Validate()
+			for field, wantErr := range tc.wantErrs {
+				assert.FieldError(t, err, field, wantErr)
+			}
+		})
+	}
+}
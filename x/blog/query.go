@@ -0,0 +1,505 @@
+package blog
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+const (
+	// DefaultPageLimit is the number of entities returned by a range query
+	// if no limit was requested.
+	DefaultPageLimit = 50
+	// MaxPageLimit is the maximum number of entities that a single range
+	// query can return.
+	MaxPageLimit = 200
+)
+
+// KeyFormat describes the format of a query path data used by key and prefix
+// queries.
+type KeyFormat int
+
+const (
+	// SequenceKeyFormat is a big-endian encoded sequence ID.
+	SequenceKeyFormat KeyFormat = iota
+	// AddressKeyFormat is a weave.Address.
+	AddressKeyFormat
+	// TimedBlogKeyFormat is a blog sequence ID followed by a big-endian
+	// article creation time, as produced by BuildBlogTimedIndex.
+	TimedBlogKeyFormat
+)
+
+// QueryPath describes a single query path registered by RegisterQuery.
+type QueryPath struct {
+	// Path is the absolute query path, for example "/articles/blog".
+	Path string
+	// NewModel returns a new instance of the model returned by the query.
+	NewModel func() weave.Persistent
+	// Key is the format of the key and prefix query data.
+	Key KeyFormat
+	// Paginated is true if the path supports range queries with a
+	// PageQuery data.
+	Paginated bool
+	// TimeRange is true if the path supports range queries with a
+	// TimeRangeQuery data.
+	TimeRange bool
+
+	handler weave.QueryHandler
+}
+
+// QueryPaths returns all query paths that RegisterQuery registers. Clients
+// can use it to discover supported paths and the format of their data.
+func QueryPaths() []QueryPath {
+	buckets := weave.NewQueryRouter()
+	NewUserBucket().Register("users", buckets)
+	NewBlogBucket().Register("blogs", buckets)
+	NewArticleBucket().Register("articles", buckets)
+	NewCommissionBucket().Register("commissions", buckets)
+	NewSeriesBucket().Register("series", buckets)
+
+	var (
+		newUser       = func() weave.Persistent { return &User{} }
+		newBlog       = func() weave.Persistent { return &Blog{} }
+		newArticle    = func() weave.Persistent { return &Article{} }
+		newCommission = func() weave.Persistent { return &Commission{} }
+		newSeries     = func() weave.Persistent { return &Series{} }
+	)
+	return []QueryPath{
+		{
+			Path:      "/users",
+			NewModel:  newUser,
+			Paginated: true,
+			handler:   pageQueryHandler{QueryHandler: buckets.Handler("/users"), prefix: []byte("user:")},
+		},
+		{
+			Path:      "/blogs",
+			NewModel:  newBlog,
+			Paginated: true,
+			handler:   pageQueryHandler{QueryHandler: buckets.Handler("/blogs"), prefix: []byte("blog:")},
+		},
+		{
+			Path:     "/blogs/user",
+			NewModel: newBlog,
+			Key:      AddressKeyFormat,
+			handler:  buckets.Handler("/blogs/user"),
+		},
+		{
+			Path:      "/articles",
+			NewModel:  newArticle,
+			Paginated: true,
+			handler:   pageQueryHandler{QueryHandler: buckets.Handler("/articles"), prefix: []byte("article:")},
+		},
+		{
+			// Articles queried by blog key are returned with pinned
+			// articles first.
+			Path:     "/articles/blog",
+			NewModel: newArticle,
+			handler: pinnedFirstQueryHandler{
+				articles: buckets.Handler("/articles/blog"),
+				blogs:    NewBlogBucket(),
+			},
+		},
+		{
+			Path:      "/articles/timedBlog",
+			NewModel:  newArticle,
+			Key:       TimedBlogKeyFormat,
+			TimeRange: true,
+			handler: timeRangeQueryHandler{
+				QueryHandler: buckets.Handler("/articles/timedBlog"),
+				index:        []byte("_i.article_timedBlog:"),
+				prefix:       []byte("article:"),
+			},
+		},
+		{
+			Path:     "/commissions",
+			NewModel: newCommission,
+			handler:  buckets.Handler("/commissions"),
+		},
+		{
+			Path:     "/commissions/blog",
+			NewModel: newCommission,
+			handler:  buckets.Handler("/commissions/blog"),
+		},
+		{
+			Path:     "/commissions/requester",
+			NewModel: newCommission,
+			Key:      AddressKeyFormat,
+			handler:  buckets.Handler("/commissions/requester"),
+		},
+		{
+			Path:     "/commissions/article",
+			NewModel: newCommission,
+			handler:  buckets.Handler("/commissions/article"),
+		},
+		{
+			Path:     "/series",
+			NewModel: newSeries,
+			handler:  buckets.Handler("/series"),
+		},
+		{
+			Path:     "/series/blog",
+			NewModel: newSeries,
+			handler:  buckets.Handler("/series/blog"),
+		},
+		{
+			// Resolved series path returns each series together with
+			// its articles.
+			Path:     "/series/resolved",
+			NewModel: func() weave.Persistent { return &ResolvedSeries{} },
+			handler: resolvedSeriesQueryHandler{
+				series:   buckets.Handler("/series"),
+				articles: NewArticleBucket(),
+			},
+		},
+	}
+}
+
+// pageQueryHandler extends bucket query handler with paginated range queries.
+// Range query data must be a serialized PageQuery. One entity more than the
+// limit is returned if there is a next page, so that the page cursor does not
+// have to be guessed.
+type pageQueryHandler struct {
+	weave.QueryHandler
+	// prefix is the bucket prefix of all entity keys.
+	prefix []byte
+}
+
+var _ weave.QueryHandler = pageQueryHandler{}
+
+// Query implements weave.QueryHandler interface.
+func (h pageQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.RangeQueryMod {
+		return h.QueryHandler.Query(db, mod, data)
+	}
+
+	var q PageQuery
+	if err := q.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot unmarshal page query")
+	}
+	limit := int(q.Limit)
+	switch {
+	case limit == 0:
+		limit = DefaultPageLimit
+	case limit > MaxPageLimit:
+		return nil, errors.Wrapf(errors.ErrInput, "limit must not be greater than %d", MaxPageLimit)
+	}
+
+	start, end := prefixRange(h.prefix)
+	var (
+		iter weave.Iterator
+		err  error
+	)
+	if q.Descending {
+		if len(q.After) != 0 {
+			end = append(append([]byte{}, h.prefix...), q.After...)
+		}
+		iter, err = db.ReverseIterator(start, end)
+	} else {
+		if len(q.After) != 0 {
+			// Smallest key that is greater than the cursor.
+			start = append(append(append([]byte{}, h.prefix...), q.After...), 0)
+		}
+		iter, err = db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer iter.Release()
+
+	models := make([]weave.Model, 0, limit+1)
+	for len(models) <= limit {
+		key, value, err := iter.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "iterator")
+		}
+		models = append(models, weave.Pair(key, value))
+	}
+	return models, nil
+}
+
+// prefixRange returns start and end keys of an iterator over all keys with
+// given prefix.
+func prefixRange(prefix []byte) ([]byte, []byte) {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return prefix, end[:i+1]
+		}
+	}
+	return prefix, nil
+}
+
+// timeRangeQueryHandler extends the articles by blog and creation time index
+// query with range queries, selecting articles of a blog created within a
+// time range. Range query data must be a serialized TimeRangeQuery.
+//
+// A single index entry references all articles of a blog created at the
+// same time, so the page cursor is the index key followed by the number of
+// references of that entry that were already returned.
+type timeRangeQueryHandler struct {
+	weave.QueryHandler
+	// index is the prefix of all timedBlog index keys.
+	index []byte
+	// prefix is the article bucket prefix of all article keys.
+	prefix []byte
+}
+
+var _ weave.QueryHandler = timeRangeQueryHandler{}
+
+// Query implements weave.QueryHandler interface.
+func (h timeRangeQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.RangeQueryMod {
+		return h.QueryHandler.Query(db, mod, data)
+	}
+
+	var q TimeRangeQuery
+	if err := q.Unmarshal(data); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot unmarshal time range query")
+	}
+	if len(q.BlogKey) != 8 {
+		return nil, errors.Wrap(errors.ErrInput, "invalid blog key")
+	}
+	if q.From < 0 {
+		return nil, errors.Wrap(errors.ErrInput, "from must not be negative")
+	}
+	if q.To != 0 && q.To <= q.From {
+		return nil, errors.Wrap(errors.ErrInput, "to must be after from")
+	}
+	limit := int(q.Limit)
+	switch {
+	case limit == 0:
+		limit = DefaultPageLimit
+	case limit > MaxPageLimit:
+		return nil, errors.Wrapf(errors.ErrInput, "limit must not be greater than %d", MaxPageLimit)
+	}
+
+	start, err := BuildBlogTimedIndex(&Article{BlogKey: q.BlogKey, CreatedAt: q.From})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build range start")
+	}
+	start = append(append([]byte{}, h.index...), start...)
+	var end []byte
+	if q.To != 0 {
+		end, err = BuildBlogTimedIndex(&Article{BlogKey: q.BlogKey, CreatedAt: q.To})
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot build range end")
+		}
+		end = append(append([]byte{}, h.index...), end...)
+	} else {
+		_, end = prefixRange(append(append([]byte{}, h.index...), q.BlogKey...))
+	}
+
+	// cursor is the index key of the entry that the page starts in and
+	// skip is the number of its references returned with previous pages.
+	var (
+		cursor []byte
+		skip   int
+	)
+	if len(q.After) != 0 {
+		if len(q.After) != timeRangeCursorLen || !bytes.Equal(q.After[:8], q.BlogKey) {
+			return nil, errors.Wrap(errors.ErrInput, "invalid after cursor")
+		}
+		cursor = append(append([]byte{}, h.index...), q.After[:16]...)
+		skip = int(binary.BigEndian.Uint32(q.After[16:]))
+		if q.Descending {
+			if end == nil || bytes.Compare(cursor, end) < 0 {
+				// Smallest key that is greater than the cursor.
+				end = append(append([]byte{}, cursor...), 0)
+			}
+		} else if bytes.Compare(cursor, start) > 0 {
+			start = cursor
+		}
+	}
+
+	var iter weave.Iterator
+	if q.Descending {
+		iter, err = db.ReverseIterator(start, end)
+	} else {
+		iter, err = db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer iter.Release()
+
+	// One model more than the limit tells that there is a next page.
+	var models []weave.Model
+	for len(models) <= limit {
+		entry, value, err := iter.Next()
+		if errors.ErrIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "iterator")
+		}
+		var refs orm.MultiRef
+		if err := refs.Unmarshal(value); err != nil {
+			return nil, errors.Wrap(err, "cannot unmarshal index references")
+		}
+		if q.Descending {
+			for i, j := 0, len(refs.Refs)-1; i < j; i, j = i+1, j-1 {
+				refs.Refs[i], refs.Refs[j] = refs.Refs[j], refs.Refs[i]
+			}
+		}
+		if cursor != nil && bytes.Equal(entry, cursor) {
+			if skip > len(refs.Refs) {
+				skip = len(refs.Refs)
+			}
+			refs.Refs = refs.Refs[skip:]
+		}
+		for _, ref := range refs.Refs {
+			if len(models) > limit {
+				break
+			}
+			key := append(append([]byte{}, h.prefix...), ref...)
+			raw, err := db.Get(key)
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot load article")
+			}
+			models = append(models, weave.Pair(key, raw))
+		}
+	}
+	return models, nil
+}
+
+// timeRangeCursorLen is the length of a time range query cursor, a timedBlog
+// index key followed by a big-endian encoded reference offset.
+const timeRangeCursorLen = 16 + 4
+
+// NextTimeRangeCursor returns the cursor of the time range query page that
+// follows given articles. Articles must be the beginning of a page returned
+// for the given after cursor, in the order they were returned.
+func NextTimeRangeCursor(after []byte, articles []*Article) ([]byte, error) {
+	if len(articles) == 0 {
+		return nil, errors.Wrap(errors.ErrInput, "no articles")
+	}
+	last := articles[len(articles)-1]
+	index, err := BuildBlogTimedIndex(last)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot build index key")
+	}
+	var offset uint32
+	for i := len(articles) - 1; i >= 0 && articles[i].CreatedAt == last.CreatedAt; i-- {
+		offset++
+	}
+	// Page that started within the same index entry continues the offset
+	// of the previous pages.
+	if int(offset) == len(articles) && len(after) == timeRangeCursorLen && bytes.Equal(after[:16], index) {
+		offset += binary.BigEndian.Uint32(after[16:])
+	}
+	cursor := make([]byte, timeRangeCursorLen)
+	copy(cursor, index)
+	binary.BigEndian.PutUint32(cursor[16:], offset)
+	return cursor, nil
+}
+
+// pinnedFirstQueryHandler wraps the articles by blog index query and moves
+// pinned articles in front of the result, preserving the pin order.
+type pinnedFirstQueryHandler struct {
+	articles weave.QueryHandler
+	blogs    *BlogBucket
+}
+
+var _ weave.QueryHandler = pinnedFirstQueryHandler{}
+
+// Query implements weave.QueryHandler interface.
+func (h pinnedFirstQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, err := h.articles.Query(db, mod, data)
+	if err != nil || len(models) == 0 {
+		return models, err
+	}
+
+	// Only exact blog key query is guaranteed to return articles of a
+	// single blog.
+	if mod != weave.KeyQueryMod {
+		return models, nil
+	}
+
+	var blog Blog
+	switch err := h.blogs.ByID(db, data, &blog); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return models, nil
+	default:
+		return nil, errors.Wrap(err, "cannot retrieve blog")
+	}
+	return pinnedFirst(models, blog.PinnedArticleKeys), nil
+}
+
+// pinnedFirst returns models ordered so that the pinned articles, in the
+// order of pinning, are before any other article. Relative order of not
+// pinned articles is preserved.
+func pinnedFirst(models []weave.Model, pinned [][]byte) []weave.Model {
+	if len(pinned) == 0 {
+		return models
+	}
+
+	res := make([]weave.Model, 0, len(models))
+	used := make([]bool, len(models))
+	for _, key := range pinned {
+		for i, m := range models {
+			if !used[i] && bytes.HasSuffix(m.Key, key) {
+				res = append(res, m)
+				used[i] = true
+				break
+			}
+		}
+	}
+	for i, m := range models {
+		if !used[i] {
+			res = append(res, m)
+		}
+	}
+	return res
+}
+
+// resolvedSeriesQueryHandler wraps the series query and replaces each
+// returned series with a ResolvedSeries, that contains the series articles in
+// the series order.
+type resolvedSeriesQueryHandler struct {
+	series   weave.QueryHandler
+	articles *ArticleBucket
+}
+
+var _ weave.QueryHandler = resolvedSeriesQueryHandler{}
+
+// Query implements weave.QueryHandler interface.
+func (h resolvedSeriesQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	models, err := h.series.Query(db, mod, data)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range models {
+		var series Series
+		if err := series.Unmarshal(m.Value); err != nil {
+			return nil, errors.Wrap(err, "cannot unmarshal series")
+		}
+
+		resolved := ResolvedSeries{
+			Series:   &series,
+			Articles: make([]*Article, 0, len(series.ArticleKeys)),
+		}
+		for _, key := range series.ArticleKeys {
+			var article Article
+			if err := h.articles.ByID(db, key, &article); err != nil {
+				return nil, errors.Wrapf(err, "cannot retrieve article %s", key)
+			}
+			resolved.Articles = append(resolved.Articles, &article)
+		}
+
+		raw, err := resolved.Marshal()
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal resolved series")
+		}
+		models[i].Value = raw
+	}
+	return models, nil
+}
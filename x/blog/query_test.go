@@ -0,0 +1,501 @@
+package blog
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestQueryArticlesByBlogPinnedFirst(t *testing.T) {
+	owner := weavetest.NewCondition()
+	now := weave.AsUnixTime(time.Now())
+
+	kv := store.MemStore()
+
+	blogs := NewBlogBucket()
+	for i := uint64(1); i <= 2; i++ {
+		err := blogs.Save(kv, &Blog{
+			Metadata:          &weave.Metadata{Schema: 1},
+			PrimaryKey:        weavetest.SequenceID(i),
+			Owner:             owner.Address(),
+			Title:             "insanely good title",
+			Description:       "best description in the existence",
+			CreatedAt:         now,
+			PinnedArticleKeys: [][]byte{weavetest.SequenceID(3), weavetest.SequenceID(1)},
+		})
+		assert.Nil(t, err)
+	}
+
+	articles := NewArticleBucket()
+	for i := uint64(1); i <= 5; i++ {
+		blogID := weavetest.SequenceID(1)
+		if i == 5 {
+			blogID = weavetest.SequenceID(2)
+		}
+		err := articles.Save(kv, &Article{
+			Metadata:   &weave.Metadata{Schema: 1},
+			PrimaryKey: weavetest.SequenceID(i),
+			BlogKey:    blogID,
+			Owner:      owner.Address(),
+			Title:      "Best hacker's blog",
+			Content:    "Best description ever",
+			CreatedAt:  now,
+		})
+		assert.Nil(t, err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	cases := map[string]struct {
+		path    string
+		mod     string
+		data    []byte
+		wantIDs []uint64
+	}{
+		"pinned articles are first": {
+			path:    "/articles/blog",
+			mod:     weave.KeyQueryMod,
+			data:    weavetest.SequenceID(1),
+			wantIDs: []uint64{3, 1, 2, 4},
+		},
+		"pinned articles of another blog are ignored": {
+			path:    "/articles/blog",
+			mod:     weave.KeyQueryMod,
+			data:    weavetest.SequenceID(2),
+			wantIDs: []uint64{5},
+		},
+		"prefix query order is not changed": {
+			path:    "/articles/blog",
+			mod:     weave.PrefixQueryMod,
+			data:    nil,
+			wantIDs: []uint64{1, 2, 3, 4, 5},
+		},
+		"all articles": {
+			path:    "/articles",
+			mod:     weave.PrefixQueryMod,
+			data:    nil,
+			wantIDs: []uint64{1, 2, 3, 4, 5},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := qr.Handler(tc.path).Query(kv, tc.mod, tc.data)
+			assert.Nil(t, err)
+
+			var got []uint64
+			for _, m := range models {
+				var a Article
+				err := a.Unmarshal(m.Value)
+				assert.Nil(t, err)
+				got = append(got, binary.BigEndian.Uint64(a.PrimaryKey))
+			}
+			assert.Equal(t, tc.wantIDs, got)
+		})
+	}
+}
+
+func TestQueryResolvedSeries(t *testing.T) {
+	owner := weavetest.NewCondition()
+	now := weave.AsUnixTime(time.Now())
+
+	kv := store.MemStore()
+
+	articles := NewArticleBucket()
+	for i := uint64(1); i <= 3; i++ {
+		err := articles.Save(kv, &Article{
+			Metadata:   &weave.Metadata{Schema: 1},
+			PrimaryKey: weavetest.SequenceID(i),
+			BlogKey:    weavetest.SequenceID(1),
+			Owner:      owner.Address(),
+			Title:      "Best hacker's blog",
+			Content:    "Best description ever",
+			CreatedAt:  now,
+		})
+		assert.Nil(t, err)
+	}
+
+	series := NewSeriesBucket()
+	err := series.Save(kv, &Series{
+		Metadata:    &weave.Metadata{Schema: 1},
+		PrimaryKey:  weavetest.SequenceID(1),
+		BlogKey:     weavetest.SequenceID(1),
+		Title:       "Weave tutorial",
+		Description: "All you need to know",
+		ArticleKeys: [][]byte{weavetest.SequenceID(3), weavetest.SequenceID(1)},
+		CreatedAt:   now,
+	})
+	assert.Nil(t, err)
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	models, err := qr.Handler("/series/resolved").Query(kv, weave.KeyQueryMod, weavetest.SequenceID(1))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(models))
+
+	var resolved ResolvedSeries
+	err = resolved.Unmarshal(models[0].Value)
+	assert.Nil(t, err)
+	assert.Equal(t, "Weave tutorial", resolved.Series.Title)
+
+	var got []uint64
+	for _, a := range resolved.Articles {
+		got = append(got, binary.BigEndian.Uint64(a.PrimaryKey))
+	}
+	assert.Equal(t, []uint64{3, 1}, got)
+
+	models, err = qr.Handler("/series/resolved").Query(kv, weave.KeyQueryMod, weavetest.SequenceID(2))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(models))
+}
+
+func TestPaginatedQuery(t *testing.T) {
+	owner := weavetest.NewCondition()
+	now := weave.AsUnixTime(time.Now())
+
+	kv := store.MemStore()
+
+	blogs := NewBlogBucket()
+	for i := 0; i < 5; i++ {
+		err := blogs.Save(kv, &Blog{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Owner:       owner.Address(),
+			Title:       "insanely good title",
+			Description: "best description in the existence",
+			CreatedAt:   now,
+		})
+		assert.Nil(t, err)
+	}
+	// Entities of other buckets must not be returned.
+	err := NewArticleBucket().Save(kv, &Article{
+		Metadata:  &weave.Metadata{Schema: 1},
+		BlogKey:   weavetest.SequenceID(1),
+		Owner:     owner.Address(),
+		Title:     "Best hacker's blog",
+		Content:   "Best description ever",
+		CreatedAt: now,
+	})
+	assert.Nil(t, err)
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	// One entity more than the limit is returned if there is a next page.
+	cases := map[string]struct {
+		query   *PageQuery
+		wantErr *errors.Error
+		wantIDs []uint64
+	}{
+		"first page": {
+			query:   &PageQuery{Limit: 2},
+			wantIDs: []uint64{1, 2, 3},
+		},
+		"page after a cursor": {
+			query:   &PageQuery{After: weavetest.SequenceID(2), Limit: 2},
+			wantIDs: []uint64{3, 4, 5},
+		},
+		"full last page": {
+			query:   &PageQuery{After: weavetest.SequenceID(3), Limit: 2},
+			wantIDs: []uint64{4, 5},
+		},
+		"last page": {
+			query:   &PageQuery{After: weavetest.SequenceID(4), Limit: 2},
+			wantIDs: []uint64{5},
+		},
+		"page after the last entity": {
+			query:   &PageQuery{After: weavetest.SequenceID(5), Limit: 2},
+			wantIDs: nil,
+		},
+		"default limit": {
+			query:   &PageQuery{},
+			wantIDs: []uint64{1, 2, 3, 4, 5},
+		},
+		"first page descending": {
+			query:   &PageQuery{Limit: 2, Descending: true},
+			wantIDs: []uint64{5, 4, 3},
+		},
+		"page after a cursor descending": {
+			query:   &PageQuery{After: weavetest.SequenceID(4), Limit: 2, Descending: true},
+			wantIDs: []uint64{3, 2, 1},
+		},
+		"limit too big": {
+			query:   &PageQuery{Limit: MaxPageLimit + 1},
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			data, err := tc.query.Marshal()
+			assert.Nil(t, err)
+
+			models, err := qr.Handler("/blogs").Query(kv, weave.RangeQueryMod, data)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var got []uint64
+			for _, m := range models {
+				var b Blog
+				err := b.Unmarshal(m.Value)
+				assert.Nil(t, err)
+				got = append(got, binary.BigEndian.Uint64(b.PrimaryKey))
+			}
+			assert.Equal(t, tc.wantIDs, got)
+		})
+	}
+}
+
+func TestQueryPaths(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	paths := make(map[string]bool)
+	for _, p := range QueryPaths() {
+		if qr.Handler(p.Path) == nil {
+			t.Errorf("%q path not registered", p.Path)
+		}
+		if p.NewModel() == nil {
+			t.Errorf("%q path has no model", p.Path)
+		}
+		paths[p.Path] = true
+	}
+
+	want := []string{
+		"/users", "/blogs", "/blogs/user", "/articles", "/articles/blog", "/articles/timedBlog",
+		"/commissions", "/commissions/blog", "/commissions/requester", "/commissions/article",
+		"/series", "/series/blog", "/series/resolved",
+	}
+	for _, path := range want {
+		if !paths[path] {
+			t.Errorf("%q path not described", path)
+		}
+	}
+}
+
+func TestTimeRangeQuery(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	kv := store.MemStore()
+
+	articles := NewArticleBucket()
+	// Articles 1-5 are created in blog 1 at times 100, 200, 200, 300, 400.
+	// Article 6 belongs to blog 2.
+	created := []weave.UnixTime{100, 200, 200, 300, 400, 200}
+	for i, at := range created {
+		blogID := weavetest.SequenceID(1)
+		if i == 5 {
+			blogID = weavetest.SequenceID(2)
+		}
+		err := articles.Save(kv, &Article{
+			Metadata:  &weave.Metadata{Schema: 1},
+			BlogKey:   blogID,
+			Owner:     owner.Address(),
+			Title:     "Best hacker's blog",
+			Content:   "Best description ever",
+			CreatedAt: at,
+		})
+		assert.Nil(t, err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	cases := map[string]struct {
+		query   *TimeRangeQuery
+		wantErr *errors.Error
+		wantIDs []uint64
+	}{
+		"all articles of a blog": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1)},
+			wantIDs: []uint64{1, 2, 3, 4, 5},
+		},
+		"from is inclusive and to is exclusive": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), From: 200, To: 400},
+			wantIDs: []uint64{2, 3, 4},
+		},
+		"descending": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), From: 200, Descending: true},
+			wantIDs: []uint64{5, 4, 3, 2},
+		},
+		"limit": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), Limit: 2, Descending: true},
+			wantIDs: []uint64{5, 4, 3},
+		},
+		"one article more than the limit marks the next page": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), Limit: 1},
+			wantIDs: []uint64{1, 2},
+		},
+		"articles created at the same time are split by the limit": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), Limit: 1, From: 200},
+			wantIDs: []uint64{2, 3},
+		},
+		"page after a cursor": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), After: timeRangeCursor(1, 200, 1), Limit: 2},
+			wantIDs: []uint64{3, 4, 5},
+		},
+		"page after a cursor descending": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), After: timeRangeCursor(1, 200, 1), Descending: true},
+			wantIDs: []uint64{2, 1},
+		},
+		"cursor before the range": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), After: timeRangeCursor(1, 100, 1), From: 300},
+			wantIDs: []uint64{4, 5},
+		},
+		"cursor of another blog": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), After: timeRangeCursor(2, 200, 1)},
+			wantErr: errors.ErrInput,
+		},
+		"invalid cursor": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), After: weavetest.SequenceID(1)},
+			wantErr: errors.ErrInput,
+		},
+		"another blog": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(2)},
+			wantIDs: []uint64{6},
+		},
+		"empty range": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), From: 500},
+			wantIDs: nil,
+		},
+		"missing blog key": {
+			query:   &TimeRangeQuery{From: 100},
+			wantErr: errors.ErrInput,
+		},
+		"to before from": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), From: 300, To: 200},
+			wantErr: errors.ErrInput,
+		},
+		"limit too big": {
+			query:   &TimeRangeQuery{BlogKey: weavetest.SequenceID(1), Limit: MaxPageLimit + 1},
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			data, err := tc.query.Marshal()
+			assert.Nil(t, err)
+
+			models, err := qr.Handler("/articles/timedBlog").Query(kv, weave.RangeQueryMod, data)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var got []uint64
+			for _, m := range models {
+				var a Article
+				err := a.Unmarshal(m.Value)
+				assert.Nil(t, err)
+				got = append(got, binary.BigEndian.Uint64(a.PrimaryKey))
+			}
+			assert.Equal(t, tc.wantIDs, got)
+		})
+	}
+}
+
+func TestNextTimeRangeCursor(t *testing.T) {
+	owner := weavetest.NewCondition()
+
+	kv := store.MemStore()
+
+	articles := NewArticleBucket()
+	created := []weave.UnixTime{100, 200, 200, 200, 300}
+	for _, at := range created {
+		err := articles.Save(kv, &Article{
+			Metadata:  &weave.Metadata{Schema: 1},
+			BlogKey:   weavetest.SequenceID(1),
+			Owner:     owner.Address(),
+			Title:     "Best hacker's blog",
+			Content:   "Best description ever",
+			CreatedAt: at,
+		})
+		assert.Nil(t, err)
+	}
+
+	qr := weave.NewQueryRouter()
+	RegisterQuery(qr)
+
+	cases := map[string]struct {
+		limit      uint32
+		descending bool
+		wantIDs    []uint64
+	}{
+		"single article pages": {
+			limit:   1,
+			wantIDs: []uint64{1, 2, 3, 4, 5},
+		},
+		"pages split within the same time": {
+			limit:   2,
+			wantIDs: []uint64{1, 2, 3, 4, 5},
+		},
+		"descending": {
+			limit:      2,
+			descending: true,
+			wantIDs:    []uint64{5, 4, 3, 2, 1},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var (
+				got   []uint64
+				after []byte
+			)
+			for page := 0; page < len(created); page++ {
+				q := TimeRangeQuery{
+					BlogKey:    weavetest.SequenceID(1),
+					After:      after,
+					Limit:      tc.limit,
+					Descending: tc.descending,
+				}
+				data, err := q.Marshal()
+				assert.Nil(t, err)
+				models, err := qr.Handler("/articles/timedBlog").Query(kv, weave.RangeQueryMod, data)
+				assert.Nil(t, err)
+
+				var page []*Article
+				for _, m := range models {
+					var a Article
+					assert.Nil(t, a.Unmarshal(m.Value))
+					page = append(page, &a)
+				}
+				if len(page) <= int(tc.limit) {
+					for _, a := range page {
+						got = append(got, binary.BigEndian.Uint64(a.PrimaryKey))
+					}
+					assert.Equal(t, tc.wantIDs, got)
+					return
+				}
+				page = page[:tc.limit]
+				for _, a := range page {
+					got = append(got, binary.BigEndian.Uint64(a.PrimaryKey))
+				}
+				if after, err = NextTimeRangeCursor(after, page); err != nil {
+					t.Fatalf("cannot build cursor: %s", err)
+				}
+			}
+			t.Fatal("pagination does not end")
+		})
+	}
+}
+
+// timeRangeCursor returns the cursor of a time range query page that starts
+// after offset articles of given blog, created at given time.
+func timeRangeCursor(blogID uint64, createdAt weave.UnixTime, offset uint32) []byte {
+	cursor := make([]byte, 20)
+	binary.BigEndian.PutUint64(cursor, blogID)
+	binary.BigEndian.PutUint64(cursor[8:], uint64(createdAt))
+	binary.BigEndian.PutUint32(cursor[16:], offset)
+	return cursor
+}
@@ -0,0 +1,155 @@
+package blog
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// rateLimiter limits the number of actions a single address can execute
+// within a sliding window of block time. Limit and window are taken from the
+// configuration.
+//
+// Each action time is stored until it leaves the window. Expired action times
+// are removed lazily, when the address executes another action, and by a
+// scheduled task, so that inactive addresses do not stay in the store.
+type rateLimiter struct {
+	b         *RateLimitBucket
+	scheduler weave.Scheduler
+}
+
+func newRateLimiter(scheduler weave.Scheduler) rateLimiter {
+	return rateLimiter{
+		b:         NewRateLimitBucket(),
+		scheduler: scheduler,
+	}
+}
+
+// check returns ErrRateLimited if given address cannot execute another action
+// without exceeding the limit.
+func (r rateLimiter) check(ctx weave.Context, store weave.KVStore, conf *Configuration, addr weave.Address) error {
+	if conf.ArticleRateLimit == 0 {
+		return nil
+	}
+
+	rl, err := r.load(store, addr)
+	if err != nil || rl == nil {
+		return err
+	}
+
+	now, err := blockUnixTime(ctx)
+	if err != nil {
+		return err
+	}
+	rl.expire(now.Add(-conf.RateLimitWindow.Duration()))
+	if len(rl.ActionTimes) >= int(conf.ArticleRateLimit) {
+		return errors.Wrapf(ErrRateLimited, "no more than %d articles within %s", conf.ArticleRateLimit, conf.RateLimitWindow)
+	}
+	return nil
+}
+
+// record stores an action of given address executed at the current block
+// time. It does not check the limit.
+func (r rateLimiter) record(ctx weave.Context, store weave.KVStore, conf *Configuration, addr weave.Address) error {
+	if conf.ArticleRateLimit == 0 {
+		return nil
+	}
+
+	rl, err := r.load(store, addr)
+	if err != nil {
+		return err
+	}
+	if rl == nil {
+		rl = &RateLimit{
+			Metadata: &weave.Metadata{Schema: 1},
+			Address:  addr,
+		}
+	}
+
+	now, err := blockUnixTime(ctx)
+	if err != nil {
+		return err
+	}
+	rl.expire(now.Add(-conf.RateLimitWindow.Duration()))
+	rl.ActionTimes = append(rl.ActionTimes, now)
+
+	if len(rl.ExpireTaskID) == 0 {
+		if err := r.scheduleExpire(store, rl, conf); err != nil {
+			return err
+		}
+	}
+
+	if _, err := r.b.Put(store, addr, rl); err != nil {
+		return errors.Wrap(err, "cannot store rate limit")
+	}
+	return nil
+}
+
+// expire removes action times that left the window. Rate limit is deleted
+// once it contains no action times, otherwise next expiration is scheduled.
+func (r rateLimiter) expire(ctx weave.Context, store weave.KVStore, conf *Configuration, addr weave.Address) error {
+	rl, err := r.load(store, addr)
+	if err != nil || rl == nil {
+		return err
+	}
+
+	now, err := blockUnixTime(ctx)
+	if err != nil {
+		return err
+	}
+	rl.ExpireTaskID = nil
+	rl.expire(now.Add(-conf.RateLimitWindow.Duration()))
+
+	// Rate limiting could have been disabled since the action was recorded.
+	if len(rl.ActionTimes) == 0 || conf.ArticleRateLimit == 0 {
+		if err := r.b.Delete(store, addr); err != nil {
+			return errors.Wrap(err, "cannot delete rate limit")
+		}
+		return nil
+	}
+
+	if err := r.scheduleExpire(store, rl, conf); err != nil {
+		return err
+	}
+	if _, err := r.b.Put(store, addr, rl); err != nil {
+		return errors.Wrap(err, "cannot store rate limit")
+	}
+	return nil
+}
+
+// scheduleExpire schedules a task that removes the oldest action time of the
+// rate limit, once it leaves the window.
+func (r rateLimiter) scheduleExpire(store weave.KVStore, rl *RateLimit, conf *Configuration) error {
+	msg := &ExpireRateLimitMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  rl.Address,
+	}
+	at := rl.ActionTimes[0].Add(conf.RateLimitWindow.Duration())
+	taskID, err := r.scheduler.Schedule(store, at.Time(), nil, msg)
+	if err != nil {
+		return errors.Wrap(err, "cannot schedule rate limit expiration task")
+	}
+	rl.ExpireTaskID = taskID
+	return nil
+}
+
+// load returns the rate limit of given address or nil if it does not exist.
+func (r rateLimiter) load(store weave.KVStore, addr weave.Address) (*RateLimit, error) {
+	var rl RateLimit
+	switch err := r.b.One(store, addr, &rl); {
+	case err == nil:
+		return &rl, nil
+	case errors.ErrNotFound.Is(err):
+		return nil, nil
+	default:
+		return nil, errors.Wrap(err, "cannot retrieve rate limit")
+	}
+}
+
+// blockUnixTime returns the current block time.
+func blockUnixTime(ctx weave.Context) (weave.UnixTime, error) {
+	blockTime, err := weave.BlockTime(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "no block time in header")
+	}
+	return weave.AsUnixTime(blockTime), nil
+}
@@ -0,0 +1,66 @@
+package blog
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/iov-one/weave"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// Tags added by the blog handlers to the deliver results, so that
+// transactions can be searched by the entities they affected. Entity keys
+// are tagged with their sequence number, for example blog.blog='7'.
+const (
+	// TagAction is the path of the processed message.
+	TagAction = "blog.action"
+	// TagBlog is the key of the affected blog.
+	TagBlog = "blog.blog"
+	// TagArticle is the key of the affected article.
+	TagArticle = "blog.article"
+	// TagOwner is the address of the affected entity owner.
+	TagOwner = "blog.owner"
+	// TagUsername is the name of the affected user.
+	TagUsername = "blog.username"
+)
+
+// entityTags describes the entities affected by a message. Empty values are
+// not tagged.
+type entityTags struct {
+	Blog     []byte
+	Article  []byte
+	Owner    weave.Address
+	Username string
+}
+
+// resultTags returns the tags of a message processed by a blog handler.
+func resultTags(msg weave.Msg, t entityTags) []common.KVPair {
+	tags := []common.KVPair{tag(TagAction, msg.Path())}
+	if len(t.Blog) != 0 {
+		tags = append(tags, tag(TagBlog, TagKeyValue(t.Blog)))
+	}
+	if len(t.Article) != 0 {
+		tags = append(tags, tag(TagArticle, TagKeyValue(t.Article)))
+	}
+	if len(t.Owner) != 0 {
+		tags = append(tags, tag(TagOwner, t.Owner.String()))
+	}
+	if t.Username != "" {
+		tags = append(tags, tag(TagUsername, t.Username))
+	}
+	return tags
+}
+
+func tag(key, value string) common.KVPair {
+	return common.KVPair{Key: []byte(key), Value: []byte(value)}
+}
+
+// TagKeyValue returns the tag value of an entity key. Sequence keys are
+// represented by their decimal number, any other key is hex encoded.
+func TagKeyValue(key []byte) string {
+	if len(key) == 8 {
+		return strconv.FormatUint(binary.BigEndian.Uint64(key), 10)
+	}
+	return hex.EncodeToString(key)
+}
@@ -0,0 +1,54 @@
+package blog
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestTagKeyValue(t *testing.T) {
+	assert.Equal(t, "7", TagKeyValue(weavetest.SequenceID(7)))
+	assert.Equal(t, "18446744073709551615", TagKeyValue([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}))
+	assert.Equal(t, "0102", TagKeyValue([]byte{1, 2}))
+}
+
+func TestResultTags(t *testing.T) {
+	owner := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg  weave.Msg
+		tags entityTags
+		want []common.KVPair
+	}{
+		"action only": {
+			msg: &CreateSeriesMsg{},
+			want: []common.KVPair{
+				{Key: []byte(TagAction), Value: []byte("blog/create_series")},
+			},
+		},
+		"all entities": {
+			msg: &PinArticleMsg{},
+			tags: entityTags{
+				Blog:     weavetest.SequenceID(7),
+				Article:  weavetest.SequenceID(3),
+				Owner:    owner,
+				Username: "alice",
+			},
+			want: []common.KVPair{
+				{Key: []byte(TagAction), Value: []byte("blog/pin_article")},
+				{Key: []byte(TagBlog), Value: []byte("7")},
+				{Key: []byte(TagArticle), Value: []byte("3")},
+				{Key: []byte(TagOwner), Value: []byte(owner.String())},
+				{Key: []byte(TagUsername), Value: []byte("alice")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, resultTags(tc.msg, tc.tags))
+		})
+	}
+}